@@ -35,6 +35,9 @@ const (
 	ManagementService_KubernetesSyncManifests_FullMethodName    = "/management.ManagementService/KubernetesSyncManifests"
 	ManagementService_CreateSchematic_FullMethodName            = "/management.ManagementService/CreateSchematic"
 	ManagementService_GetSupportBundle_FullMethodName           = "/management.ManagementService/GetSupportBundle"
+	ManagementService_ReadAuditLog_FullMethodName               = "/management.ManagementService/ReadAuditLog"
+	ManagementService_ClusterLogs_FullMethodName                = "/management.ManagementService/ClusterLogs"
+	ManagementService_MachineConfigPreview_FullMethodName       = "/management.ManagementService/MachineConfigPreview"
 )
 
 // ManagementServiceClient is the client API for ManagementService service.
@@ -54,6 +57,9 @@ type ManagementServiceClient interface {
 	KubernetesSyncManifests(ctx context.Context, in *KubernetesSyncManifestRequest, opts ...grpc.CallOption) (ManagementService_KubernetesSyncManifestsClient, error)
 	CreateSchematic(ctx context.Context, in *CreateSchematicRequest, opts ...grpc.CallOption) (*CreateSchematicResponse, error)
 	GetSupportBundle(ctx context.Context, in *GetSupportBundleRequest, opts ...grpc.CallOption) (ManagementService_GetSupportBundleClient, error)
+	ReadAuditLog(ctx context.Context, in *ReadAuditLogRequest, opts ...grpc.CallOption) (ManagementService_ReadAuditLogClient, error)
+	ClusterLogs(ctx context.Context, in *ClusterLogsRequest, opts ...grpc.CallOption) (ManagementService_ClusterLogsClient, error)
+	MachineConfigPreview(ctx context.Context, in *MachineConfigPreviewRequest, opts ...grpc.CallOption) (*MachineConfigPreviewResponse, error)
 }
 
 type managementServiceClient struct {
@@ -250,6 +256,79 @@ func (x *managementServiceGetSupportBundleClient) Recv() (*GetSupportBundleRespo
 	return m, nil
 }
 
+func (c *managementServiceClient) ReadAuditLog(ctx context.Context, in *ReadAuditLogRequest, opts ...grpc.CallOption) (ManagementService_ReadAuditLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[3], ManagementService_ReadAuditLog_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceReadAuditLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_ReadAuditLogClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
+}
+
+type managementServiceReadAuditLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceReadAuditLogClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) ClusterLogs(ctx context.Context, in *ClusterLogsRequest, opts ...grpc.CallOption) (ManagementService_ClusterLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[4], ManagementService_ClusterLogs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceClusterLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_ClusterLogsClient interface {
+	Recv() (*ClusterLogsResponse, error)
+	grpc.ClientStream
+}
+
+type managementServiceClusterLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceClusterLogsClient) Recv() (*ClusterLogsResponse, error) {
+	m := new(ClusterLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) MachineConfigPreview(ctx context.Context, in *MachineConfigPreviewRequest, opts ...grpc.CallOption) (*MachineConfigPreviewResponse, error) {
+	out := new(MachineConfigPreviewResponse)
+	err := c.cc.Invoke(ctx, ManagementService_MachineConfigPreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagementServiceServer is the server API for ManagementService service.
 // All implementations must embed UnimplementedManagementServiceServer
 // for forward compatibility
@@ -267,6 +346,9 @@ type ManagementServiceServer interface {
 	KubernetesSyncManifests(*KubernetesSyncManifestRequest, ManagementService_KubernetesSyncManifestsServer) error
 	CreateSchematic(context.Context, *CreateSchematicRequest) (*CreateSchematicResponse, error)
 	GetSupportBundle(*GetSupportBundleRequest, ManagementService_GetSupportBundleServer) error
+	ReadAuditLog(*ReadAuditLogRequest, ManagementService_ReadAuditLogServer) error
+	ClusterLogs(*ClusterLogsRequest, ManagementService_ClusterLogsServer) error
+	MachineConfigPreview(context.Context, *MachineConfigPreviewRequest) (*MachineConfigPreviewResponse, error)
 	mustEmbedUnimplementedManagementServiceServer()
 }
 
@@ -313,6 +395,15 @@ func (UnimplementedManagementServiceServer) CreateSchematic(context.Context, *Cr
 func (UnimplementedManagementServiceServer) GetSupportBundle(*GetSupportBundleRequest, ManagementService_GetSupportBundleServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetSupportBundle not implemented")
 }
+func (UnimplementedManagementServiceServer) ReadAuditLog(*ReadAuditLogRequest, ManagementService_ReadAuditLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadAuditLog not implemented")
+}
+func (UnimplementedManagementServiceServer) ClusterLogs(*ClusterLogsRequest, ManagementService_ClusterLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ClusterLogs not implemented")
+}
+func (UnimplementedManagementServiceServer) MachineConfigPreview(context.Context, *MachineConfigPreviewRequest) (*MachineConfigPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MachineConfigPreview not implemented")
+}
 func (UnimplementedManagementServiceServer) mustEmbedUnimplementedManagementServiceServer() {}
 
 // UnsafeManagementServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -569,6 +660,66 @@ func (x *managementServiceGetSupportBundleServer) Send(m *GetSupportBundleRespon
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ManagementService_ReadAuditLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadAuditLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).ReadAuditLog(m, &managementServiceReadAuditLogServer{stream})
+}
+
+type ManagementService_ReadAuditLogServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type managementServiceReadAuditLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceReadAuditLogServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_ClusterLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClusterLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).ClusterLogs(m, &managementServiceClusterLogsServer{stream})
+}
+
+type ManagementService_ClusterLogsServer interface {
+	Send(*ClusterLogsResponse) error
+	grpc.ServerStream
+}
+
+type managementServiceClusterLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceClusterLogsServer) Send(m *ClusterLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_MachineConfigPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MachineConfigPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).MachineConfigPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_MachineConfigPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).MachineConfigPreview(ctx, req.(*MachineConfigPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ManagementService_ServiceDesc is the grpc.ServiceDesc for ManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -616,6 +767,10 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSchematic",
 			Handler:    _ManagementService_CreateSchematic_Handler,
 		},
+		{
+			MethodName: "MachineConfigPreview",
+			Handler:    _ManagementService_MachineConfigPreview_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -633,6 +788,16 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ManagementService_GetSupportBundle_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ReadAuditLog",
+			Handler:       _ManagementService_ReadAuditLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ClusterLogs",
+			Handler:       _ManagementService_ClusterLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "omni/management/management.proto",
 }
@@ -13,12 +13,14 @@ import (
 	"io"
 	"time"
 
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/management"
 )
@@ -46,6 +48,49 @@ func WithServiceAccount(ttl time.Duration, user string, groups ...string) Kubeco
 	}
 }
 
+// LogsOption is a functional option for LogsReader.
+type LogsOption func(request *management.MachineLogsRequest)
+
+// WithLogsTimeRange limits the logs to the ones received within the given time range.
+//
+// Zero since or until leave the corresponding side of the range open.
+func WithLogsTimeRange(since, until time.Time) LogsOption {
+	return func(request *management.MachineLogsRequest) {
+		if !since.IsZero() {
+			request.Since = timestamppb.New(since)
+		}
+
+		if !until.IsZero() {
+			request.Until = timestamppb.New(until)
+		}
+	}
+}
+
+// WithLogsFilter limits the logs to the lines containing the given substring, or matching it as a regular expression.
+func WithLogsFilter(filter string, regex bool) LogsOption {
+	return func(request *management.MachineLogsRequest) {
+		request.Filter = filter
+		request.FilterRegex = regex
+	}
+}
+
+// ClusterLogsOption is a functional option for ClusterLogs.
+type ClusterLogsOption func(request *management.ClusterLogsRequest)
+
+// WithClusterLogsMachineSet limits the logs to the machines of the given machine set.
+func WithClusterLogsMachineSet(machineSet string) ClusterLogsOption {
+	return func(request *management.ClusterLogsRequest) {
+		request.MachineSet = machineSet
+	}
+}
+
+// WithClusterLogsSelector limits the logs to the machines matching the given label selector.
+func WithClusterLogsSelector(selector string) ClusterLogsOption {
+	return func(request *management.ClusterLogsRequest) {
+		request.Selector = selector
+	}
+}
+
 // Client for Management API .
 type Client struct {
 	conn management.ManagementServiceClient
@@ -90,12 +135,18 @@ func (client *Client) Omniconfig(ctx context.Context) ([]byte, error) {
 }
 
 // LogsReader returns the io.Reader for the logs with each message separated by '\n'.
-func (client *Client) LogsReader(ctx context.Context, machineID string, follow bool, tailLines int32) (io.Reader, error) {
-	logStream, err := client.conn.MachineLogs(ctx, &management.MachineLogsRequest{
+func (client *Client) LogsReader(ctx context.Context, machineID string, follow bool, tailLines int32, opts ...LogsOption) (io.Reader, error) {
+	req := &management.MachineLogsRequest{
 		MachineId: machineID,
 		Follow:    follow,
 		TailLines: tailLines,
-	})
+	}
+
+	for _, o := range opts {
+		o(req)
+	}
+
+	logStream, err := client.conn.MachineLogs(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +157,33 @@ func (client *Client) LogsReader(ctx context.Context, machineID string, follow b
 	}, nil
 }
 
+// AuditLogReader returns the io.Reader for the audit log entries matching the filters, each entry is a JSON object separated by '\n'.
+//
+// Zero since and until times and an empty identity match all entries.
+func (client *Client) AuditLogReader(ctx context.Context, since, until time.Time, identity string) (io.Reader, error) {
+	req := &management.ReadAuditLogRequest{
+		Identity: identity,
+	}
+
+	if !since.IsZero() {
+		req.Since = timestamppb.New(since)
+	}
+
+	if !until.IsZero() {
+		req.Until = timestamppb.New(until)
+	}
+
+	auditLogStream, err := client.conn.ReadAuditLog(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogReader{
+		ctx:    ctx,
+		client: auditLogStream,
+	}, nil
+}
+
 // CreateSchematic using the image factory.
 func (client *Client) CreateSchematic(ctx context.Context, req *management.CreateSchematicRequest) (*management.CreateSchematicResponse, error) {
 	schematic, err := client.conn.CreateSchematic(ctx, req)
@@ -196,7 +274,9 @@ func (client *Client) GetSupportBundle(ctx context.Context, cluster string, prog
 // LogReader is a log client reader which implements io.Reader.
 type LogReader struct {
 	ctx    context.Context //nolint:containedctx
-	client management.ManagementService_MachineLogsClient
+	client interface {
+		Recv() (*common.Data, error)
+	}
 
 	buf bytes.Buffer
 }
@@ -312,6 +392,15 @@ func (client *ClusterClient) KubernetesUpgradePreChecks(ctx context.Context, new
 	return fmt.Errorf("%s", resp.GetReason())
 }
 
+// MachineConfigPreview renders the machine configs of the cluster with the proposed changes applied, without applying them.
+//
+// Resources are the YAML encoded config patches and machine sets to be created or updated.
+func (client *ClusterClient) MachineConfigPreview(ctx context.Context, req *management.MachineConfigPreviewRequest) (*management.MachineConfigPreviewResponse, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "context", client.clusterName)
+
+	return client.client.conn.MachineConfigPreview(ctx, req)
+}
+
 // KubernetesSyncManifestHandler is called for each sync event.
 type KubernetesSyncManifestHandler func(*management.KubernetesSyncManifestResponse) error
 
@@ -345,3 +434,45 @@ func (client *ClusterClient) KubernetesSyncManifests(ctx context.Context, dryRun
 		}
 	}
 }
+
+// ClusterLogsHandler is called for each log line of the cluster machines.
+type ClusterLogsHandler func(*management.ClusterLogsResponse) error
+
+// ClusterLogs reads the logs of the cluster machines, ordered by the time of the lines.
+//
+// The tail lines limit is applied to each machine, negative value returns all lines.
+func (client *ClusterClient) ClusterLogs(ctx context.Context, follow bool, tailLines int32, handler ClusterLogsHandler, opts ...ClusterLogsOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "context", client.clusterName)
+
+	req := &management.ClusterLogsRequest{
+		Follow:    follow,
+		TailLines: tailLines,
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	cli, err := client.client.conn.ClusterLogs(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := cli.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = handler(msg); err != nil {
+			return err
+		}
+	}
+}
@@ -73,3 +73,20 @@ const CertificateValidityTime = time.Hour * 24 * 365 // 1 year
 
 // KubernetesAdminCertCommonName is the common name of the Kubernetes admin certificate.
 const KubernetesAdminCertCommonName = "omni:admin"
+
+const (
+	// WorkloadProxyHostPrefix is the prefix used to distinguish subdomain requests which should be proxied to the workload clusters.
+	//
+	// tsgen:workloadProxyHostPrefix
+	WorkloadProxyHostPrefix = "p"
+
+	// WorkloadProxyPublicKeyIDCookie is the name of the cookie used for workload proxy request authentication that contains the public key ID.
+	//
+	// tsgen:workloadProxyPublicKeyIdCookie
+	WorkloadProxyPublicKeyIDCookie = "publicKeyId"
+
+	// WorkloadProxyPublicKeyIDSignatureBase64Cookie is the name of the cookie used for workload proxy request authentication that contains the signed & base64'd public key ID.
+	//
+	// tsgen:workloadProxyPublicKeyIdSignatureBase64Cookie
+	WorkloadProxyPublicKeyIDSignatureBase64Cookie = "publicKeyIdSignatureBase64"
+)
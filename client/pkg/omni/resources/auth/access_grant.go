@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewAccessGrant creates new AccessGrant resource.
+func NewAccessGrant(id resource.ID) *AccessGrant {
+	return typed.NewResource[AccessGrantSpec, AccessGrantExtension](
+		resource.NewMetadata(resources.DefaultNamespace, AccessGrantType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.AccessGrantSpec{}),
+	)
+}
+
+const (
+	// AccessGrantType is the type of AccessGrant resource.
+	//
+	// tsgen:AccessGrantType
+	AccessGrantType = resource.Type("AccessGrants.omni.sidero.dev")
+)
+
+// AccessGrant resource describes a time-bound role elevation of an identity on the clusters.
+type AccessGrant = typed.Resource[AccessGrantSpec, AccessGrantExtension]
+
+// AccessGrantSpec wraps specs.AccessGrantSpec.
+type AccessGrantSpec = protobuf.ResourceSpec[specs.AccessGrantSpec, *specs.AccessGrantSpec]
+
+// AccessGrantExtension providers auxiliary methods for AccessGrant resource.
+type AccessGrantExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (AccessGrantExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AccessGrantType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Identity",
+				JSONPath: "{.identity}",
+			},
+			{
+				Name:     "Role",
+				JSONPath: "{.role}",
+			},
+			{
+				Name:     "Expiration",
+				JSONPath: "{.expiration}",
+			},
+		},
+	}
+}
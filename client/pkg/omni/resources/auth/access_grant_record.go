@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewAccessGrantRecord creates new AccessGrantRecord resource.
+func NewAccessGrantRecord(id resource.ID) *AccessGrantRecord {
+	return typed.NewResource[AccessGrantRecordSpec, AccessGrantRecordExtension](
+		resource.NewMetadata(resources.DefaultNamespace, AccessGrantRecordType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.AccessGrantRecordSpec{}),
+	)
+}
+
+const (
+	// AccessGrantRecordType is the type of AccessGrantRecord resource.
+	//
+	// tsgen:AccessGrantRecordType
+	AccessGrantRecordType = resource.Type("AccessGrantRecords.omni.sidero.dev")
+)
+
+// AccessGrantRecord resource keeps the history of an AccessGrant: when it was granted and when it was revoked.
+type AccessGrantRecord = typed.Resource[AccessGrantRecordSpec, AccessGrantRecordExtension]
+
+// AccessGrantRecordSpec wraps specs.AccessGrantRecordSpec.
+type AccessGrantRecordSpec = protobuf.ResourceSpec[specs.AccessGrantRecordSpec, *specs.AccessGrantRecordSpec]
+
+// AccessGrantRecordExtension providers auxiliary methods for AccessGrantRecord resource.
+type AccessGrantRecordExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (AccessGrantRecordExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             AccessGrantRecordType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Identity",
+				JSONPath: "{.identity}",
+			},
+			{
+				Name:     "Role",
+				JSONPath: "{.role}",
+			},
+			{
+				Name:     "Revoked",
+				JSONPath: "{.revokedat}",
+			},
+		},
+	}
+}
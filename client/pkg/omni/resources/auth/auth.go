@@ -13,6 +13,8 @@ func init() {
 	registry.MustRegisterResource(PublicKeyType, &PublicKey{})
 	registry.MustRegisterResource(UserType, &User{})
 	registry.MustRegisterResource(AccessPolicyType, &AccessPolicy{})
+	registry.MustRegisterResource(AccessGrantType, &AccessGrant{})
+	registry.MustRegisterResource(AccessGrantRecordType, &AccessGrantRecord{})
 	registry.MustRegisterResource(SAMLAssertionType, &SAMLAssertion{})
 	registry.MustRegisterResource(SAMLLabelRuleType, &SAMLLabelRule{})
 }
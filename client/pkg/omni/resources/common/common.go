@@ -10,6 +10,7 @@ import (
 
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 )
 
 // UserManagedResourceTypes is a list of resource types that are managed by the user.
@@ -17,14 +18,18 @@ var UserManagedResourceTypes = []resource.Type{
 	authres.IdentityType,
 	authres.UserType,
 	authres.AccessPolicyType,
+	authres.AccessGrantType,
 	authres.SAMLLabelRuleType,
 	omni.ClusterType,
 	omni.ConfigPatchType,
+	omni.ConfigPatchLibraryType,
 	omni.EtcdManualBackupType,
+	omni.EtcdRestoreType,
 	omni.MachineClassType,
 	omni.MachineLabelsType,
 	omni.MachineSetType,
 	omni.MachineSetNodeType,
 	omni.EtcdBackupS3ConfType,
 	omni.ExtensionsConfigurationType,
+	siderolink.JoinTokenType,
 }
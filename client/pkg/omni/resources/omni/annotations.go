@@ -20,4 +20,12 @@ const (
 	// ConfigPatchDescription human readable patch description.
 	// tsgen:ConfigPatchDescription
 	ConfigPatchDescription = "description"
+
+	// MachineSetStatusObservedVersion is the version of the MachineSet which was used to build the MachineSetStatus.
+	MachineSetStatusObservedVersion = SystemLabelPrefix + "observed-machine-set-version"
+
+	// ClusterMachinePendingReplacement marks the outdated cluster machine which is going to be replaced by a fresh machine
+	// instead of being updated in place, set by the surge rolling update.
+	// tsgen:ClusterMachinePendingReplacement
+	ClusterMachinePendingReplacement = SystemLabelPrefix + "pending-replacement"
 )
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterMachineDrainStatus creates new ClusterMachineDrainStatus resource.
+func NewClusterMachineDrainStatus(ns string, id resource.ID) *ClusterMachineDrainStatus {
+	return typed.NewResource[ClusterMachineDrainStatusSpec, ClusterMachineDrainStatusExtension](
+		resource.NewMetadata(ns, ClusterMachineDrainStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterMachineDrainStatusSpec{}),
+	)
+}
+
+const (
+	// ClusterMachineDrainStatusType is the type of the ClusterMachineDrainStatus resource.
+	// tsgen:ClusterMachineDrainStatusType
+	ClusterMachineDrainStatusType = resource.Type("ClusterMachineDrainStatuses.omni.sidero.dev")
+)
+
+// ClusterMachineDrainStatus describes the progress of the Kubernetes node drain of the cluster machine.
+type ClusterMachineDrainStatus = typed.Resource[ClusterMachineDrainStatusSpec, ClusterMachineDrainStatusExtension]
+
+// ClusterMachineDrainStatusSpec wraps specs.ClusterMachineDrainStatusSpec.
+type ClusterMachineDrainStatusSpec = protobuf.ResourceSpec[specs.ClusterMachineDrainStatusSpec, *specs.ClusterMachineDrainStatusSpec]
+
+// ClusterMachineDrainStatusExtension provides auxiliary methods for ClusterMachineDrainStatus resource.
+type ClusterMachineDrainStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ClusterMachineDrainStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterMachineDrainStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Node",
+				JSONPath: "{.nodename}",
+			},
+			{
+				Name:     "Remaining",
+				JSONPath: "{.podsremaining}",
+			},
+		},
+	}
+}
@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterUpgradeChannelStatus creates new ClusterUpgradeChannelStatus state.
+func NewClusterUpgradeChannelStatus(ns, id string) *ClusterUpgradeChannelStatus {
+	return typed.NewResource[ClusterUpgradeChannelStatusSpec, ClusterUpgradeChannelStatusExtension](
+		resource.NewMetadata(ns, ClusterUpgradeChannelStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterUpgradeChannelStatusSpec{}),
+	)
+}
+
+// ClusterUpgradeChannelStatusType is a resource type that contains the state of the automatic upgrades of the cluster.
+//
+// tsgen:ClusterUpgradeChannelStatusType
+const ClusterUpgradeChannelStatusType = resource.Type("ClusterUpgradeChannelStatuses.omni.sidero.dev")
+
+// ClusterUpgradeChannelStatus is a resource type that contains the state of the automatic upgrades of the cluster.
+type ClusterUpgradeChannelStatus = typed.Resource[ClusterUpgradeChannelStatusSpec, ClusterUpgradeChannelStatusExtension]
+
+// ClusterUpgradeChannelStatusSpec wraps specs.ClusterUpgradeChannelStatusSpec.
+type ClusterUpgradeChannelStatusSpec = protobuf.ResourceSpec[specs.ClusterUpgradeChannelStatusSpec, *specs.ClusterUpgradeChannelStatusSpec]
+
+// ClusterUpgradeChannelStatusExtension providers auxiliary methods for ClusterUpgradeChannelStatus resource.
+type ClusterUpgradeChannelStatusExtension struct{}
+
+// ResourceDefinition implements typed.ResourceDefinition interface.
+func (ClusterUpgradeChannelStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterUpgradeChannelStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Talos",
+				JSONPath: "{.talosversion}",
+			},
+			{
+				Name:     "Kubernetes",
+				JSONPath: "{.kubernetesversion}",
+			},
+			{
+				Name:     "Status",
+				JSONPath: "{.status}",
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewConfigPatchLibrary creates new ConfigPatchLibrary resource.
+func NewConfigPatchLibrary(ns string, id resource.ID) *ConfigPatchLibrary {
+	return typed.NewResource[ConfigPatchLibrarySpec, ConfigPatchLibraryExtension](
+		resource.NewMetadata(ns, ConfigPatchLibraryType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ConfigPatchLibrarySpec{}),
+	)
+}
+
+const (
+	// ConfigPatchLibraryType is the type of the ConfigPatchLibrary resource.
+	// tsgen:ConfigPatchLibraryType
+	ConfigPatchLibraryType = resource.Type("ConfigPatchLibraries.omni.sidero.dev")
+)
+
+// ConfigPatchLibrary describes the config patch shared between the clusters and machine sets.
+//
+// The clusters and machine sets reference the library by name or by label selectors,
+// every change of the library is rolled out to the machines referencing it, unless the reference pins the library version.
+type ConfigPatchLibrary = typed.Resource[ConfigPatchLibrarySpec, ConfigPatchLibraryExtension]
+
+// ConfigPatchLibrarySpec wraps specs.ConfigPatchLibrarySpec.
+type ConfigPatchLibrarySpec = protobuf.ResourceSpec[specs.ConfigPatchLibrarySpec, *specs.ConfigPatchLibrarySpec]
+
+// ConfigPatchLibraryExtension provides auxiliary methods for ConfigPatchLibrary resource.
+type ConfigPatchLibraryExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ConfigPatchLibraryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigPatchLibraryType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Description",
+				JSONPath: "{.description}",
+			},
+		},
+		Sensitivity: meta.Sensitive,
+	}
+}
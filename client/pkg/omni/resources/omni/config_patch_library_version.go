@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewConfigPatchLibraryVersion creates new ConfigPatchLibraryVersion resource.
+func NewConfigPatchLibraryVersion(ns string, id resource.ID) *ConfigPatchLibraryVersion {
+	return typed.NewResource[ConfigPatchLibraryVersionSpec, ConfigPatchLibraryVersionExtension](
+		resource.NewMetadata(ns, ConfigPatchLibraryVersionType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ConfigPatchLibraryVersionSpec{}),
+	)
+}
+
+const (
+	// ConfigPatchLibraryVersionType is the type of the ConfigPatchLibraryVersion resource.
+	// tsgen:ConfigPatchLibraryVersionType
+	ConfigPatchLibraryVersionType = resource.Type("ConfigPatchLibraryVersions.omni.sidero.dev")
+)
+
+// ConfigPatchLibraryVersion keeps the config patch of the ConfigPatchLibrary at some version.
+//
+// A new version is created on every change of the library data, the versions are kept until the library is removed,
+// so the clusters and machine sets can pin the library to one of its versions.
+type ConfigPatchLibraryVersion = typed.Resource[ConfigPatchLibraryVersionSpec, ConfigPatchLibraryVersionExtension]
+
+// ConfigPatchLibraryVersionSpec wraps specs.ConfigPatchLibraryVersionSpec.
+type ConfigPatchLibraryVersionSpec = protobuf.ResourceSpec[specs.ConfigPatchLibraryVersionSpec, *specs.ConfigPatchLibraryVersionSpec]
+
+// ConfigPatchLibraryVersionExtension provides auxiliary methods for ConfigPatchLibraryVersion resource.
+type ConfigPatchLibraryVersionExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ConfigPatchLibraryVersionExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigPatchLibraryVersionType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Version",
+				JSONPath: "{.version}",
+			},
+		},
+		Sensitivity: meta.Sensitive,
+	}
+}
+
+// ConfigPatchLibraryVersionID returns the ID of the version of the config patch library.
+func ConfigPatchLibraryVersionID(library resource.ID, version uint32) resource.ID {
+	return fmt.Sprintf("%s-v%d", library, version)
+}
@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewEtcdRestore creates new etcd restore resource.
+//
+// The ID of the resource is the ID of the cluster to restore.
+func NewEtcdRestore(id resource.ID) *EtcdRestore {
+	return typed.NewResource[EtcdRestoreSpec, EtcdRestoreExtension](
+		resource.NewMetadata(resources.DefaultNamespace, EtcdRestoreType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.EtcdRestoreSpec{}),
+	)
+}
+
+const (
+	// EtcdRestoreType is the type of the EtcdRestore resource.
+	// tsgen:EtcdRestoreType
+	EtcdRestoreType = resource.Type("EtcdRestores.omni.sidero.dev")
+)
+
+// EtcdRestore describes requested in-place etcd restore of an existing cluster.
+type EtcdRestore = typed.Resource[EtcdRestoreSpec, EtcdRestoreExtension]
+
+// EtcdRestoreSpec wraps specs.EtcdRestoreSpec.
+type EtcdRestoreSpec = protobuf.ResourceSpec[specs.EtcdRestoreSpec, *specs.EtcdRestoreSpec]
+
+// EtcdRestoreExtension provides auxiliary methods for EtcdRestore resource.
+type EtcdRestoreExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (EtcdRestoreExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EtcdRestoreType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Cluster UUID",
+				JSONPath: "{.clusteruuid}",
+			},
+			{
+				Name:     "Snapshot",
+				JSONPath: "{.snapshot}",
+			},
+		},
+	}
+}
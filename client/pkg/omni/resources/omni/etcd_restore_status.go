@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewEtcdRestoreStatus creates new etcd restore status resource.
+func NewEtcdRestoreStatus(id resource.ID) *EtcdRestoreStatus {
+	return typed.NewResource[EtcdRestoreStatusSpec, EtcdRestoreStatusExtension](
+		resource.NewMetadata(resources.DefaultNamespace, EtcdRestoreStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.EtcdRestoreStatusSpec{}),
+	)
+}
+
+const (
+	// EtcdRestoreStatusType is the type of the EtcdRestoreStatus resource.
+	// tsgen:EtcdRestoreStatusType
+	EtcdRestoreStatusType = resource.Type("EtcdRestoreStatuses.omni.sidero.dev")
+)
+
+// EtcdRestoreStatus describes the progress of the in-place etcd restore.
+type EtcdRestoreStatus = typed.Resource[EtcdRestoreStatusSpec, EtcdRestoreStatusExtension]
+
+// EtcdRestoreStatusSpec wraps specs.EtcdRestoreStatusSpec.
+type EtcdRestoreStatusSpec = protobuf.ResourceSpec[specs.EtcdRestoreStatusSpec, *specs.EtcdRestoreStatusSpec]
+
+// EtcdRestoreStatusExtension provides auxiliary methods for EtcdRestoreStatus resource.
+type EtcdRestoreStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (EtcdRestoreStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EtcdRestoreStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Step",
+				JSONPath: "{.step}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
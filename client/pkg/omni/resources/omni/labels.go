@@ -53,6 +53,10 @@ const (
 	// LabelExposedServiceAlias is the alias of the exposed service.
 	// tsgen:LabelExposedServiceAlias
 	LabelExposedServiceAlias = SystemLabelPrefix + "exposed-service-alias"
+
+	// LabelConfigPatchLibrary defines the config patch library relation label.
+	// tsgen:LabelConfigPatchLibrary
+	LabelConfigPatchLibrary = SystemLabelPrefix + "config-patch-library"
 )
 
 const (
@@ -128,6 +132,11 @@ const (
 	// MachineStatusLabelInstance describes the machine instance type (for machines running in the clouds).
 	// tsgen:MachineStatusLabelInstance
 	MachineStatusLabelInstance = SystemLabelPrefix + "instance"
+
+	// MachineStatusLabelPendingAcceptance is set if the machine has joined, but it is not accepted yet.
+	// The label is set on the Link and Machine resources as well.
+	// tsgen:MachineStatusLabelPendingAcceptance
+	MachineStatusLabelPendingAcceptance = SystemLabelPrefix + "pending-acceptance"
 )
 
 const (
@@ -144,3 +153,18 @@ const (
 	// MachineAddressLabel is used for faster lookup of the machine by address.
 	MachineAddressLabel = SystemLabelPrefix + "address"
 )
+
+const (
+	// Join token labels.
+
+	// LabelJoinToken is set on the Link to the ID of the JoinToken the machine has joined with.
+	LabelJoinToken = SystemLabelPrefix + "join-token"
+
+	// LabelTargetCluster is set on the machines which joined with a JoinToken meant for a cluster.
+	// tsgen:LabelTargetCluster
+	LabelTargetCluster = SystemLabelPrefix + "target-cluster"
+
+	// LabelTargetMachineClass is set on the machines which joined with a JoinToken meant for a machine class.
+	// tsgen:LabelTargetMachineClass
+	LabelTargetMachineClass = SystemLabelPrefix + "target-machine-class"
+)
@@ -5,6 +5,11 @@
 package omni
 
 import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -46,3 +51,145 @@ func (MachineClassExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 		PrintColumns:     []meta.PrintColumn{},
 	}
 }
+
+// machineClassDiskTypes are the disk types which can be used in the machine class requirements.
+var machineClassDiskTypes = []string{"hdd", "ssd", "nvme", "sd", "cd"}
+
+// ValidateMachineClassRequirements checks that the machine class requirements are valid.
+func ValidateMachineClassRequirements(requirements *specs.MachineClassSpec_Requirements) error {
+	for _, disk := range requirements.GetDisks() {
+		if disk.Type != "" && !slices.Contains(machineClassDiskTypes, strings.ToLower(disk.Type)) {
+			return fmt.Errorf("unknown disk type %q, should be one of %s", disk.Type, strings.Join(machineClassDiskTypes, ", "))
+		}
+
+		if _, err := path.Match(disk.Model, ""); err != nil {
+			return fmt.Errorf("invalid disk model pattern %q: %w", disk.Model, err)
+		}
+	}
+
+	return nil
+}
+
+// MachineClassRequirementsMismatches returns the list of reasons why the machine doesn't satisfy the machine class requirements.
+//
+// The machine satisfies the requirements if the list is empty.
+//
+//nolint:gocognit,gocyclo,cyclop
+func MachineClassRequirementsMismatches(requirements *specs.MachineClassSpec_Requirements, machineStatus *MachineStatus) []string {
+	if requirements == nil {
+		return nil
+	}
+
+	var reasons []string
+
+	hardware := machineStatus.TypedSpec().Value.GetHardware()
+
+	if requirements.MinCores > 0 {
+		var cores uint32
+
+		for _, processor := range hardware.GetProcessors() {
+			cores += processor.GetCoreCount()
+		}
+
+		if cores < requirements.MinCores {
+			reasons = append(reasons, fmt.Sprintf("has %d CPU cores, at least %d required", cores, requirements.MinCores))
+		}
+	}
+
+	if requirements.MinMemoryMb > 0 {
+		var memory uint64
+
+		for _, module := range hardware.GetMemoryModules() {
+			memory += uint64(module.GetSizeMb())
+		}
+
+		if memory < requirements.MinMemoryMb {
+			reasons = append(reasons, fmt.Sprintf("has %d MB of memory, at least %d MB required", memory, requirements.MinMemoryMb))
+		}
+	}
+
+	for _, disk := range requirements.Disks {
+		count := max(disk.Count, 1)
+
+		var matching uint32
+
+		for _, blockDevice := range hardware.GetBlockdevices() {
+			if diskMatches(disk, blockDevice) {
+				matching++
+			}
+		}
+
+		if matching < count {
+			reasons = append(reasons, fmt.Sprintf("has %d disks matching %s, at least %d required", matching, describeDisk(disk), count))
+		}
+	}
+
+	if requirements.MinNicSpeedMbps > 0 {
+		var speed uint32
+
+		for _, link := range machineStatus.TypedSpec().Value.GetNetwork().GetNetworkLinks() {
+			speed = max(speed, link.GetSpeedMbps())
+		}
+
+		if speed < requirements.MinNicSpeedMbps {
+			reasons = append(reasons, fmt.Sprintf("the fastest network link is %d Mbps, at least %d Mbps required", speed, requirements.MinNicSpeedMbps))
+		}
+	}
+
+	platformMetadata := machineStatus.TypedSpec().Value.GetPlatformMetadata()
+
+	if len(requirements.Regions) > 0 && !slices.Contains(requirements.Regions, platformMetadata.GetRegion()) {
+		reasons = append(reasons, fmt.Sprintf("region %q is not one of %s", platformMetadata.GetRegion(), strings.Join(requirements.Regions, ", ")))
+	}
+
+	if len(requirements.Zones) > 0 && !slices.Contains(requirements.Zones, platformMetadata.GetZone()) {
+		reasons = append(reasons, fmt.Sprintf("zone %q is not one of %s", platformMetadata.GetZone(), strings.Join(requirements.Zones, ", ")))
+	}
+
+	if len(requirements.Archs) > 0 && !slices.Contains(requirements.Archs, hardware.GetArch()) {
+		reasons = append(reasons, fmt.Sprintf("arch %q is not one of %s", hardware.GetArch(), strings.Join(requirements.Archs, ", ")))
+	}
+
+	return reasons
+}
+
+func diskMatches(disk *specs.MachineClassSpec_Requirements_Disk, blockDevice *specs.MachineStatusSpec_HardwareStatus_BlockDevice) bool {
+	if disk.Type != "" && !strings.EqualFold(disk.Type, blockDevice.GetType()) {
+		return false
+	}
+
+	if blockDevice.GetSize() < disk.MinSizeGb*1000*1000*1000 {
+		return false
+	}
+
+	if disk.Model != "" {
+		matches, err := path.Match(disk.Model, strings.TrimSpace(blockDevice.GetModel()))
+		if err != nil || !matches {
+			return false
+		}
+	}
+
+	return true
+}
+
+func describeDisk(disk *specs.MachineClassSpec_Requirements_Disk) string {
+	var conditions []string
+
+	if disk.Type != "" {
+		conditions = append(conditions, "type "+strings.ToLower(disk.Type))
+	}
+
+	if disk.MinSizeGb > 0 {
+		conditions = append(conditions, fmt.Sprintf("size >= %dGB", disk.MinSizeGb))
+	}
+
+	if disk.Model != "" {
+		conditions = append(conditions, fmt.Sprintf("model %q", disk.Model))
+	}
+
+	if len(conditions) == 0 {
+		return "any"
+	}
+
+	return "(" + strings.Join(conditions, ", ") + ")"
+}
@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestMachineClassRequirementsMismatches(t *testing.T) {
+	t.Parallel()
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine")
+	machineStatus.TypedSpec().Value = &specs.MachineStatusSpec{
+		Hardware: &specs.MachineStatusSpec_HardwareStatus{
+			Arch: "amd64",
+			Processors: []*specs.MachineStatusSpec_HardwareStatus_Processor{
+				{CoreCount: 4},
+				{CoreCount: 4},
+			},
+			MemoryModules: []*specs.MachineStatusSpec_HardwareStatus_MemoryModule{
+				{SizeMb: 16384},
+				{SizeMb: 16384},
+			},
+			Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+				{Type: "NVME", Size: 2e12, Model: "Samsung SSD 990 PRO 2TB "},
+				{Type: "NVME", Size: 1e12, Model: "Micron 7450"},
+				{Type: "HDD", Size: 8e12, Model: "WDC WD80EFZZ"},
+			},
+		},
+		Network: &specs.MachineStatusSpec_NetworkStatus{
+			NetworkLinks: []*specs.MachineStatusSpec_NetworkStatus_NetworkLinkStatus{
+				{SpeedMbps: 1000},
+				{SpeedMbps: 25000},
+			},
+		},
+		PlatformMetadata: &specs.MachineStatusSpec_PlatformMetadata{
+			Region: "eu-central-1",
+			Zone:   "eu-central-1a",
+		},
+	}
+
+	for _, test := range []struct {
+		name         string
+		requirements *specs.MachineClassSpec_Requirements
+		expected     []string
+	}{
+		{
+			name: "no requirements",
+		},
+		{
+			name: "satisfied",
+			requirements: &specs.MachineClassSpec_Requirements{
+				MinCores:    8,
+				MinMemoryMb: 32768,
+				Disks: []*specs.MachineClassSpec_Requirements_Disk{
+					{Type: "nvme", MinSizeGb: 1000, Count: 2},
+					{Model: "Samsung*"},
+				},
+				MinNicSpeedMbps: 10000,
+				Regions:         []string{"eu-central-1", "eu-west-1"},
+				Zones:           []string{"eu-central-1a"},
+				Archs:           []string{"amd64"},
+			},
+		},
+		{
+			name: "not satisfied",
+			requirements: &specs.MachineClassSpec_Requirements{
+				MinCores:    16,
+				MinMemoryMb: 65536,
+				Disks: []*specs.MachineClassSpec_Requirements_Disk{
+					{Type: "nvme", MinSizeGb: 2000, Count: 2},
+					{Type: "ssd"},
+				},
+				MinNicSpeedMbps: 100000,
+				Regions:         []string{"us-east-1"},
+				Zones:           []string{"us-east-1a"},
+				Archs:           []string{"arm64"},
+			},
+			expected: []string{
+				"has 8 CPU cores, at least 16 required",
+				"has 32768 MB of memory, at least 65536 MB required",
+				"has 1 disks matching (type nvme, size >= 2000GB), at least 2 required",
+				"has 0 disks matching (type ssd), at least 1 required",
+				"the fastest network link is 25000 Mbps, at least 100000 Mbps required",
+				`region "eu-central-1" is not one of us-east-1`,
+				`zone "eu-central-1a" is not one of us-east-1a`,
+				`arch "amd64" is not one of arm64`,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, omni.MachineClassRequirementsMismatches(test.requirements, machineStatus))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // the maintenance window time zones should not depend on the host time zone database
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// maxMaintenanceWindowSearch limits the search of the next maintenance window start.
+const maxMaintenanceWindowSearch = 5 * 366 * 24 * time.Hour
+
+// MaintenanceSchedule is the parsed maintenance window.
+type MaintenanceSchedule struct {
+	location *time.Location
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+	duration time.Duration
+
+	// the day matches if either day of month or day of week matches when both are restricted, as in cron
+	anyDay bool
+}
+
+// ParseMaintenanceWindow parses the maintenance window, returns nil if the window is not set.
+func ParseMaintenanceWindow(window *specs.MaintenanceWindow) (*MaintenanceSchedule, error) {
+	if window == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	if window.GetDuration().AsDuration() <= 0 {
+		return nil, fmt.Errorf("maintenance window duration should be positive")
+	}
+
+	location, err := time.LoadLocation(window.GetTimezone())
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window timezone: %w", err)
+	}
+
+	fields := strings.Fields(window.GetSchedule())
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("maintenance window schedule %q should have 5 fields: minute hour day-of-month month day-of-week", window.GetSchedule())
+	}
+
+	schedule := &MaintenanceSchedule{
+		location: location,
+		duration: window.GetDuration().AsDuration(),
+		anyDay:   fields[2] != "*" && fields[4] != "*",
+	}
+
+	for i, field := range []struct {
+		value    *uint64
+		name     string
+		min, max int
+	}{
+		{name: "minute", value: &schedule.minutes, min: 0, max: 59},
+		{name: "hour", value: &schedule.hours, min: 0, max: 23},
+		{name: "day of month", value: &schedule.days, min: 1, max: 31},
+		{name: "month", value: &schedule.months, min: 1, max: 12},
+		{name: "day of week", value: &schedule.weekdays, min: 0, max: 7},
+	} {
+		*field.value, err = parseScheduleField(fields[i], field.min, field.max)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window %s %q: %w", field.name, fields[i], err)
+		}
+	}
+
+	// 7 is Sunday as well as 0
+	if schedule.weekdays&(1<<7) != 0 {
+		schedule.weekdays |= 1
+	}
+
+	return schedule, nil
+}
+
+func parseScheduleField(field string, minValue, maxValue int) (uint64, error) {
+	var result uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+
+		if hasStep {
+			var err error
+
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+
+		start, end := minValue, maxValue
+
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+
+			if start, err = strconv.Atoi(from); err != nil {
+				return 0, fmt.Errorf("invalid value %q", from)
+			}
+
+			end = start
+
+			if isRange {
+				if end, err = strconv.Atoi(to); err != nil {
+					return 0, fmt.Errorf("invalid value %q", to)
+				}
+			} else if hasStep {
+				end = maxValue
+			}
+		}
+
+		if start < minValue || end > maxValue || start > end {
+			return 0, fmt.Errorf("%q is out of range %d-%d", rangePart, minValue, maxValue)
+		}
+
+		for v := start; v <= end; v += step {
+			result |= 1 << v
+		}
+	}
+
+	return result, nil
+}
+
+// Open returns whether the maintenance window is open at the given time.
+//
+// If the window is open, the second return value is the time when it closes, otherwise the time when it opens next.
+// Zero time is returned if the window never opens.
+func (schedule *MaintenanceSchedule) Open(now time.Time) (bool, time.Time) {
+	// the most recent window which could still be open has started after now - duration
+	start := schedule.next(now.Add(-schedule.duration))
+
+	if !start.IsZero() && !start.After(now) {
+		return true, start.Add(schedule.duration)
+	}
+
+	return false, start
+}
+
+// next returns the first minute matching the schedule strictly after the given time.
+func (schedule *MaintenanceSchedule) next(after time.Time) time.Time {
+	t := after.In(schedule.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxMaintenanceWindowSearch)
+
+	for t.Before(limit) {
+		switch {
+		case schedule.months&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, schedule.location)
+		case !schedule.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, schedule.location)
+		case schedule.hours&(1<<t.Hour()) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, schedule.location)
+		case schedule.minutes&(1<<t.Minute()) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (schedule *MaintenanceSchedule) dayMatches(t time.Time) bool {
+	dayMatches := schedule.days&(1<<t.Day()) != 0
+	weekdayMatches := schedule.weekdays&(1<<int(t.Weekday())) != 0
+
+	if schedule.anyDay {
+		return dayMatches || weekdayMatches
+	}
+
+	return dayMatches && weekdayMatches
+}
+
+// GetMaintenanceWindow returns the maintenance window which applies to the machine set, the machine set window overrides the cluster one.
+func GetMaintenanceWindow(cluster *Cluster, machineSet *MachineSet) *specs.MaintenanceWindow {
+	if machineSet != nil && machineSet.TypedSpec().Value.MaintenanceWindow != nil {
+		return machineSet.TypedSpec().Value.MaintenanceWindow
+	}
+
+	if cluster != nil {
+		return cluster.TypedSpec().Value.MaintenanceWindow
+	}
+
+	return nil
+}
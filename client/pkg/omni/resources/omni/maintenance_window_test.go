@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// Saturday and Sunday, 02:00-06:00 Berlin time
+	schedule, err := omni.ParseMaintenanceWindow(&specs.MaintenanceWindow{
+		Schedule: "0 2 * * 6,7",
+		Duration: durationpb.New(4 * time.Hour),
+		Timezone: "Europe/Berlin",
+	})
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name         string
+		now          time.Time
+		expectedNext time.Time
+		expectedOpen bool
+	}{
+		{
+			name:         "weekday",
+			now:          time.Date(2024, 3, 6, 12, 0, 0, 0, berlin),
+			expectedNext: time.Date(2024, 3, 9, 2, 0, 0, 0, berlin),
+		},
+		{
+			name:         "open",
+			now:          time.Date(2024, 3, 9, 3, 30, 0, 0, berlin),
+			expectedOpen: true,
+			expectedNext: time.Date(2024, 3, 9, 6, 0, 0, 0, berlin),
+		},
+		{
+			name:         "open in UTC",
+			now:          time.Date(2024, 3, 10, 1, 0, 0, 0, time.UTC),
+			expectedOpen: true,
+			expectedNext: time.Date(2024, 3, 10, 6, 0, 0, 0, berlin),
+		},
+		{
+			name:         "closed",
+			now:          time.Date(2024, 3, 10, 6, 0, 0, 0, berlin),
+			expectedNext: time.Date(2024, 3, 16, 2, 0, 0, 0, berlin),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			open, next := schedule.Open(test.now)
+
+			assert.Equal(t, test.expectedOpen, open)
+			assert.True(t, test.expectedNext.Equal(next), "expected %s, got %s", test.expectedNext, next)
+		})
+	}
+
+	for _, window := range []*specs.MaintenanceWindow{
+		{Schedule: "0 2 * *", Duration: durationpb.New(time.Hour)},
+		{Schedule: "60 2 * * *", Duration: durationpb.New(time.Hour)},
+		{Schedule: "*/0 2 * * *", Duration: durationpb.New(time.Hour)},
+		{Schedule: "0 2 * * *"},
+		{Schedule: "0 2 * * *", Duration: durationpb.New(time.Hour), Timezone: "Mars/Olympus"},
+	} {
+		_, err = omni.ParseMaintenanceWindow(window)
+		assert.Error(t, err, window.Schedule)
+	}
+}
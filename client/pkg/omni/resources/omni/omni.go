@@ -17,6 +17,7 @@ func init() {
 	registry.MustRegisterResource(ClusterUUIDType, &ClusterUUID{})
 	registry.MustRegisterResource(ClusterSecretsType, &ClusterSecrets{})
 	registry.MustRegisterResource(ClusterStatusType, &ClusterStatus{})
+	registry.MustRegisterResource(ClusterUpgradeChannelStatusType, &ClusterUpgradeChannelStatus{})
 	registry.MustRegisterResource(ClusterMachineType, &ClusterMachine{})
 	registry.MustRegisterResource(ClusterMachineEncryptionKeyType, &ClusterMachineEncryptionKey{})
 	registry.MustRegisterResource(ClusterMachineIdentityType, &ClusterMachineIdentity{})
@@ -24,9 +25,12 @@ func init() {
 	registry.MustRegisterResource(ClusterMachineConfigType, &ClusterMachineConfig{})
 	registry.MustRegisterResource(ClusterMachineConfigPatchesType, &ClusterMachineConfigPatches{})
 	registry.MustRegisterResource(ClusterMachineConfigStatusType, &ClusterMachineConfigStatus{})
+	registry.MustRegisterResource(ClusterMachineDrainStatusType, &ClusterMachineDrainStatus{})
 	registry.MustRegisterResource(ClusterMachineTalosVersionType, &ClusterMachineTalosVersion{})
 	registry.MustRegisterResource(ClusterMachineTemplateType, &ClusterMachineTemplate{})
 	registry.MustRegisterResource(ConfigPatchType, &ConfigPatch{})
+	registry.MustRegisterResource(ConfigPatchLibraryType, &ConfigPatchLibrary{})
+	registry.MustRegisterResource(ConfigPatchLibraryVersionType, &ConfigPatchLibraryVersion{})
 	registry.MustRegisterResource(EtcdAuditResultType, &EtcdAuditResult{})
 	registry.MustRegisterResource(EtcdBackupType, &EtcdBackup{})
 	registry.MustRegisterResource(EtcdBackupS3ConfType, &EtcdBackupS3Conf{})
@@ -38,6 +42,8 @@ func init() {
 	registry.MustRegisterResource(ExtensionsConfigurationStatusType, &ExtensionsConfigurationStatus{})
 	registry.MustRegisterResource(BackupDataType, &BackupData{})
 	registry.MustRegisterResource(EtcdManualBackupType, &EtcdManualBackup{})
+	registry.MustRegisterResource(EtcdRestoreType, &EtcdRestore{})
+	registry.MustRegisterResource(EtcdRestoreStatusType, &EtcdRestoreStatus{})
 	registry.MustRegisterResource(ExposedServiceType, &ExposedService{})
 	registry.MustRegisterResource(FeaturesConfigType, &FeaturesConfig{})
 	registry.MustRegisterResource(ImagePullRequestType, &ImagePullRequest{})
@@ -53,6 +59,7 @@ func init() {
 	registry.MustRegisterResource(MachineLabelsType, &MachineLabels{})
 	registry.MustRegisterResource(MachineType, &Machine{})
 	registry.MustRegisterResource(MachineClassType, &MachineClass{})
+	registry.MustRegisterResource(MachineClassStatusType, &MachineClassStatus{})
 	registry.MustRegisterResource(MachineConfigGenOptionsType, &MachineConfigGenOptions{})
 	registry.MustRegisterResource(MachineExtensionsType, &MachineExtensions{})
 	registry.MustRegisterResource(MachineSetType, &MachineSet{})
@@ -0,0 +1,80 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// UpgradeChannelStable is the upgrade channel which follows the latest release.
+const UpgradeChannelStable = "stable"
+
+// VersionChannel is the parsed Talos or Kubernetes version channel of the cluster upgrade channel.
+type VersionChannel struct {
+	major, minor uint64
+	stable       bool
+}
+
+// ParseVersionChannel parses the version channel, returns nil if the channel is not set.
+func ParseVersionChannel(channel string) (*VersionChannel, error) {
+	if channel == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	if channel == UpgradeChannelStable {
+		return &VersionChannel{stable: true}, nil
+	}
+
+	majorPart, minorPart, ok := strings.Cut(strings.TrimPrefix(channel, "v"), ".")
+	if !ok {
+		return nil, fmt.Errorf("upgrade channel %q should be either %q or a minor version like \"1.7\"", channel, UpgradeChannelStable)
+	}
+
+	major, err := strconv.ParseUint(majorPart, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("upgrade channel %q has invalid major version: %w", channel, err)
+	}
+
+	minor, err := strconv.ParseUint(minorPart, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("upgrade channel %q has invalid minor version: %w", channel, err)
+	}
+
+	return &VersionChannel{major: major, minor: minor}, nil
+}
+
+// Allows returns whether the version belongs to the channel.
+//
+// The pre-releases never belong to any channel.
+func (channel *VersionChannel) Allows(version semver.Version) bool {
+	if len(version.Pre) > 0 {
+		return false
+	}
+
+	if channel.stable {
+		return true
+	}
+
+	return version.Major == channel.major && version.Minor <= channel.minor
+}
+
+// ValidateUpgradeChannel checks that the Talos and Kubernetes channels of the upgrade channel are valid.
+func ValidateUpgradeChannel(upgradeChannel *specs.UpgradeChannel) error {
+	if _, err := ParseVersionChannel(upgradeChannel.GetTalos()); err != nil {
+		return fmt.Errorf("talos %w", err)
+	}
+
+	if _, err := ParseVersionChannel(upgradeChannel.GetKubernetes()); err != nil {
+		return fmt.Errorf("kubernetes %w", err)
+	}
+
+	return nil
+}
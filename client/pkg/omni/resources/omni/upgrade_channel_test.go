@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestVersionChannel(t *testing.T) {
+	t.Parallel()
+
+	channel, err := omni.ParseVersionChannel("")
+	require.NoError(t, err)
+	assert.Nil(t, channel)
+
+	for _, test := range []struct {
+		channel  string
+		allowed  []string
+		rejected []string
+	}{
+		{
+			channel:  "stable",
+			allowed:  []string{"1.6.7", "1.7.4", "2.0.0"},
+			rejected: []string{"1.8.0-alpha.1"},
+		},
+		{
+			channel:  "1.7",
+			allowed:  []string{"1.6.7", "1.7.0", "1.7.4"},
+			rejected: []string{"1.8.0", "2.0.0", "1.7.5-beta.0"},
+		},
+		{
+			channel:  "v1.29",
+			allowed:  []string{"1.29.3"},
+			rejected: []string{"1.30.0"},
+		},
+	} {
+		t.Run(test.channel, func(t *testing.T) {
+			t.Parallel()
+
+			channel, err := omni.ParseVersionChannel(test.channel)
+			require.NoError(t, err)
+
+			for _, version := range test.allowed {
+				assert.True(t, channel.Allows(semver.MustParse(version)), version)
+			}
+
+			for _, version := range test.rejected {
+				assert.False(t, channel.Allows(semver.MustParse(version)), version)
+			}
+		})
+	}
+
+	for _, channel := range []string{"latest", "1", "1.x", "a.7"} {
+		_, err = omni.ParseVersionChannel(channel)
+		assert.Error(t, err, channel)
+	}
+}
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package siderolink
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// NewJoinToken creates new JoinToken resource.
+func NewJoinToken(ns, id string) *JoinToken {
+	return typed.NewResource[JoinTokenSpec, JoinTokenExtension](
+		resource.NewMetadata(ns, JoinTokenType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.JoinTokenSpec{}),
+	)
+}
+
+// JoinTokenType is the type of JoinToken resource.
+//
+// tsgen:JoinTokenType
+const JoinTokenType = resource.Type("JoinTokens.omni.sidero.dev")
+
+// JoinTokenMinLength is the minimum length of the join token value.
+const JoinTokenMinLength = 32
+
+// JoinToken resource describes a join token which can be used instead of the instance-wide one.
+//
+// Each join token can be revoked or rotated independently, and the machines which join with it are labeled according to it.
+type JoinToken = typed.Resource[JoinTokenSpec, JoinTokenExtension]
+
+// JoinTokenSpec wraps specs.JoinTokenSpec.
+type JoinTokenSpec = protobuf.ResourceSpec[specs.JoinTokenSpec, *specs.JoinTokenSpec]
+
+// JoinTokenExtension providers auxiliary methods for JoinToken resource.
+type JoinTokenExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (JoinTokenExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             JoinTokenType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: Namespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Expiration",
+				JSONPath: "{.expirationtime}",
+			},
+			{
+				Name:     "Uses",
+				JSONPath: "{.uses}",
+			},
+			{
+				Name:     "MaxUses",
+				JSONPath: "{.maxuses}",
+			},
+			{
+				Name:     "Revoked",
+				JSONPath: "{.revoked}",
+			},
+		},
+		Sensitivity: meta.Sensitive,
+	}
+}
+
+// ValidateJoinToken checks that the join token can be used.
+func ValidateJoinToken(spec *specs.JoinTokenSpec) error {
+	if len(spec.Token) < JoinTokenMinLength {
+		return fmt.Errorf("join token should be at least %d characters long", JoinTokenMinLength)
+	}
+
+	if spec.Cluster != "" && spec.MachineClass != "" {
+		return fmt.Errorf("join token can't target both the cluster and the machine class")
+	}
+
+	return nil
+}
+
+// CheckJoinTokenUsable checks that the machines can join with the token at the given time.
+func CheckJoinTokenUsable(spec *specs.JoinTokenSpec, now time.Time) error {
+	switch {
+	case spec.Revoked:
+		return fmt.Errorf("join token is revoked")
+	case spec.ExpirationTime != nil && !now.Before(spec.ExpirationTime.AsTime()):
+		return fmt.Errorf("join token has expired")
+	case spec.MaxUses != 0 && spec.Uses >= spec.MaxUses:
+		return fmt.Errorf("join token has been used by %d machines out of %d allowed", spec.Uses, spec.MaxUses)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package siderolink
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// NewMachineAcceptance creates new MachineAcceptance resource.
+func NewMachineAcceptance(ns, id string) *MachineAcceptance {
+	return typed.NewResource[MachineAcceptanceSpec, MachineAcceptanceExtension](
+		resource.NewMetadata(ns, MachineAcceptanceType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineAcceptanceSpec{}),
+	)
+}
+
+// MachineAcceptanceType is the type of MachineAcceptance resource.
+//
+// tsgen:MachineAcceptanceType
+const MachineAcceptanceType = resource.Type("MachineAcceptances.omni.sidero.dev")
+
+// MachineAcceptance resource keeps the decision whether the machine is allowed to join the instance.
+//
+// MachineAcceptance resource ID is a machine UUID.
+// The machines without the MachineAcceptance resource are accepted, unless the machine approval is required.
+type MachineAcceptance = typed.Resource[MachineAcceptanceSpec, MachineAcceptanceExtension]
+
+// MachineAcceptanceSpec wraps specs.MachineAcceptanceSpec.
+type MachineAcceptanceSpec = protobuf.ResourceSpec[specs.MachineAcceptanceSpec, *specs.MachineAcceptanceSpec]
+
+// MachineAcceptanceExtension providers auxiliary methods for MachineAcceptance resource.
+type MachineAcceptanceExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineAcceptanceExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineAcceptanceType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: Namespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+		},
+	}
+}
@@ -22,6 +22,8 @@ func init() {
 	registry.MustRegisterResource(ConnectionParamsType, &ConnectionParams{})
 	registry.MustRegisterResource(ConfigType, &Config{})
 	registry.MustRegisterResource(LinkType, &Link{})
+	registry.MustRegisterResource(JoinTokenType, &JoinToken{})
+	registry.MustRegisterResource(MachineAcceptanceType, &MachineAcceptance{})
 
 	// NOTE: this resource is not used anymore, but still used in the migration code.
 	registry.MustRegisterResource(DeprecatedLinkCounterType, &DeprecatedLinkCounter{})
@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var auditCmdFlags struct {
+	since    string
+	until    string
+	identity string
+}
+
+// auditCmd represents the audit command.
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Read the audit log of the state-mutating API calls",
+	Long: `Read the audit log of the state-mutating API calls.
+
+Each entry is printed as a JSON object on a separate line.
+The --since and --until flags accept either a duration relative to the current time (e.g. 24h) or an RFC3339 timestamp.`,
+	Example: `  omnictl audit --since 24h --identity user@example.com`,
+	Args:    cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		now := time.Now()
+
+		since, err := parseTimeFlag(auditCmdFlags.since, now)
+		if err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+
+		until, err := parseTimeFlag(auditCmdFlags.until, now)
+		if err != nil {
+			return fmt.Errorf("invalid --until: %w", err)
+		}
+
+		return access.WithClient(func(ctx context.Context, client *client.Client) error {
+			auditLogReader, err := client.Management().AuditLogReader(ctx, since, until, auditCmdFlags.identity)
+			if err != nil {
+				return fmt.Errorf("failed to read audit log: %w", err)
+			}
+
+			_, err = io.Copy(os.Stdout, auditLogReader)
+
+			return err
+		})
+	},
+}
+
+// parseTimeFlag parses either a duration relative to now, or an RFC3339 timestamp.
+func parseTimeFlag(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func init() {
+	auditCmd.Flags().StringVar(&auditCmdFlags.since, "since", "", "show entries newer than a relative duration (e.g. 24h) or an RFC3339 timestamp")
+	auditCmd.Flags().StringVar(&auditCmdFlags.until, "until", "", "show entries older than a relative duration (e.g. 1h) or an RFC3339 timestamp")
+	auditCmd.Flags().StringVar(&auditCmdFlags.identity, "identity", "", "show only the entries of the given identity")
+
+	RootCmd.AddCommand(auditCmd)
+}
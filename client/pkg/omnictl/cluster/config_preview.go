@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var configPreviewCmdFlags struct {
+	resFile              string
+	talosVersion         string
+	kubernetesVersion    string
+	deletedConfigPatches []string
+}
+
+// configPreviewCmd represents the cluster config-preview command.
+var configPreviewCmd = &cobra.Command{
+	Use:   "config-preview cluster-name",
+	Short: "Preview the machine config changes of a cluster without applying them",
+	Long: `Render the machine configs of the cluster with the proposed changes applied and show the diff against the current configs.
+
+The changes can be config patches and machine sets to create or update (--file), config patches to delete (--delete-patch),
+and the Talos and Kubernetes version changes.
+For each affected machine the Talos apply mode is shown, along with whether the machine would reboot.`,
+	Example: `  omnictl cluster config-preview my-cluster -f patches.yaml --delete-patch 400-my-cluster-old-patch`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		req := &management.MachineConfigPreviewRequest{
+			DeletedConfigPatches: configPreviewCmdFlags.deletedConfigPatches,
+			TalosVersion:         configPreviewCmdFlags.talosVersion,
+			KubernetesVersion:    configPreviewCmdFlags.kubernetesVersion,
+		}
+
+		if configPreviewCmdFlags.resFile != "" {
+			yamlRaw, err := os.ReadFile(configPreviewCmdFlags.resFile)
+			if err != nil {
+				return fmt.Errorf("failed to read resource yaml file %q: %w", configPreviewCmdFlags.resFile, err)
+			}
+
+			req.Resources, err = splitYAMLDocuments(yamlRaw)
+			if err != nil {
+				return fmt.Errorf("failed to parse resource yaml file %q: %w", configPreviewCmdFlags.resFile, err)
+			}
+		}
+
+		return access.WithClient(configPreview(args[0], req))
+	},
+	SilenceUsage: true,
+}
+
+func configPreview(clusterName string, req *management.MachineConfigPreviewRequest) func(ctx context.Context, client *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		resp, err := client.Management().WithCluster(clusterName).MachineConfigPreview(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to preview the config changes of the cluster %q: %w", clusterName, err)
+		}
+
+		if len(resp.Machines) == 0 {
+			fmt.Println("No machine configs would change.")
+
+			return nil
+		}
+
+		for _, machine := range resp.Machines {
+			fmt.Printf("Machine %q (machine set %q)\n", machine.Id, machine.MachineSet)
+
+			if machine.ApplyMode != "" {
+				fmt.Printf("  apply mode: %s\n", machine.ApplyMode)
+			}
+
+			fmt.Printf("  reboot: %t\n", machine.Reboot)
+
+			if machine.TalosUpgrade {
+				fmt.Println("  talos upgrade: true")
+			}
+
+			if machine.Error != "" {
+				fmt.Printf("  error: %s\n", machine.Error)
+			}
+
+			if machine.Diff != "" {
+				fmt.Printf("\n%s\n", machine.Diff)
+			}
+
+			fmt.Println()
+		}
+
+		return nil
+	}
+}
+
+// splitYAMLDocuments splits a multi-document YAML into the individual documents.
+func splitYAMLDocuments(yamlRaw []byte) ([]string, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(yamlRaw))
+
+	var documents []string
+
+	for {
+		var node yaml.Node
+
+		err := dec.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		out, err := yaml.Marshal(&node)
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, string(out))
+	}
+
+	return documents, nil
+}
+
+func init() {
+	configPreviewCmd.Flags().StringVarP(&configPreviewCmdFlags.resFile, "file", "f", "", "file with the config patches and machine sets to create or update")
+	configPreviewCmd.Flags().StringSliceVar(&configPreviewCmdFlags.deletedConfigPatches, "delete-patch", nil, "IDs of the config patches to delete")
+	configPreviewCmd.Flags().StringVar(&configPreviewCmdFlags.talosVersion, "talos-version", "", "new Talos version of the cluster")
+	configPreviewCmd.Flags().StringVar(&configPreviewCmdFlags.kubernetesVersion, "kubernetes-version", "", "new Kubernetes version of the cluster")
+	clusterCmd.AddCommand(configPreviewCmd)
+}
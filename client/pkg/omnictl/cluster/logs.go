@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/client"
+	managementclient "github.com/siderolabs/omni/client/pkg/client/management"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+	"github.com/siderolabs/omni/client/pkg/omnictl/logformat"
+)
+
+var logsCmdFlags struct {
+	logFormat  string
+	machineSet string
+	selector   string
+	follow     bool
+	tailLines  int32
+}
+
+// logsCmd represents the cluster logs command.
+var logsCmd = &cobra.Command{
+	Use:   "logs cluster-name",
+	Short: "Get logs for all machines of a cluster",
+	Long: `Get logs of all machines of a cluster merged together in the time order.
+
+Each line is prefixed with the hostname and the role of the machine which produced it.
+The logs can be limited to the machines of a machine set, or to the machines matching a label selector.`,
+	Example: `  omnictl cluster logs my-cluster --machine-set my-cluster-workers --tail 100 --follow`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(logs(args[0]))
+	},
+	SilenceUsage: true,
+}
+
+func logs(clusterName string) func(ctx context.Context, client *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		writer := logformat.NewLineWriter(os.Stdout, logsCmdFlags.logFormat)
+
+		err := client.Management().WithCluster(clusterName).ClusterLogs(ctx, logsCmdFlags.follow, logsCmdFlags.tailLines,
+			func(resp *management.ClusterLogsResponse) error {
+				return writer.WriteLine(logPrefix(resp), resp.Data)
+			},
+			managementclient.WithClusterLogsMachineSet(logsCmdFlags.machineSet),
+			managementclient.WithClusterLogsSelector(logsCmdFlags.selector),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get logs of the cluster %q: %w", clusterName, err)
+		}
+
+		return nil
+	}
+}
+
+// logPrefix returns the prefix of the line with the hostname and the role of the machine, using the machine ID if the hostname is unknown.
+func logPrefix(resp *management.ClusterLogsResponse) string {
+	hostname := resp.Hostname
+	if hostname == "" {
+		hostname = resp.MachineId
+	}
+
+	return fmt.Sprintf("%s (%s): ", hostname, resp.Role)
+}
+
+func init() {
+	logsCmd.Flags().BoolVarP(&logsCmdFlags.follow, "follow", "f", false, "specify if the logs should be streamed")
+	logsCmd.Flags().Int32Var(&logsCmdFlags.tailLines, "tail", -1, "lines of log file to display from each machine (default is to show from the beginning)")
+	logsCmd.Flags().StringVar(&logsCmdFlags.machineSet, "machine-set", "", "show only the logs of the machines of the given machine set")
+	logsCmd.Flags().StringVarP(&logsCmdFlags.selector, "selector", "l", "", "show only the logs of the machines matching the label selector")
+	logsCmd.Flags().StringVar(&logsCmdFlags.logFormat, "log-format", "raw", "log format (raw, omni, dmesg) to display (default is to display in raw format)")
+	clusterCmd.AddCommand(logsCmd)
+}
@@ -32,6 +32,11 @@ var deleteCmd = &cobra.Command{
 }
 
 func deleteImpl(ctx context.Context, client *client.Client) error {
+	loadOpts, err := loadOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -39,7 +44,7 @@ func deleteImpl(ctx context.Context, client *client.Client) error {
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.DeleteTemplate(ctx, f, os.Stdout, client.Omni().State(), deleteCmdFlags.options)
+	return operations.DeleteTemplate(ctx, f, os.Stdout, client.Omni().State(), deleteCmdFlags.options, loadOpts...)
 }
 
 func init() {
@@ -28,6 +28,11 @@ var diffCmd = &cobra.Command{
 }
 
 func diff(ctx context.Context, client *client.Client) error {
+	loadOpts, err := loadOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -35,7 +40,7 @@ func diff(ctx context.Context, client *client.Client) error {
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.DiffTemplate(ctx, f, os.Stdout, client.Omni().State())
+	return operations.DiffTemplate(ctx, f, os.Stdout, client.Omni().State(), loadOpts...)
 }
 
 func init() {
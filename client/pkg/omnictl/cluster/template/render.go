@@ -25,6 +25,11 @@ var renderCmd = &cobra.Command{
 }
 
 func render() error {
+	loadOpts, err := loadOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -32,7 +37,7 @@ func render() error {
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.RenderTemplate(f, os.Stdout)
+	return operations.RenderTemplate(f, os.Stdout, loadOpts...)
 }
 
 func init() {
@@ -34,6 +34,11 @@ var statusCmd = &cobra.Command{
 }
 
 func status(ctx context.Context, client *client.Client) error {
+	loadOpts, err := loadOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -52,7 +57,7 @@ func status(ctx context.Context, client *client.Client) error {
 		statusCmdFlags.options.Wait = false
 	}
 
-	return operations.StatusTemplate(ctx, f, os.Stdout, client.Omni().State(), statusCmdFlags.options)
+	return operations.StatusTemplate(ctx, f, os.Stdout, client.Omni().State(), statusCmdFlags.options, loadOpts...)
 }
 
 func init() {
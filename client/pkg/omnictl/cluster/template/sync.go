@@ -7,6 +7,7 @@ package template
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -21,9 +22,12 @@ var syncCmdFlags struct {
 
 // syncCmd represents the template sync command.
 var syncCmd = &cobra.Command{
-	Use:     "sync",
-	Short:   "Apply template to the Omni.",
-	Long:    `Query existing resources for the cluster and compare them with the resources generated from the template, create/update/delete resources as needed. This command requires API access.`,
+	Use:   "sync",
+	Short: "Apply template to the Omni.",
+	Long: `Query existing resources for the cluster and compare them with the resources generated from the template, create/update/delete resources as needed. This command requires API access.
+
+With --wait, the command waits for the cluster to become ready after the sync, showing the cluster status while waiting.
+The command fails if the wait times out, or if any of the machine sets, upgrades or config applies fails.`,
 	Example: "",
 	Args:    cobra.NoArgs,
 	RunE: func(*cobra.Command, []string) error {
@@ -32,6 +36,11 @@ var syncCmd = &cobra.Command{
 }
 
 func sync(ctx context.Context, client *client.Client) error {
+	loadOpts, err := loadOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -39,12 +48,14 @@ func sync(ctx context.Context, client *client.Client) error {
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.SyncTemplate(ctx, f, os.Stdout, client.Omni().State(), syncCmdFlags.options)
+	return operations.SyncTemplate(ctx, f, os.Stdout, client.Omni().State(), syncCmdFlags.options, loadOpts...)
 }
 
 func init() {
 	addRequiredFileFlag(syncCmd)
 	syncCmd.PersistentFlags().BoolVarP(&syncCmdFlags.options.Verbose, "verbose", "v", false, "verbose output (show diff for each resource)")
 	syncCmd.PersistentFlags().BoolVarP(&syncCmdFlags.options.DryRun, "dry-run", "d", false, "dry run")
+	syncCmd.PersistentFlags().BoolVarP(&syncCmdFlags.options.Wait, "wait", "w", false, "wait for the cluster to become ready after the sync")
+	syncCmd.PersistentFlags().DurationVar(&syncCmdFlags.options.WaitTimeout, "timeout", 5*time.Minute, "wait timeout, if zero, wait indefinitely")
 	templateCmd.AddCommand(syncCmd)
 }
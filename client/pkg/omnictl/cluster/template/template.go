@@ -6,14 +6,25 @@
 package template
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/siderolabs/gen/ensure"
 	"github.com/spf13/cobra"
+
+	omnitemplate "github.com/siderolabs/omni/client/pkg/template"
 )
 
 // cmdFlags contains shared cluster template flags.
 var cmdFlags struct {
 	// Path to the cluster template file.
 	TemplatePath string
+
+	// Paths to the files with the template variable values.
+	ValuesPaths []string
+
+	// Template variable values in the key=value format.
+	Values []string
 }
 
 // templateCmd represents the template sub-command.
@@ -33,4 +44,47 @@ func RootCmd() *cobra.Command {
 func addRequiredFileFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVarP(&cmdFlags.TemplatePath, "file", "f", "", "path to the cluster template file.")
 	ensure.NoError(cmd.MarkPersistentFlagRequired("file"))
+
+	cmd.PersistentFlags().StringArrayVar(&cmdFlags.ValuesPaths, "values", nil,
+		"path to the YAML file with the template variable values, can be specified multiple times, later files override earlier ones.")
+	cmd.PersistentFlags().StringArrayVar(&cmdFlags.Values, "set", nil,
+		"set the template variable value (key=value, key might be a dotted path), can be specified multiple times, overrides the values files.")
+}
+
+// loadOptions builds template load options from the variable flags.
+func loadOptions() ([]omnitemplate.LoadOption, error) {
+	if len(cmdFlags.ValuesPaths) == 0 && len(cmdFlags.Values) == 0 {
+		return nil, nil
+	}
+
+	var variables omnitemplate.Variables
+
+	for _, path := range cmdFlags.ValuesPaths {
+		if err := loadValuesFile(&variables, path); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, value := range cmdFlags.Values {
+		if err := variables.Set(value); err != nil {
+			return nil, err
+		}
+	}
+
+	return []omnitemplate.LoadOption{omnitemplate.WithVariables(&variables)}, nil
+}
+
+func loadValuesFile(variables *omnitemplate.Variables, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	if err = variables.LoadValues(f); err != nil {
+		return fmt.Errorf("error loading values file %q: %w", path, err)
+	}
+
+	return nil
 }
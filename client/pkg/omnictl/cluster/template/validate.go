@@ -25,6 +25,11 @@ var validateCmd = &cobra.Command{
 }
 
 func validate() error {
+	loadOpts, err := loadOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -32,7 +37,7 @@ func validate() error {
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.ValidateTemplate(f)
+	return operations.ValidateTemplate(f, loadOpts...)
 }
 
 func init() {
@@ -18,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-api-signature/pkg/message"
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
 	pgpclient "github.com/siderolabs/go-api-signature/pkg/pgp/client"
 	"github.com/siderolabs/go-api-signature/pkg/serviceaccount"
 	"github.com/spf13/cobra"
@@ -359,10 +360,10 @@ func signRequest(req *http.Request) error {
 	return msg.Sign(identity, signer)
 }
 
-// getSigner returns the identity and the signer to use for signing the request.
+// getSigner returns the identity and the key to use for signing the request.
 //
 // It can be a service account or a user key.
-func getSigner() (identity string, signer message.Signer, err error) {
+func getSigner() (identity string, signer *pgp.Key, err error) {
 	envKey, valueBase64 := serviceaccount.GetFromEnv()
 	if envKey != "" {
 		sa, saErr := serviceaccount.Decode(valueBase64)
@@ -382,10 +383,10 @@ func getSigner() (identity string, signer message.Signer, err error) {
 
 	key, keyErr := provider.ReadValidKey(contextName, configCtx.Auth.SideroV1.Identity)
 	if keyErr != nil {
-		return "", nil, fmt.Errorf("failed to read key: %w", err)
+		return "", nil, fmt.Errorf("failed to read key: %w", keyErr)
 	}
 
-	return configCtx.Auth.SideroV1.Identity, key, nil
+	return configCtx.Auth.SideroV1.Identity, key.Key, nil
 }
 
 func getMachineLabels() ([]byte, error) {
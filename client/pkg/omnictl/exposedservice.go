@@ -0,0 +1,271 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	exposedServiceTunnelFlags struct {
+		localAddress string
+		localPort    int
+	}
+
+	// exposedServiceCmd represents the exposed-service command.
+	exposedServiceCmd = &cobra.Command{
+		Use:     "exposed-service",
+		Aliases: []string{"es"},
+		Short:   "Access the services exposed from the workload clusters",
+	}
+
+	exposedServiceTunnelCmd = &cobra.Command{
+		Use:   "tunnel <alias>",
+		Short: "Open a local tunnel to an exposed TCP service",
+		Long: `Listen on a local port and tunnel each accepted connection to the exposed TCP service with the given alias.
+
+The connections are tunneled through the Omni workload proxy, the access is checked the same way as for the exposed services opened in the browser.`,
+		Example: `  omnictl exposed-service tunnel postgres --local-port 5432`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return tunnelExposedService(ctx, client, args[0])
+			})
+		},
+	}
+)
+
+func tunnelExposedService(ctx context.Context, client *client.Client, alias string) error {
+	services, err := safe.StateListAll[*omni.ExposedService](ctx, client.Omni().State(),
+		state.WithLabelQuery(resource.LabelEqual(omni.LabelExposedServiceAlias, alias)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get exposed service %q: %w", alias, err)
+	}
+
+	if services.Len() == 0 {
+		return fmt.Errorf("exposed service %q not found", alias)
+	}
+
+	service := services.Get(0)
+
+	if protocol := service.TypedSpec().Value.Protocol; protocol != specs.ExposedServiceSpec_TCP {
+		return fmt.Errorf("exposed service %q has %s protocol, only TCP services can be tunneled", alias, protocol)
+	}
+
+	tunnel, err := newExposedServiceTunnel(client.Endpoint(), alias)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(exposedServiceTunnelFlags.localAddress, strconv.Itoa(exposedServiceTunnelFlags.localPort)))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	stop := context.AfterFunc(ctx, func() { listener.Close() }) //nolint:errcheck
+	defer stop()
+
+	fmt.Printf("Forwarding %s to the exposed service %q, press Ctrl+C to stop\n", listener.Addr(), service.Metadata().ID())
+
+	for {
+		conn, acceptErr := listener.Accept()
+		if acceptErr != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to accept connection: %w", acceptErr)
+		}
+
+		go func() {
+			if tunnelErr := tunnel.handle(ctx, conn); tunnelErr != nil {
+				fmt.Fprintf(os.Stderr, "tunnel for %s failed: %v\n", conn.RemoteAddr(), tunnelErr)
+			}
+		}()
+	}
+}
+
+// exposedServiceTunnel tunnels the TCP connections to an exposed service using HTTP CONNECT requests to the workload proxy.
+type exposedServiceTunnel struct {
+	tlsConfig *tls.Config // nil if the endpoint is not using TLS
+	cookies   []*http.Cookie
+	address   string
+}
+
+func newExposedServiceTunnel(endpoint, alias string) (*exposedServiceTunnel, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+
+	host := fmt.Sprintf("%s-%s-%s", constants.WorkloadProxyHostPrefix, alias, endpointURL.Hostname())
+
+	tunnel := &exposedServiceTunnel{}
+
+	port := endpointURL.Port()
+
+	switch endpointURL.Scheme {
+	case "http", "grpc":
+		if port == "" {
+			port = "80"
+		}
+	default:
+		if port == "" {
+			port = "443"
+		}
+
+		tunnel.tlsConfig = &tls.Config{
+			ServerName:         host,
+			NextProtos:         []string{"http/1.1"},
+			InsecureSkipVerify: access.CmdFlags.InsecureSkipTLSVerify, //nolint:gosec
+		}
+	}
+
+	tunnel.address = net.JoinHostPort(host, port)
+
+	// the workload proxy authenticates the requests by the public key ID signed with the key itself
+	_, key, err := getSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyID := key.Fingerprint()
+
+	signature, err := key.Sign([]byte(publicKeyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign public key ID: %w", err)
+	}
+
+	tunnel.cookies = []*http.Cookie{
+		{Name: constants.WorkloadProxyPublicKeyIDCookie, Value: publicKeyID},
+		{Name: constants.WorkloadProxyPublicKeyIDSignatureBase64Cookie, Value: base64.StdEncoding.EncodeToString(signature)},
+	}
+
+	return tunnel, nil
+}
+
+func (tunnel *exposedServiceTunnel) handle(ctx context.Context, conn net.Conn) error {
+	defer conn.Close() //nolint:errcheck
+
+	proxyConn, proxyReader, err := tunnel.dial(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer proxyConn.Close() //nolint:errcheck
+
+	errCh := make(chan error, 2)
+
+	go tunnelCopy(errCh, proxyConn, conn)
+	go tunnelCopy(errCh, conn, proxyReader)
+
+	for range 2 {
+		if err = <-errCh; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dial opens a tunnel to the exposed service.
+//
+// The returned reader should be used to read from the tunnel, as it might have buffered the data sent right after the CONNECT response.
+func (tunnel *exposedServiceTunnel) dial(ctx context.Context) (net.Conn, io.Reader, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", tunnel.address)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to dial workload proxy: %w", err)
+	}
+
+	if tunnel.tlsConfig != nil {
+		tlsConn := tls.Client(conn, tunnel.tlsConfig)
+
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close() //nolint:errcheck
+
+			return nil, nil, fmt.Errorf("TLS handshake with workload proxy failed: %w", err)
+		}
+
+		conn = tlsConn
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Host: tunnel.address},
+		Host:   tunnel.address,
+		Header: http.Header{},
+	}
+
+	for _, cookie := range tunnel.cookies {
+		req.AddCookie(cookie)
+	}
+
+	reader := bufio.NewReader(conn)
+
+	if err = req.Write(conn); err == nil {
+		var resp *http.Response
+
+		resp, err = http.ReadResponse(reader, req)
+		if err == nil && resp.StatusCode != http.StatusOK {
+			err = fmt.Errorf("workload proxy responded with %q", resp.Status)
+		}
+	}
+
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, nil, fmt.Errorf("failed to open tunnel: %w", err)
+	}
+
+	return conn, reader, nil
+}
+
+func tunnelCopy(errCh chan<- error, dst net.Conn, src io.Reader) {
+	_, err := io.Copy(dst, src)
+
+	if closeWriter, ok := dst.(interface{ CloseWrite() error }); ok {
+		closeWriter.CloseWrite() //nolint:errcheck
+	}
+
+	if errors.Is(err, net.ErrClosed) {
+		err = nil
+	}
+
+	errCh <- err
+}
+
+func init() {
+	RootCmd.AddCommand(exposedServiceCmd)
+
+	exposedServiceCmd.AddCommand(exposedServiceTunnelCmd)
+
+	exposedServiceTunnelCmd.Flags().StringVar(&exposedServiceTunnelFlags.localAddress, "local-address", "127.0.0.1", "local address to listen on")
+	exposedServiceTunnelCmd.Flags().IntVar(&exposedServiceTunnelFlags.localPort, "local-port", 0, "local port to listen on, a random port is picked if not set")
+}
@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	joinTokenCreateFlags struct {
+		labels       map[string]string
+		cluster      string
+		machineClass string
+		ttl          time.Duration
+		maxUses      uint32
+	}
+
+	// joinTokenCmd represents the jointoken command.
+	joinTokenCmd = &cobra.Command{
+		Use:     "jointoken",
+		Aliases: []string{"jt"},
+		Short:   "Manage SideroLink join tokens",
+		Long: `Manage the join tokens which can be used instead of the instance-wide one to connect the machines to Omni.
+
+Each join token can be revoked or rotated without affecting the installation media which use the other tokens.`,
+	}
+
+	joinTokenCreateCmd = &cobra.Command{
+		Use:     "create <name>",
+		Aliases: []string{"c"},
+		Short:   "Create a join token",
+		Example: `  omnictl jointoken create site-a --ttl 720h --max-uses 10 --label site=a --cluster my-cluster`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				token, err := generateJoinToken()
+				if err != nil {
+					return err
+				}
+
+				joinToken := siderolink.NewJoinToken(siderolink.Namespace, args[0])
+
+				spec := joinToken.TypedSpec().Value
+				spec.Token = token
+				spec.MaxUses = joinTokenCreateFlags.maxUses
+				spec.MachineLabels = joinTokenCreateFlags.labels
+				spec.Cluster = joinTokenCreateFlags.cluster
+				spec.MachineClass = joinTokenCreateFlags.machineClass
+
+				if joinTokenCreateFlags.ttl != 0 {
+					spec.ExpirationTime = timestamppb.New(time.Now().Add(joinTokenCreateFlags.ttl))
+				}
+
+				if err = client.Omni().State().Create(ctx, joinToken); err != nil {
+					return fmt.Errorf("failed to create join token: %w", err)
+				}
+
+				fmt.Printf("Created join token %q\n", args[0])
+
+				return printJoinToken(ctx, client, token)
+			})
+		},
+	}
+
+	joinTokenRotateCmd = &cobra.Command{
+		Use:     "rotate <name>",
+		Aliases: []string{"r"},
+		Short:   "Replace the value of a join token",
+		Long:    `Replace the value of a join token. The machines which have already joined are not affected, but the installation media with the old value stop working.`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				token, err := generateJoinToken()
+				if err != nil {
+					return err
+				}
+
+				if _, err = safe.StateUpdateWithConflicts(ctx, client.Omni().State(), siderolink.NewJoinToken(siderolink.Namespace, args[0]).Metadata(),
+					func(res *siderolink.JoinToken) error {
+						res.TypedSpec().Value.Token = token
+
+						return nil
+					},
+				); err != nil {
+					return fmt.Errorf("failed to rotate join token: %w", err)
+				}
+
+				fmt.Printf("Rotated join token %q\n", args[0])
+
+				return printJoinToken(ctx, client, token)
+			})
+		},
+	}
+
+	joinTokenRevokeCmd = &cobra.Command{
+		Use:   "revoke <name>",
+		Short: "Revoke a join token",
+		Long:  `Revoke a join token, so no more machines can join with it. The machines which have already joined are not affected.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				if _, err := safe.StateUpdateWithConflicts(ctx, client.Omni().State(), siderolink.NewJoinToken(siderolink.Namespace, args[0]).Metadata(),
+					func(res *siderolink.JoinToken) error {
+						res.TypedSpec().Value.Revoked = true
+
+						return nil
+					},
+				); err != nil {
+					return fmt.Errorf("failed to revoke join token: %w", err)
+				}
+
+				fmt.Printf("Revoked join token %q\n", args[0])
+
+				return nil
+			})
+		},
+	}
+)
+
+// generateJoinToken generates a random join token value.
+func generateJoinToken() (string, error) {
+	b := make([]byte, siderolink.JoinTokenMinLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// printJoinToken prints the join token value and the kernel arguments to connect the machines with it.
+func printJoinToken(ctx context.Context, client *client.Client, token string) error {
+	connectionParams, err := safe.StateGetByID[*siderolink.ConnectionParams](ctx, client.Omni().State(), siderolink.ConfigID)
+	if err != nil {
+		return fmt.Errorf("failed to get connection params: %w", err)
+	}
+
+	spec := connectionParams.TypedSpec().Value
+
+	fmt.Printf("\n")
+	fmt.Printf("Join token: %s\n", token)
+	fmt.Printf("Kernel arguments: %s\n", strings.Replace(spec.Args, "jointoken="+spec.JoinToken, "jointoken="+token, 1))
+	fmt.Printf("\n")
+	fmt.Printf("Note: Store the join token securely, it grants the machines access to Omni\n")
+
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(joinTokenCmd)
+
+	joinTokenCmd.AddCommand(joinTokenCreateCmd)
+	joinTokenCmd.AddCommand(joinTokenRotateCmd)
+	joinTokenCmd.AddCommand(joinTokenRevokeCmd)
+
+	joinTokenCreateCmd.Flags().DurationVarP(&joinTokenCreateFlags.ttl, "ttl", "t", 0, "TTL for the join token, the token never expires if not set")
+	joinTokenCreateCmd.Flags().Uint32Var(&joinTokenCreateFlags.maxUses, "max-uses", 0, "maximum number of machines which can join with the token, unlimited if not set")
+	joinTokenCreateCmd.Flags().StringToStringVarP(&joinTokenCreateFlags.labels, "label", "l", nil, "initial labels of the machines which join with the token")
+	joinTokenCreateCmd.Flags().StringVar(&joinTokenCreateFlags.cluster, "cluster", "", "cluster the machines which join with the token are meant for")
+	joinTokenCreateCmd.Flags().StringVar(&joinTokenCreateFlags.machineClass, "machine-class", "", "machine class the machines which join with the token are meant for")
+
+	joinTokenCreateCmd.MarkFlagsMutuallyExclusive("cluster", "machine-class")
+}
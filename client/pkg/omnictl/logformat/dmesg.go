@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 )
 
@@ -44,22 +45,32 @@ func (o DmesgOutput) Run(ctx context.Context) error {
 			return err
 		}
 
-		secondsPart := msg.Clock / 1000000
-		microSecondsPart := msg.Clock % 1000000
-		message := trimNewLine(msg.Message)
+		if err = writeDmesg(os.Stdout, "", &msg); err != nil {
+			return err
+		}
+	}
+}
+
+// writeDmesg writes the message in dmesg format, each line of a multiline message is prefixed with the kernel clock.
+func writeDmesg(w io.Writer, prefix string, msg *talosLogMessage) error {
+	secondsPart := msg.Clock / 1000000
+	microSecondsPart := msg.Clock % 1000000
+
+	for i, line := range strings.Split(trimNewLine(msg.Message), "\n") {
+		var err error
 
-		if strings.IndexByte(message, '\n') == -1 {
-			fmt.Printf("[%5d.%06d] %s: %s\n", secondsPart, microSecondsPart, msg.Facility, message)
+		if i == 0 {
+			_, err = fmt.Fprintf(w, "%s[%5d.%06d] %s: %s\n", prefix, secondsPart, microSecondsPart, msg.Facility, line)
 		} else {
-			for i, line := range strings.Split(message, "\n") {
-				if i == 0 {
-					fmt.Printf("[%5d.%06d] %s: %s\n", secondsPart, microSecondsPart, msg.Facility, line)
-				} else {
-					fmt.Printf("[%5d.%06d] %s\n", secondsPart, microSecondsPart, line)
-				}
-			}
+			_, err = fmt.Fprintf(w, "%s[%5d.%06d] %s\n", prefix, secondsPart, microSecondsPart, line)
+		}
+
+		if err != nil {
+			return err
 		}
 	}
+
+	return nil
 }
 
 func trimNewLine(message string) string {
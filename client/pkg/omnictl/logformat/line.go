@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// NewLineWriter returns a new LineWriter for the log format (raw, omni, dmesg), raw is used for unknown formats.
+func NewLineWriter(w io.Writer, format string) *LineWriter {
+	lw := &LineWriter{
+		w:      w,
+		format: format,
+	}
+
+	if format == "omni" {
+		lw.encoder = newOmniEncoder()
+	}
+
+	return lw
+}
+
+// LineWriter writes the log lines one by one, each of them with its own prefix.
+//
+// It is used to print the logs of multiple machines merged together.
+type LineWriter struct {
+	w       io.Writer
+	encoder zapcore.Encoder
+	format  string
+}
+
+// WriteLine writes a single log line in the configured format, prefixing each output line with the prefix.
+//
+// The lines which can't be parsed are written in raw format.
+func (lw *LineWriter) WriteLine(prefix string, line []byte) error {
+	line = bytes.TrimRight(line, "\n")
+
+	if lw.format == "omni" || lw.format == "dmesg" {
+		var msg talosLogMessage
+
+		if err := json.Unmarshal(line, &msg); err == nil {
+			if lw.format == "omni" {
+				return writeOmni(lw.w, lw.encoder, prefix, &msg)
+			}
+
+			return writeDmesg(lw.w, prefix, &msg)
+		}
+	}
+
+	_, err := io.WriteString(lw.w, prefix+string(line)+"\n")
+
+	return err
+}
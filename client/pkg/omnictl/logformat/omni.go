@@ -10,9 +10,11 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"os"
 	"time"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 // NewOmniOutput returns a new OmniOutput.
@@ -31,9 +33,7 @@ type OmniOutput struct {
 func (o *OmniOutput) Run(ctx context.Context) error {
 	var msg talosLogMessage
 
-	var logger zap.Logger
-
-	logger.WithOptions(zap.AddStacktrace(zap.FatalLevel))
+	encoder := newOmniEncoder()
 
 	for {
 		if ctx.Err() != nil {
@@ -49,22 +49,42 @@ func (o *OmniOutput) Run(ctx context.Context) error {
 			return err
 		}
 
-		level, err := zap.ParseAtomicLevel(msg.TalosLevel)
-		if err != nil {
-			level = zap.NewAtomicLevel()
+		if err = writeOmni(os.Stdout, encoder, "", &msg); err != nil {
+			return err
 		}
+	}
+}
 
-		logMsg := logger.Check(level.Level(), msg.Message)
-		if logMsg != nil {
-			logMsg.Write(
-				zap.Int("clock", msg.Clock),
-				zap.String("facility", msg.Facility),
-				zap.String("priority", msg.Priority),
-				zap.Int("seq", msg.Seq),
-				zap.Time("talos-time", msg.TalosTime),
-			)
-		}
+func newOmniEncoder() zapcore.Encoder {
+	return zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig())
+}
+
+// writeOmni writes the message in zap'like format.
+func writeOmni(w io.Writer, encoder zapcore.Encoder, prefix string, msg *talosLogMessage) error {
+	level, err := zapcore.ParseLevel(msg.TalosLevel)
+	if err != nil {
+		level = zapcore.InfoLevel
+	}
+
+	buf, err := encoder.EncodeEntry(zapcore.Entry{
+		Level:   level,
+		Time:    msg.TalosTime,
+		Message: trimNewLine(msg.Message),
+	}, []zapcore.Field{
+		zap.Int("clock", msg.Clock),
+		zap.String("facility", msg.Facility),
+		zap.String("priority", msg.Priority),
+		zap.Int("seq", msg.Seq),
+	})
+	if err != nil {
+		return err
 	}
+
+	defer buf.Free()
+
+	_, err = io.WriteString(w, prefix+buf.String())
+
+	return err
 }
 
 type talosLogMessage struct {
@@ -7,17 +7,23 @@ package omnictl
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
 	"github.com/siderolabs/omni/client/pkg/omnictl/logformat"
 )
 
 var logsCmdFlags struct {
 	logFormat string
+	since     string
+	until     string
+	filter    string
 	follow    bool
+	regex     bool
 	tailLines int32
 }
 
@@ -26,8 +32,11 @@ var logsCmd = &cobra.Command{
 	Use:     "machine-logs machineID",
 	Aliases: []string{"l"},
 	Short:   "Get logs for a machine",
-	Long:    `Get logs for a provided machine id`,
-	Example: "",
+	Long: `Get logs for a provided machine id.
+
+The --since and --until flags accept either a duration relative to the current time (e.g. 24h) or an RFC3339 timestamp.
+Reading the logs within a time range requires log storage to be enabled in Omni.`,
+	Example: `  omnictl machine-logs <machine-id> --since 26h --until 22h --filter "error|fail" --regex`,
 	Args:    cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return access.WithClient(getLogs(cmd, args))
@@ -38,8 +47,22 @@ var logsCmd = &cobra.Command{
 func getLogs(_ *cobra.Command, args []string) func(ctx context.Context, client *client.Client) error {
 	return func(ctx context.Context, client *client.Client) error {
 		machineID := args[0]
+		now := time.Now()
+
+		since, err := parseTimeFlag(logsCmdFlags.since, now)
+		if err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+
+		until, err := parseTimeFlag(logsCmdFlags.until, now)
+		if err != nil {
+			return fmt.Errorf("invalid --until: %w", err)
+		}
 
-		logReader, err := client.Management().LogsReader(ctx, machineID, logsCmdFlags.follow, logsCmdFlags.tailLines)
+		logReader, err := client.Management().LogsReader(ctx, machineID, logsCmdFlags.follow, logsCmdFlags.tailLines,
+			management.WithLogsTimeRange(since, until),
+			management.WithLogsFilter(logsCmdFlags.filter, logsCmdFlags.regex),
+		)
 		if err != nil {
 			return fmt.Errorf("failed to get logs stream for '%s': %w", machineID, err)
 		}
@@ -64,6 +87,10 @@ func getLogs(_ *cobra.Command, args []string) func(ctx context.Context, client *
 func init() {
 	logsCmd.Flags().BoolVarP(&logsCmdFlags.follow, "follow", "f", false, "specify if the logs should be streamed")
 	logsCmd.Flags().Int32Var(&logsCmdFlags.tailLines, "tail", -1, "lines of log file to display (default is to show from the beginning)")
+	logsCmd.Flags().StringVar(&logsCmdFlags.since, "since", "", "show logs newer than a relative duration (e.g. 24h) or an RFC3339 timestamp")
+	logsCmd.Flags().StringVar(&logsCmdFlags.until, "until", "", "show logs older than a relative duration (e.g. 1h) or an RFC3339 timestamp")
+	logsCmd.Flags().StringVar(&logsCmdFlags.filter, "filter", "", "show only the lines containing the given substring")
+	logsCmd.Flags().BoolVar(&logsCmdFlags.regex, "regex", false, "treat the --filter value as a regular expression")
 	logsCmd.Flags().StringVar(&logsCmdFlags.logFormat, "log-format", "raw", "log format (raw, omni, dmesg) to display (default is to display in raw format)")
 	RootCmd.AddCommand(logsCmd)
 }
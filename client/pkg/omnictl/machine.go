@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	// machineCmd represents the machine command.
+	machineCmd = &cobra.Command{
+		Use:   "machine",
+		Short: "Manage the machines joining the instance",
+	}
+
+	machineAcceptCmd = &cobra.Command{
+		Use:   "accept machineID",
+		Short: "Accept a machine, so it can be allocated to the clusters",
+		Long: `Accept a machine which is pending acceptance, so it can be allocated to the clusters.
+
+The machine can also be accepted before it joins, or after it was rejected.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(setMachineAcceptance(args[0], specs.MachineAcceptanceSpec_Accepted))
+		},
+	}
+
+	machineRejectCmd = &cobra.Command{
+		Use:   "reject machineID",
+		Short: "Reject a machine, disconnecting it from the instance",
+		Long:  `Reject a machine: the machine is disconnected, and it can't join again until it is accepted.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(setMachineAcceptance(args[0], specs.MachineAcceptanceSpec_Rejected))
+		},
+	}
+)
+
+func setMachineAcceptance(machineID string, phase specs.MachineAcceptanceSpec_Phase) func(ctx context.Context, client *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		st := client.Omni().State()
+
+		acceptance := siderolink.NewMachineAcceptance(siderolink.Namespace, machineID)
+		acceptance.TypedSpec().Value.Phase = phase
+
+		_, err := safe.StateUpdateWithConflicts(ctx, st, acceptance.Metadata(), func(res *siderolink.MachineAcceptance) error {
+			res.TypedSpec().Value.Phase = phase
+
+			return nil
+		})
+		if state.IsNotFoundError(err) {
+			err = st.Create(ctx, acceptance)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to set the acceptance of the machine %q: %w", machineID, err)
+		}
+
+		fmt.Printf("Machine %q is %s\n", machineID, strings.ToLower(phase.String()))
+
+		return nil
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(machineCmd)
+
+	machineCmd.AddCommand(machineAcceptCmd)
+	machineCmd.AddCommand(machineRejectCmd)
+}
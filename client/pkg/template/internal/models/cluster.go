@@ -47,6 +47,47 @@ type Cluster struct { //nolint:govet
 
 	// Cluster-wide patches.
 	Patches PatchList `yaml:"patches,omitempty"`
+
+	// Cluster-wide config patch libraries.
+	PatchLibraries PatchLibraryList `yaml:"patchLibraries,omitempty"`
+
+	// MaintenanceWindow restricts the disruptive operations on the cluster machines to the scheduled time windows.
+	MaintenanceWindow *MaintenanceWindow `yaml:"maintenanceWindow,omitempty"`
+}
+
+// MaintenanceWindow defines the recurring time window when the machines can be upgraded, rebooted or torn down.
+type MaintenanceWindow struct {
+	// Schedule is the cron expression (minute hour day-of-month month day-of-week) of the window start.
+	Schedule string `yaml:"schedule"`
+	// Timezone is the IANA time zone of the schedule, UTC if not set.
+	Timezone string `yaml:"timezone,omitempty"`
+	// Duration is how long the window stays open.
+	Duration time.Duration `yaml:"duration"`
+}
+
+// Validate the model.
+func (window *MaintenanceWindow) Validate() error {
+	if window == nil {
+		return nil
+	}
+
+	if _, err := omni.ParseMaintenanceWindow(window.translate()); err != nil {
+		return fmt.Errorf("error validating maintenance window: %w", err)
+	}
+
+	return nil
+}
+
+func (window *MaintenanceWindow) translate() *specs.MaintenanceWindow {
+	if window == nil {
+		return nil
+	}
+
+	return &specs.MaintenanceWindow{
+		Schedule: window.Schedule,
+		Duration: durationpb.New(window.Duration),
+		Timezone: window.Timezone,
+	}
 }
 
 // Features defines cluster-wide features.
@@ -63,6 +104,43 @@ type Features struct {
 type BackupConfiguration struct {
 	// Interval configures intervals between backups. If set to 0, etcd backups for this cluster are disabled.
 	Interval time.Duration `yaml:"interval,omitempty"`
+	// Retention configures pruning of old backups. If not set, backups are never pruned.
+	Retention *BackupRetention `yaml:"retention,omitempty"`
+}
+
+// BackupRetention configures which etcd backups are kept when old backups are pruned.
+//
+// A backup is kept if it matches any of the keep rules, the most recent backup is always kept.
+type BackupRetention struct {
+	// KeepLast is the number of the most recent backups to keep.
+	KeepLast uint32 `yaml:"keepLast,omitempty"`
+	// KeepHourly is the number of hours for which the most recent backup of each hour is kept.
+	KeepHourly uint32 `yaml:"keepHourly,omitempty"`
+	// KeepDaily is the number of days for which the most recent backup of each day is kept.
+	KeepDaily uint32 `yaml:"keepDaily,omitempty"`
+	// KeepWeekly is the number of weeks for which the most recent backup of each week is kept.
+	KeepWeekly uint32 `yaml:"keepWeekly,omitempty"`
+	// MaxAge is the maximum age of a backup, older backups are removed even if they match keep rules.
+	MaxAge time.Duration `yaml:"maxAge,omitempty"`
+}
+
+func (retention *BackupRetention) translate() *specs.EtcdBackupRetention {
+	if retention == nil {
+		return nil
+	}
+
+	result := &specs.EtcdBackupRetention{
+		KeepLast:   retention.KeepLast,
+		KeepHourly: retention.KeepHourly,
+		KeepDaily:  retention.KeepDaily,
+		KeepWeekly: retention.KeepWeekly,
+	}
+
+	if retention.MaxAge > 0 {
+		result.MaxAge = durationpb.New(retention.MaxAge)
+	}
+
+	return result
 }
 
 // KubernetesCluster is a Kubernetes cluster settings.
@@ -72,9 +150,96 @@ type KubernetesCluster struct {
 }
 
 // TalosCluster is a Talos cluster settings.
-type TalosCluster struct {
+type TalosCluster struct { //nolint:govet
 	// Version is the Talos version.
 	Version string `yaml:"version"`
+
+	// UpgradePolicy enables the staged Talos upgrades with the health checks between the stages.
+	UpgradePolicy *TalosUpgradePolicy `yaml:"upgradePolicy,omitempty"`
+}
+
+// TalosUpgradePolicy defines how the Talos upgrades are rolled out to the cluster machines.
+//
+// The canary worker is upgraded first, then the share of the workers, then the control planes, then the remaining workers.
+type TalosUpgradePolicy struct {
+	// OnFailure is the action taken when the cluster doesn't become healthy after the stage: Pause or Rollback.
+	OnFailure *TalosUpgradeFailureAction `yaml:"onFailure,omitempty"`
+
+	// WorkersPercentage is the share of the workers upgraded before the control planes, including the canary.
+	WorkersPercentage uint32 `yaml:"workersPercentage,omitempty"`
+
+	// HealthTimeout is how long to wait for the cluster to become healthy after each stage.
+	HealthTimeout time.Duration `yaml:"healthTimeout,omitempty"`
+}
+
+// TalosUpgradeFailureAction extends protobuf generated failure action enum to parse string constants.
+type TalosUpgradeFailureAction uint32
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (a *TalosUpgradeFailureAction) UnmarshalYAML(unmarshal func(any) error) error {
+	var value string
+
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	v, ok := specs.TalosUpgradePolicy_FailureAction_value[value]
+
+	if !ok {
+		return fmt.Errorf("invalid upgrade failure action %s", value)
+	}
+
+	*a = TalosUpgradeFailureAction(v)
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (a TalosUpgradeFailureAction) MarshalYAML() (any, error) {
+	return specs.TalosUpgradePolicy_FailureAction_name[int32(a)], nil
+}
+
+// Validate the model.
+func (policy *TalosUpgradePolicy) Validate() error {
+	if policy == nil {
+		return nil
+	}
+
+	var multiErr error
+
+	if policy.WorkersPercentage > 100 {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("workers percentage should not be greater than 100"))
+	}
+
+	if policy.HealthTimeout < 0 {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("health timeout should not be negative"))
+	}
+
+	if multiErr != nil {
+		return fmt.Errorf("error validating Talos upgrade policy: %w", multiErr)
+	}
+
+	return nil
+}
+
+func (policy *TalosUpgradePolicy) translate() *specs.TalosUpgradePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	result := &specs.TalosUpgradePolicy{
+		WorkersPercentage: policy.WorkersPercentage,
+	}
+
+	if policy.HealthTimeout != 0 {
+		result.HealthTimeout = durationpb.New(policy.HealthTimeout)
+	}
+
+	if policy.OnFailure != nil {
+		result.OnFailure = specs.TalosUpgradePolicy_FailureAction(*policy.OnFailure)
+	}
+
+	return result
 }
 
 // Validate the model.
@@ -97,7 +262,7 @@ func (cluster *Cluster) Validate() error {
 		multiErr = multierror.Append(multiErr, err)
 	}
 
-	multiErr = joinErrors(multiErr, cluster.Kubernetes.Validate(), cluster.Talos.Validate(), cluster.Patches.Validate())
+	multiErr = joinErrors(multiErr, cluster.Kubernetes.Validate(), cluster.Talos.Validate(), cluster.Patches.Validate(), cluster.PatchLibraries.Validate(), cluster.Features.BackupConfiguration.Validate(), cluster.MaintenanceWindow.Validate())
 
 	if multiErr != nil {
 		return fmt.Errorf("error validating cluster %q: %w", cluster.Name, multiErr)
@@ -106,6 +271,23 @@ func (cluster *Cluster) Validate() error {
 	return nil
 }
 
+// Validate the model.
+func (backup *BackupConfiguration) Validate() error {
+	if backup.Retention == nil {
+		return nil
+	}
+
+	if backup.Interval == 0 {
+		return fmt.Errorf("error validating backup configuration: retention requires interval to be set")
+	}
+
+	if backup.Retention.MaxAge < 0 {
+		return fmt.Errorf("error validating backup configuration: retention max age should be positive")
+	}
+
+	return nil
+}
+
 // Validate the model.
 func (kubernetes *KubernetesCluster) Validate() error {
 	var multiErr error
@@ -143,7 +325,7 @@ func (talos *TalosCluster) Validate() error {
 		return fmt.Errorf("error validating Talos version: %w", multiErr)
 	}
 
-	return nil
+	return talos.UpgradePolicy.Validate()
 }
 
 // Translate into Omni resources.
@@ -160,6 +342,9 @@ func (cluster *Cluster) Translate(ctx TranslateContext) ([]resource.Resource, er
 
 	clusterResource.TypedSpec().Value.KubernetesVersion = strings.TrimLeft(cluster.Kubernetes.Version, "v")
 	clusterResource.TypedSpec().Value.TalosVersion = strings.TrimLeft(cluster.Talos.Version, "v")
+	clusterResource.TypedSpec().Value.ConfigPatchLibraries = cluster.PatchLibraries.Translate()
+	clusterResource.TypedSpec().Value.MaintenanceWindow = cluster.MaintenanceWindow.translate()
+	clusterResource.TypedSpec().Value.TalosUpgradePolicy = cluster.Talos.UpgradePolicy.translate()
 
 	patches, err := cluster.Patches.Translate(fmt.Sprintf("cluster-%s", cluster.Name), constants.PatchBaseWeightCluster, pair.MakePair(omni.LabelCluster, cluster.Name))
 	if err != nil {
@@ -173,7 +358,11 @@ func (cluster *Cluster) Translate(ctx TranslateContext) ([]resource.Resource, er
 	clusterResource.TypedSpec().Value.Features.DiskEncryption = cluster.Features.DiskEncryption
 
 	if interval := cluster.Features.BackupConfiguration.Interval; interval > 0 {
-		clusterResource.TypedSpec().Value.BackupConfiguration = &specs.EtcdBackupConf{Interval: durationpb.New(interval), Enabled: true}
+		clusterResource.TypedSpec().Value.BackupConfiguration = &specs.EtcdBackupConf{
+			Interval:  durationpb.New(interval),
+			Enabled:   true,
+			Retention: cluster.Features.BackupConfiguration.Retention.translate(),
+		}
 	}
 
 	resourceList := append([]resource.Resource{clusterResource}, patches...)
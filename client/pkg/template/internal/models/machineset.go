@@ -7,10 +7,12 @@ package models
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/pair"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/constants"
@@ -44,6 +46,12 @@ type MachineSet struct {
 
 	// MachineSet patches.
 	Patches PatchList `yaml:"patches,omitempty"`
+
+	// MachineSet config patch libraries.
+	PatchLibraries PatchLibraryList `yaml:"patchLibraries,omitempty"`
+
+	// MaintenanceWindow overrides the cluster maintenance window for the machine set.
+	MaintenanceWindow *MaintenanceWindow `yaml:"maintenanceWindow,omitempty"`
 }
 
 // BootstrapSpec defines the model for setting the bootstrap specification, i.e. restoring from a backup, in the machine set.
@@ -63,6 +71,18 @@ type MachineClassConfig struct {
 
 	// Size sets the number of machines to be pulled from the machine class.
 	Size Size `yaml:"size"`
+
+	// TopologySpread spreads the machines pulled from the machine class across the failure domains.
+	TopologySpread *TopologySpread `yaml:"topologySpread,omitempty"`
+}
+
+// TopologySpread defines the model for spreading the machines across the failure domains.
+type TopologySpread struct {
+	// Key is the machine label which defines the failure domain, e.g. omni.sidero.dev/zone.
+	Key string `yaml:"key"`
+
+	// MaxSkew is the maximum allowed difference between the number of machines in any two failure domains.
+	MaxSkew uint32 `yaml:"maxSkew,omitempty"`
 }
 
 // Size extends protobuf generated allocation type enum to parse string constants.
@@ -141,12 +161,66 @@ func (t UpdateStrategyType) MarshalYAML() (any, error) {
 // RollingUpdateStrategyConfig defines the model for setting the rolling update strategy in the machine set.
 type RollingUpdateStrategyConfig struct {
 	MaxParallelism uint32 `yaml:"maxParallelism,omitempty"`
+
+	// MaxSurge is the number of fresh machines allocated from the machine class to replace the outdated machines.
+	MaxSurge uint32 `yaml:"maxSurge,omitempty"`
+}
+
+// DrainConfig defines the model for draining the Kubernetes node before the machine is upgraded, rebooted or torn down.
+type DrainConfig struct {
+	// Timeout is how long to wait for the pods to be evicted, the operation proceeds with the pods which are still running when it expires.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+
+	// Force deletes the pods which are still running when the timeout expires, ignoring the pod disruption budgets.
+	Force bool `yaml:"force,omitempty"`
 }
 
 // UpdateStrategyConfig defines the model for setting the update strategy in the machine set.
 type UpdateStrategyConfig struct {
 	Type    *UpdateStrategyType          `yaml:"type,omitempty"`
 	Rolling *RollingUpdateStrategyConfig `yaml:"rolling,omitempty"`
+	Drain   *DrainConfig                 `yaml:"drain,omitempty"`
+}
+
+// Validate the model.
+func (config *UpdateStrategyConfig) Validate() error {
+	if config == nil || config.Drain == nil {
+		return nil
+	}
+
+	if config.Drain.Timeout < 0 {
+		return fmt.Errorf("drain timeout should not be negative")
+	}
+
+	if config.Drain.Force && config.Drain.Timeout == 0 {
+		return fmt.Errorf("forced drain requires the drain timeout")
+	}
+
+	return nil
+}
+
+func (config *UpdateStrategyConfig) translate() *specs.MachineSetSpec_UpdateStrategyConfig {
+	if config.Rolling == nil && config.Drain == nil {
+		return nil
+	}
+
+	result := &specs.MachineSetSpec_UpdateStrategyConfig{}
+
+	if config.Rolling != nil {
+		result.Rolling = &specs.MachineSetSpec_RollingUpdateStrategyConfig{
+			MaxParallelism: config.Rolling.MaxParallelism,
+			MaxSurge:       config.Rolling.MaxSurge,
+		}
+	}
+
+	if config.Drain != nil {
+		result.Drain = &specs.MachineSetSpec_DrainConfig{
+			Timeout: durationpb.New(config.Drain.Timeout),
+			Force:   config.Drain.Force,
+		}
+	}
+
+	return result
 }
 
 // Validate checks the machine set fields correctness.
@@ -161,6 +235,26 @@ func (machineset *MachineSet) Validate() error {
 		multiErr = multierror.Append(multiErr, fmt.Errorf("machine set can not have both machines and machine class defined"))
 	}
 
+	if machineset.MachineClass != nil && machineset.MachineClass.TopologySpread != nil && machineset.MachineClass.TopologySpread.Key == "" {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("topology spread key is required"))
+	}
+
+	if machineset.UpdateStrategy != nil && machineset.UpdateStrategy.Rolling != nil && machineset.UpdateStrategy.Rolling.MaxSurge > 0 {
+		if machineset.MachineClass == nil || machineset.MachineClass.Size.AllocationType != specs.MachineSetSpec_MachineClass_Static {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("maxSurge requires the machine class with the fixed size"))
+		}
+
+		if machineset.UpdateStrategy.Type != nil && specs.MachineSetSpec_UpdateStrategy(*machineset.UpdateStrategy.Type) != specs.MachineSetSpec_Rolling {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("maxSurge requires the rolling update strategy"))
+		}
+	}
+
+	if machineset.DeleteStrategy != nil && machineset.DeleteStrategy.Rolling != nil && machineset.DeleteStrategy.Rolling.MaxSurge > 0 {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("maxSurge is not allowed in the deleteStrategy"))
+	}
+
+	multiErr = joinErrors(multiErr, machineset.UpdateStrategy.Validate(), machineset.DeleteStrategy.Validate(), machineset.PatchLibraries.Validate(), machineset.MaintenanceWindow.Validate())
+
 	return multiErr
 }
 
@@ -175,19 +269,15 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 	machineset.Descriptors.Apply(machineSet)
 
 	machineSet.TypedSpec().Value.UpdateStrategy = specs.MachineSetSpec_Rolling // Update strategy is Rolling when not specified.
+	machineSet.TypedSpec().Value.ConfigPatchLibraries = machineset.PatchLibraries.Translate()
+	machineSet.TypedSpec().Value.MaintenanceWindow = machineset.MaintenanceWindow.translate()
 
 	if machineset.UpdateStrategy != nil {
 		if machineset.UpdateStrategy.Type != nil {
 			machineSet.TypedSpec().Value.UpdateStrategy = specs.MachineSetSpec_UpdateStrategy(*machineset.UpdateStrategy.Type)
 		}
 
-		if machineset.UpdateStrategy.Rolling != nil {
-			machineSet.TypedSpec().Value.UpdateStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
-				Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
-					MaxParallelism: machineset.UpdateStrategy.Rolling.MaxParallelism,
-				},
-			}
-		}
+		machineSet.TypedSpec().Value.UpdateStrategyConfig = machineset.UpdateStrategy.translate()
 	}
 
 	if machineset.DeleteStrategy != nil {
@@ -195,13 +285,7 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 			machineSet.TypedSpec().Value.DeleteStrategy = specs.MachineSetSpec_UpdateStrategy(*machineset.DeleteStrategy.Type)
 		}
 
-		if machineset.DeleteStrategy.Rolling != nil {
-			machineSet.TypedSpec().Value.DeleteStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
-				Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
-					MaxParallelism: machineset.DeleteStrategy.Rolling.MaxParallelism,
-				},
-			}
-		}
+		machineSet.TypedSpec().Value.DeleteStrategyConfig = machineset.DeleteStrategy.translate()
 	}
 
 	resourceList := []resource.Resource{machineSet}
@@ -219,6 +303,13 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 			MachineCount:   machineset.MachineClass.Size.Value,
 			AllocationType: machineset.MachineClass.Size.AllocationType,
 		}
+
+		if spread := machineset.MachineClass.TopologySpread; spread != nil {
+			machineSet.TypedSpec().Value.MachineClass.TopologySpread = &specs.MachineSetSpec_MachineClass_TopologySpread{
+				Key:     spread.Key,
+				MaxSkew: spread.MaxSkew,
+			}
+		}
 	} else {
 		for _, machineID := range machineset.Machines {
 			machineSetNode := omni.NewMachineSetNode(resources.DefaultNamespace, string(machineID), machineSet)
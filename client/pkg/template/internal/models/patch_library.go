@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+)
+
+// PatchLibraryList is a list of references to the config patch libraries.
+type PatchLibraryList []PatchLibrary
+
+// PatchLibrary references the shared config patch libraries either by name or by label selectors.
+type PatchLibrary struct {
+	// Name of the config patch library.
+	Name string `yaml:"name,omitempty"`
+
+	// MatchLabels selects the config patch libraries by labels, the libraries matching any of the selectors are used.
+	MatchLabels []string `yaml:"matchLabels,omitempty"`
+
+	// Version pins the version of the config patch library referenced by name, the latest version is used if not set.
+	Version uint32 `yaml:"version,omitempty"`
+}
+
+// Validate the model.
+func (l PatchLibraryList) Validate() error {
+	var multiErr error
+
+	for _, library := range l {
+		multiErr = joinErrors(multiErr, library.Validate())
+	}
+
+	return multiErr
+}
+
+// Translate the list of references into the resource spec.
+func (l PatchLibraryList) Translate() []*specs.ConfigPatchLibraryRef {
+	if len(l) == 0 {
+		return nil
+	}
+
+	refs := make([]*specs.ConfigPatchLibraryRef, 0, len(l))
+
+	for _, library := range l {
+		refs = append(refs, &specs.ConfigPatchLibraryRef{
+			Name:        library.Name,
+			MatchLabels: library.MatchLabels,
+			Version:     library.Version,
+		})
+	}
+
+	return refs
+}
+
+// Validate the model.
+func (library *PatchLibrary) Validate() error {
+	var multiErr error
+
+	if library.Name == "" && len(library.MatchLabels) == 0 {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("either name or matchLabels is required for patch libraries"))
+	}
+
+	if library.Version != 0 && (library.Name == "" || len(library.MatchLabels) != 0) {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("version can be pinned only for the patch library referenced by name without matchLabels"))
+	}
+
+	if _, err := labels.ParseSelectors(library.MatchLabels); err != nil {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("invalid matchLabels: %w", err))
+	}
+
+	if multiErr != nil {
+		return fmt.Errorf("patch library %q is invalid: %w", library.Name, multiErr)
+	}
+
+	return nil
+}
@@ -19,8 +19,8 @@ import (
 )
 
 // DeleteTemplate removes all template resources from Omni.
-func DeleteTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, syncOptions SyncOptions) error {
-	tmpl, err := template.Load(templateReader)
+func DeleteTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, syncOptions SyncOptions, loadOpts ...template.LoadOption) error {
+	tmpl, err := template.Load(templateReader, loadOpts...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
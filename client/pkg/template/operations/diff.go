@@ -16,8 +16,8 @@ import (
 )
 
 // DiffTemplate outputs the diff between template resources and existing resources.
-func DiffTemplate(ctx context.Context, templateReader io.Reader, output io.Writer, st state.State) error {
-	tmpl, err := template.Load(templateReader)
+func DiffTemplate(ctx context.Context, templateReader io.Reader, output io.Writer, st state.State, loadOpts ...template.LoadOption) error {
+	tmpl, err := template.Load(templateReader, loadOpts...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
@@ -235,6 +235,13 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 				AllocationType: spec.GetMachineClass().GetAllocationType(),
 			},
 		}
+
+		if spread := spec.GetMachineClass().GetTopologySpread(); spread != nil {
+			machineClassConfig.TopologySpread = &models.TopologySpread{
+				Key:     spread.GetKey(),
+				MaxSkew: spread.GetMaxSkew(),
+			}
+		}
 	} else {
 		machineIDs = xslices.Map(nodes, func(node *omni.MachineSetNode) models.MachineID {
 			return models.MachineID(node.Metadata().ID())
@@ -255,8 +262,11 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 		if spec.GetUpdateStrategyConfig().GetRolling() != nil {
 			updateStrategyConfig.Rolling = &models.RollingUpdateStrategyConfig{
 				MaxParallelism: spec.GetUpdateStrategyConfig().GetRolling().GetMaxParallelism(),
+				MaxSurge:       spec.GetUpdateStrategyConfig().GetRolling().GetMaxSurge(),
 			}
 		}
+
+		updateStrategyConfig.Drain = transformDrainConfigToModel(spec.GetUpdateStrategyConfig().GetDrain())
 	}
 
 	var deleteStrategyConfig *models.UpdateStrategyConfig
@@ -273,6 +283,8 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 				MaxParallelism: spec.GetDeleteStrategyConfig().GetRolling().GetMaxParallelism(),
 			}
 		}
+
+		deleteStrategyConfig.Drain = transformDrainConfigToModel(spec.GetDeleteStrategyConfig().GetDrain())
 	}
 
 	kind := models.KindControlPlane
@@ -294,14 +306,16 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 		Meta: models.Meta{
 			Kind: kind,
 		},
-		Name:           name,
-		Descriptors:    getUserDescriptors(machineSet),
-		BootstrapSpec:  bootstrapSpec,
-		Machines:       machineIDs,
-		MachineClass:   machineClassConfig,
-		Patches:        patchModels,
-		UpdateStrategy: updateStrategyConfig,
-		DeleteStrategy: deleteStrategyConfig,
+		Name:              name,
+		Descriptors:       getUserDescriptors(machineSet),
+		BootstrapSpec:     bootstrapSpec,
+		Machines:          machineIDs,
+		MachineClass:      machineClassConfig,
+		Patches:           patchModels,
+		PatchLibraries:    transformConfigPatchLibraryRefsToModels(spec.GetConfigPatchLibraries()),
+		UpdateStrategy:    updateStrategyConfig,
+		DeleteStrategy:    deleteStrategyConfig,
+		MaintenanceWindow: transformMaintenanceWindowToModel(spec.GetMaintenanceWindow()),
 	}, nil
 }
 
@@ -328,19 +342,82 @@ func transformClusterToModel(cluster *omni.Cluster, patches []*omni.ConfigPatch)
 			Version: "v" + spec.GetKubernetesVersion(),
 		},
 		Talos: models.TalosCluster{
-			Version: "v" + spec.GetTalosVersion(),
+			Version:       "v" + spec.GetTalosVersion(),
+			UpgradePolicy: transformTalosUpgradePolicyToModel(spec.GetTalosUpgradePolicy()),
 		},
 		Features: models.Features{
 			DiskEncryption:      spec.GetFeatures().GetDiskEncryption(),
 			EnableWorkloadProxy: spec.GetFeatures().GetEnableWorkloadProxy(),
 			BackupConfiguration: models.BackupConfiguration{
-				Interval: backupIntervalDuration,
+				Interval:  backupIntervalDuration,
+				Retention: transformBackupRetentionToModel(spec.GetBackupConfiguration().GetRetention()),
 			},
 		},
-		Patches: patchModels,
+		Patches:           patchModels,
+		PatchLibraries:    transformConfigPatchLibraryRefsToModels(spec.GetConfigPatchLibraries()),
+		MaintenanceWindow: transformMaintenanceWindowToModel(spec.GetMaintenanceWindow()),
 	}, nil
 }
 
+func transformTalosUpgradePolicyToModel(policy *specs.TalosUpgradePolicy) *models.TalosUpgradePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &models.TalosUpgradePolicy{
+		OnFailure:         pointer.To(models.TalosUpgradeFailureAction(policy.GetOnFailure())),
+		WorkersPercentage: policy.GetWorkersPercentage(),
+		HealthTimeout:     policy.GetHealthTimeout().AsDuration(),
+	}
+}
+
+func transformDrainConfigToModel(drain *specs.MachineSetSpec_DrainConfig) *models.DrainConfig {
+	if drain == nil {
+		return nil
+	}
+
+	return &models.DrainConfig{
+		Timeout: drain.GetTimeout().AsDuration(),
+		Force:   drain.GetForce(),
+	}
+}
+
+func transformMaintenanceWindowToModel(window *specs.MaintenanceWindow) *models.MaintenanceWindow {
+	if window == nil {
+		return nil
+	}
+
+	return &models.MaintenanceWindow{
+		Schedule: window.GetSchedule(),
+		Timezone: window.GetTimezone(),
+		Duration: window.GetDuration().AsDuration(),
+	}
+}
+
+func transformConfigPatchLibraryRefsToModels(refs []*specs.ConfigPatchLibraryRef) models.PatchLibraryList {
+	return xslices.Map(refs, func(ref *specs.ConfigPatchLibraryRef) models.PatchLibrary {
+		return models.PatchLibrary{
+			Name:        ref.GetName(),
+			MatchLabels: ref.GetMatchLabels(),
+			Version:     ref.GetVersion(),
+		}
+	})
+}
+
+func transformBackupRetentionToModel(retention *specs.EtcdBackupRetention) *models.BackupRetention {
+	if retention == nil {
+		return nil
+	}
+
+	return &models.BackupRetention{
+		KeepLast:   retention.GetKeepLast(),
+		KeepHourly: retention.GetKeepHourly(),
+		KeepDaily:  retention.GetKeepDaily(),
+		KeepWeekly: retention.GetKeepWeekly(),
+		MaxAge:     retention.GetMaxAge().AsDuration(),
+	}
+}
+
 func getUserDescriptors(res resource.Resource) models.Descriptors {
 	rawLabels := res.Metadata().Labels().Raw()
 	labels := make(map[string]string, len(rawLabels))
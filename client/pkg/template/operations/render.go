@@ -15,8 +15,8 @@ import (
 )
 
 // RenderTemplate outputs the rendered template to the given output.
-func RenderTemplate(templateReader io.Reader, output io.Writer) error {
-	tmpl, err := template.Load(templateReader)
+func RenderTemplate(templateReader io.Reader, output io.Writer, loadOpts ...template.LoadOption) error {
+	tmpl, err := template.Load(templateReader, loadOpts...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
@@ -6,6 +6,7 @@ package operations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -30,11 +31,14 @@ import (
 type StatusOptions struct {
 	Wait  bool
 	Quiet bool
+
+	// FailOnError stops waiting once any of the cluster resources reports a failure.
+	FailOnError bool
 }
 
 // StatusTemplate queries, renders and (optionally) waits for the cluster status (health).
-func StatusTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, options StatusOptions) error {
-	tmpl, err := template.Load(templateReader)
+func StatusTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, options StatusOptions, loadOpts ...template.LoadOption) error {
+	tmpl, err := template.Load(templateReader, loadOpts...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
@@ -70,7 +74,13 @@ func statusTemplate(ctx context.Context, tmpl *template.Template, out io.Writer,
 		return err
 	}
 
+	// the cluster and the machine sets are not rendered, but they are used to check that the status reflects the latest changes
+	if err = st.Watch(ctx, omni.NewCluster(resources.DefaultNamespace, clusterName).Metadata(), watchCh); err != nil {
+		return err
+	}
+
 	resourceTypes := []resource.Type{
+		omni.MachineSetType,
 		omni.MachineSetStatusType,
 		omni.ControlPlaneStatusType,
 		omni.LoadBalancerStatusType,
@@ -107,28 +117,36 @@ func statusTemplate(ctx context.Context, tmpl *template.Template, out io.Writer,
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-renderTicker.C:
-			if !hasUpdates {
+			if !hasUpdates || !startedRendering {
 				continue
 			}
 
 			hasUpdates = false
 
-			if options.Quiet {
-				continue
-			}
-
 			newLines, healthy := render(resources)
 
-			if err = printStatus(out, prevLines, newLines); err != nil {
-				return err
-			}
+			if !options.Quiet {
+				if err = printStatus(out, prevLines, newLines); err != nil {
+					return err
+				}
 
-			prevLines = newLines
+				prevLines = newLines
+			}
 
 			if healthy {
 				// done waiting
 				return nil
 			}
+
+			if err = checkRollback(resources); err != nil {
+				return err
+			}
+
+			if options.FailOnError {
+				if err = checkFailures(resources); err != nil {
+					return err
+				}
+			}
 		case event := <-watchCh:
 			hasUpdates = true
 
@@ -170,6 +188,16 @@ func statusTemplate(ctx context.Context, tmpl *template.Template, out io.Writer,
 				return nil
 			}
 
+			if err = checkRollback(resources); err != nil {
+				return err
+			}
+
+			if options.FailOnError {
+				if err = checkFailures(resources); err != nil {
+					return err
+				}
+			}
+
 			if !options.Wait {
 				return fmt.Errorf("cluster is not healthy")
 			}
@@ -206,12 +234,19 @@ func render(resources map[string]resource.Resource) ([]byte, bool) {
 			clusterStatus = item
 
 			healthy = healthy && item.TypedSpec().Value.Phase == specs.ClusterStatusSpec_RUNNING && item.TypedSpec().Value.Ready
+		case *omni.MachineSet:
+			// the machine set status is not created yet
+			_, hasStatus := resources[resource.String(omni.NewMachineSetStatus(item.Metadata().Namespace(), item.Metadata().ID()))]
+
+			healthy = healthy && hasStatus
 		case *omni.MachineSetStatus:
-			healthy = healthy && item.TypedSpec().Value.Phase == specs.MachineSetPhase_Running && item.TypedSpec().Value.Ready
+			healthy = healthy && item.TypedSpec().Value.Phase == specs.MachineSetPhase_Running && item.TypedSpec().Value.Ready && machineSetStatusUpToDate(item, resources)
 		case *omni.KubernetesUpgradeStatus:
-			healthy = healthy && item.TypedSpec().Value.Phase == specs.KubernetesUpgradeStatusSpec_Done
+			healthy = healthy && item.TypedSpec().Value.Phase == specs.KubernetesUpgradeStatusSpec_Done &&
+				upgradeDone(item, resources, item.TypedSpec().Value.LastUpgradeVersion, func(cluster *specs.ClusterSpec) string { return cluster.KubernetesVersion })
 		case *omni.TalosUpgradeStatus:
-			healthy = healthy && item.TypedSpec().Value.Phase == specs.TalosUpgradeStatusSpec_Done
+			healthy = healthy && item.TypedSpec().Value.Phase == specs.TalosUpgradeStatusSpec_Done &&
+				upgradeDone(item, resources, item.TypedSpec().Value.LastUpgradeVersion, func(cluster *specs.ClusterSpec) string { return cluster.TalosVersion })
 		}
 	}
 
@@ -227,6 +262,81 @@ func render(resources map[string]resource.Resource) ([]byte, bool) {
 	return tree.Bytes(), healthy
 }
 
+// machineSetStatusUpToDate checks that the machine set status was built from the latest version of the machine set.
+func machineSetStatusUpToDate(machineSetStatus *omni.MachineSetStatus, resources map[string]resource.Resource) bool {
+	machineSet, ok := resources[resource.String(omni.NewMachineSet(machineSetStatus.Metadata().Namespace(), machineSetStatus.Metadata().ID()))]
+	if !ok {
+		return true
+	}
+
+	version, _ := machineSetStatus.Metadata().Annotations().Get(omni.MachineSetStatusObservedVersion)
+
+	return version == machineSet.Metadata().Version().String()
+}
+
+// upgradeDone checks that the last upgrade version matches the version requested in the cluster spec.
+func upgradeDone(upgradeStatus resource.Resource, resources map[string]resource.Resource, lastUpgradeVersion string, requestedVersion func(*specs.ClusterSpec) string) bool {
+	cluster, ok := resources[resource.String(omni.NewCluster(upgradeStatus.Metadata().Namespace(), upgradeStatus.Metadata().ID()))].(*omni.Cluster)
+	if !ok {
+		return false
+	}
+
+	return lastUpgradeVersion == requestedVersion(cluster.TypedSpec().Value)
+}
+
+// checkRollback returns an error if the requested Talos upgrade has failed and is rolled back.
+//
+// The rolled back upgrade is not retried until the requested version is changed, so there is no point in waiting for it.
+func checkRollback(resources map[string]resource.Resource) error {
+	for _, r := range resources {
+		upgradeStatus, ok := r.(*omni.TalosUpgradeStatus)
+		if !ok {
+			continue
+		}
+
+		failedVersion := upgradeStatus.TypedSpec().Value.FailedUpgradeVersion
+
+		if failedVersion == "" || !upgradeDone(upgradeStatus, resources, failedVersion, func(cluster *specs.ClusterSpec) string { return cluster.TalosVersion }) {
+			continue
+		}
+
+		return fmt.Errorf("talos upgrade to %s has failed the health check and was rolled back to %s", failedVersion, upgradeStatus.TypedSpec().Value.LastUpgradeVersion)
+	}
+
+	return nil
+}
+
+// checkFailures returns an error if any of the cluster resources is failed.
+func checkFailures(resources map[string]resource.Resource) error {
+	var errs []error
+
+	for _, r := range resources {
+		switch item := r.(type) {
+		case *omni.MachineSetStatus:
+			if item.TypedSpec().Value.Phase == specs.MachineSetPhase_Failed {
+				errs = append(errs, fmt.Errorf("machine set %q failed: %s", item.Metadata().ID(), item.TypedSpec().Value.Error))
+			}
+		case *omni.KubernetesUpgradeStatus:
+			if item.TypedSpec().Value.Phase == specs.KubernetesUpgradeStatusSpec_Failed {
+				errs = append(errs, fmt.Errorf("kubernetes upgrade failed: %s", item.TypedSpec().Value.Error))
+			}
+		case *omni.TalosUpgradeStatus:
+			if item.TypedSpec().Value.Phase == specs.TalosUpgradeStatusSpec_Failed {
+				errs = append(errs, fmt.Errorf("talos upgrade failed: %s", item.TypedSpec().Value.Error))
+			}
+		case *omni.ClusterMachineStatus:
+			if item.TypedSpec().Value.ConfigApplyStatus == specs.ConfigApplyStatus_FAILED {
+				errs = append(errs, fmt.Errorf("machine %q failed to apply the config: %s", item.Metadata().ID(), item.TypedSpec().Value.LastConfigError))
+			}
+		}
+	}
+
+	// sort the errors to get the stable output
+	slices.SortFunc(errs, func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+
+	return errors.Join(errs...)
+}
+
 // printStatus prints the tree to the terminal.
 //
 // If terminal supports it, previous tree is erased.
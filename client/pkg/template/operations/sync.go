@@ -6,9 +6,11 @@ package operations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -29,11 +31,17 @@ type SyncOptions struct {
 
 	// DestroyMachines forcefully remove the disconnected nodes from Omni.
 	DestroyMachines bool
+
+	// Wait for the cluster to become ready after the sync, failures of the machine sets, upgrades or config applies stop waiting with an error.
+	Wait bool
+
+	// WaitTimeout limits the time spent waiting for the cluster to become ready, if zero, wait indefinitely.
+	WaitTimeout time.Duration
 }
 
 // SyncTemplate performs resource sync to Omni.
-func SyncTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, syncOptions SyncOptions) error {
-	tmpl, err := template.Load(templateReader)
+func SyncTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, syncOptions SyncOptions, loadOpts ...template.LoadOption) error {
+	tmpl, err := template.Load(templateReader, loadOpts...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
@@ -98,7 +106,40 @@ func SyncTemplate(ctx context.Context, templateReader io.Reader, out io.Writer,
 		}
 	}
 
-	return syncDelete(ctx, syncResult, out, st, syncOptions)
+	if err = syncDelete(ctx, syncResult, out, st, syncOptions); err != nil {
+		return err
+	}
+
+	if !syncOptions.Wait || syncOptions.DryRun {
+		return nil
+	}
+
+	return syncWait(ctx, tmpl, out, st, syncOptions)
+}
+
+func syncWait(ctx context.Context, tmpl *template.Template, out io.Writer, st state.State, syncOptions SyncOptions) error {
+	if syncOptions.WaitTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, syncOptions.WaitTimeout)
+		defer cancel()
+	}
+
+	color.New(color.FgYellow).Fprintln(out, "* waiting for the cluster to become ready") //nolint:errcheck
+
+	err := statusTemplate(ctx, tmpl, out, st, StatusOptions{
+		Wait:        true,
+		FailOnError: true,
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for the cluster to become ready: %w", err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for the cluster to become ready: %w", err)
+	}
+
+	return nil
 }
 
 func syncDelete(ctx context.Context, syncResult *template.SyncResult, out io.Writer, st state.State, syncOptions SyncOptions) error {
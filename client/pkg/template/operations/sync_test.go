@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operations_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	omniresources "github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/template/operations"
+)
+
+const syncWaitTemplate = `kind: Cluster
+name: wait
+kubernetes:
+  version: v1.29.0
+talos:
+  version: v1.6.0
+---
+kind: ControlPlane
+machines:
+  - 4aed1106-6f44-4be9-9796-d4b5b0b5b0b0
+---
+kind: Machine
+name: 4aed1106-6f44-4be9-9796-d4b5b0b5b0b0
+`
+
+func TestSyncWait(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		phase         specs.MachineSetPhase
+		runController bool
+		rolledBack    bool
+		expectedError string
+	}{
+		{
+			name:          "ready",
+			phase:         specs.MachineSetPhase_Running,
+			runController: true,
+		},
+		{
+			name:          "failed",
+			phase:         specs.MachineSetPhase_Failed,
+			runController: true,
+			expectedError: `machine set "wait-control-planes" failed: no machines`,
+		},
+		{
+			name:          "rolled back",
+			phase:         specs.MachineSetPhase_Running,
+			runController: true,
+			rolledBack:    true,
+			expectedError: "talos upgrade to 1.6.0 has failed the health check and was rolled back to 1.5.0",
+		},
+		{
+			name:          "timeout",
+			expectedError: "timed out waiting for the cluster to become ready",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+			createClusterStatuses(ctx, t, st, "wait")
+
+			if tt.rolledBack {
+				_, err := safe.StateUpdateWithConflicts(ctx, st, omni.NewTalosUpgradeStatus(omniresources.DefaultNamespace, "wait").Metadata(),
+					func(res *omni.TalosUpgradeStatus) error {
+						res.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Reverting
+						res.TypedSpec().Value.LastUpgradeVersion = "1.5.0"
+						res.TypedSpec().Value.FailedUpgradeVersion = "1.6.0"
+
+						return nil
+					})
+				require.NoError(t, err)
+			}
+
+			if tt.runController {
+				runMachineSetStatusController(ctx, t, st, tt.phase)
+			}
+
+			err := operations.SyncTemplate(ctx, strings.NewReader(syncWaitTemplate), io.Discard, st, operations.SyncOptions{
+				Wait:        true,
+				WaitTimeout: 3 * time.Second,
+			})
+
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func createClusterStatuses(ctx context.Context, t *testing.T, st state.State, clusterName string) {
+	clusterStatus := omni.NewClusterStatus(omniresources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+	clusterStatus.TypedSpec().Value.Ready = true
+
+	kubernetesUpgradeStatus := omni.NewKubernetesUpgradeStatus(omniresources.DefaultNamespace, clusterName)
+	kubernetesUpgradeStatus.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	kubernetesUpgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Done
+	kubernetesUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.29.0"
+
+	talosUpgradeStatus := omni.NewTalosUpgradeStatus(omniresources.DefaultNamespace, clusterName)
+	talosUpgradeStatus.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	talosUpgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Done
+	talosUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.6.0"
+
+	for _, r := range []resource.Resource{clusterStatus, kubernetesUpgradeStatus, talosUpgradeStatus} {
+		require.NoError(t, st.Create(ctx, r))
+	}
+}
+
+// runMachineSetStatusController mimics the machine set status controller, reporting the given phase for each machine set version.
+func runMachineSetStatusController(ctx context.Context, t *testing.T, st state.State, phase specs.MachineSetPhase) {
+	eventCh := make(chan safe.WrappedStateEvent[*omni.MachineSet])
+
+	require.NoError(t, safe.StateWatchKind(ctx, st, omni.NewMachineSet(omniresources.DefaultNamespace, "").Metadata(), eventCh))
+
+	go func() {
+		for {
+			var event safe.WrappedStateEvent[*omni.MachineSet]
+
+			select {
+			case <-ctx.Done():
+				return
+			case event = <-eventCh:
+			}
+
+			if event.Type() != state.Created && event.Type() != state.Updated {
+				continue
+			}
+
+			machineSet, err := event.Resource()
+			if err != nil {
+				continue
+			}
+
+			// the status is not reported right away to make sure that the sync waits for it
+			time.Sleep(100 * time.Millisecond)
+
+			updateStatus := func(machineSetStatus *omni.MachineSetStatus) error {
+				machineSetStatus.Metadata().Labels().Set(omni.LabelCluster, "wait")
+				machineSetStatus.Metadata().Annotations().Set(omni.MachineSetStatusObservedVersion, machineSet.Metadata().Version().String())
+
+				machineSetStatus.TypedSpec().Value.Phase = phase
+				machineSetStatus.TypedSpec().Value.Ready = phase == specs.MachineSetPhase_Running
+
+				if phase == specs.MachineSetPhase_Failed {
+					machineSetStatus.TypedSpec().Value.Error = "no machines"
+				}
+
+				return nil
+			}
+
+			machineSetStatus := omni.NewMachineSetStatus(omniresources.DefaultNamespace, machineSet.Metadata().ID())
+
+			updateStatus(machineSetStatus) //nolint:errcheck
+
+			if err = st.Create(ctx, machineSetStatus); state.IsConflictError(err) {
+				safe.StateUpdateWithConflicts(ctx, st, machineSetStatus.Metadata(), updateStatus) //nolint:errcheck
+			}
+		}
+	}()
+}
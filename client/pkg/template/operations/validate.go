@@ -12,8 +12,8 @@ import (
 )
 
 // ValidateTemplate performs template validation.
-func ValidateTemplate(templateReader io.Reader) error {
-	tmpl, err := template.Load(templateReader)
+func ValidateTemplate(templateReader io.Reader, loadOpts ...template.LoadOption) error {
+	tmpl, err := template.Load(templateReader, loadOpts...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
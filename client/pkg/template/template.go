@@ -11,6 +11,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
+	"strconv"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
@@ -27,8 +29,30 @@ type Template struct {
 	models models.List
 }
 
+// LoadOption configures template loading.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	variables *Variables
+}
+
+// WithVariables enables the substitution of the variable references in the template.
+//
+// Without this option, the template is loaded as is, so ${ has no special meaning.
+func WithVariables(variables *Variables) LoadOption {
+	return func(opts *loadOptions) {
+		opts.variables = variables
+	}
+}
+
 // Load the template from input.
-func Load(input io.Reader) (*Template, error) {
+func Load(input io.Reader, opts ...LoadOption) (*Template, error) {
+	var options loadOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	dec := yaml.NewDecoder(input)
 
 	var template Template
@@ -52,6 +76,12 @@ func Load(input io.Reader) (*Template, error) {
 			return nil, fmt.Errorf("unexpected number of nodes %d", len(docNode.Content))
 		}
 
+		if options.variables != nil {
+			if err := options.variables.substitute(&docNode); err != nil {
+				return nil, fmt.Errorf("error substituting variables in document at line %d:%d: %w", docNode.Line, docNode.Column, err)
+			}
+		}
+
 		kind, err := findKind(docNode.Content[0])
 		if err != nil {
 			return nil, fmt.Errorf("error in document at line %d:%d: %w", docNode.Line, docNode.Column, err)
@@ -73,13 +103,67 @@ func Load(input io.Reader) (*Template, error) {
 		documentDecoder.KnownFields(true)
 
 		if err = documentDecoder.Decode(model); err != nil {
-			return nil, fmt.Errorf("error decoding document at line %d:%d: %w", docNode.Line, docNode.Column, err)
+			return nil, fmt.Errorf("error decoding document at line %d:%d: %w", docNode.Line, docNode.Column, remapErrorLines(err, raw, docNode.Content[0]))
 		}
 
 		template.models = append(template.models, model)
 	}
 }
 
+var errorLineRegexp = regexp.MustCompile(`^line (\d+):`)
+
+// remapErrorLines rewrites the line numbers of the decoding errors of the re-marshaled document to the lines of the original template.
+func remapErrorLines(err error, raw []byte, original *yaml.Node) error {
+	var typeErr *yaml.TypeError
+
+	if !errors.As(err, &typeErr) {
+		return err
+	}
+
+	var rawNode yaml.Node
+
+	if yaml.Unmarshal(raw, &rawNode) != nil || len(rawNode.Content) != 1 {
+		return err
+	}
+
+	lines := map[int]int{}
+
+	mapNodeLines(lines, rawNode.Content[0], original)
+
+	for i, msg := range typeErr.Errors {
+		match := errorLineRegexp.FindStringSubmatch(msg)
+		if match == nil {
+			continue
+		}
+
+		rawLine, convErr := strconv.Atoi(match[1])
+		if convErr != nil {
+			continue
+		}
+
+		if line, ok := lines[rawLine]; ok {
+			typeErr.Errors[i] = fmt.Sprintf("line %d:%s", line, msg[len(match[0]):])
+		}
+	}
+
+	return err
+}
+
+// mapNodeLines walks the trees in parallel, mapping the lines of the raw nodes to the lines of the original ones.
+func mapNodeLines(lines map[int]int, raw, original *yaml.Node) {
+	if _, ok := lines[raw.Line]; !ok {
+		lines[raw.Line] = original.Line
+	}
+
+	if raw.Kind != original.Kind || len(raw.Content) != len(original.Content) {
+		return
+	}
+
+	for i := range raw.Content {
+		mapNodeLines(lines, raw.Content[i], original.Content[i])
+	}
+}
+
 func findKind(node *yaml.Node) (string, error) {
 	if node.Kind != yaml.MappingNode {
 		return "", fmt.Errorf("unexpected node kind %q, expecting mapping", node.Kind)
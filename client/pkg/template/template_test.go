@@ -47,6 +47,15 @@ var clusterBadYAML2 []byte
 //go:embed testdata/cluster-bad-yaml3.yaml
 var clusterBadYAML3 []byte
 
+//go:embed testdata/cluster-bad-variables.yaml
+var clusterBadVariables []byte
+
+//go:embed testdata/cluster2-variables.yaml
+var cluster2Variables []byte
+
+//go:embed testdata/cluster2-values.yaml
+var cluster2Values []byte
+
 //go:embed testdata/cluster-invalid1.yaml
 var clusterInvalid1 []byte
 
@@ -115,6 +124,59 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestLoadVariables(t *testing.T) {
+	for _, tt := range []struct { //nolint:govet
+		name          string
+		data          []byte
+		set           []string
+		expectedError string
+	}{
+		{
+			name: "escaped",
+			data: []byte("kind: Cluster\nname: $${clusterName}\n"),
+		},
+		{
+			name:          "undefined",
+			data:          clusterBadVariables,
+			set:           []string{"machines=abc"},
+			expectedError: `error substituting variables in document at line 7:1: line 17:16: variable "bootstrap" is not defined`,
+		},
+		{
+			name:          "not a scalar",
+			data:          []byte("kind: Cluster\nname: cluster-${versions}\n"),
+			set:           []string{"versions.talos=v1.3.0"},
+			expectedError: `error substituting variables in document at line 1:1: line 2:7: variable "versions" is not a scalar, so it can't be a part of a string`,
+		},
+		{
+			name:          "wrong type",
+			data:          clusterBadVariables,
+			set:           []string{"machines=abc", "bootstrap.clusterUUID=test", "bootstrap.snapshot.name=test"},
+			expectedError: "error decoding document at line 7:1: yaml: unmarshal errors:\n  line 9: cannot unmarshal !!str `abc` into models.MachineIDList\n  line 17: cannot unmarshal !!map into string",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := template.Load(bytes.NewReader(tt.data), template.WithVariables(variables(t, nil, tt.set...)))
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func variables(t *testing.T, values []byte, set ...string) *template.Variables {
+	var vars template.Variables
+
+	require.NoError(t, vars.LoadValues(bytes.NewReader(values)))
+
+	for _, keyValue := range set {
+		require.NoError(t, vars.Set(keyValue))
+	}
+
+	return &vars
+}
+
 func TestValidate(t *testing.T) {
 	cwd, err := os.Getwd()
 	require.NoError(t, err)
@@ -239,9 +301,13 @@ machine:
 		{
 			name: "clusterInvalid4",
 			data: clusterInvalid4,
-			expectedError: `1 error occurred:
+			expectedError: `2 errors occurred:
+	* workers is invalid: 1 error occurred:
+	* machine set can not have both machines and machine class defined
+
+
 	* workers is invalid: 1 error occurred:
-	* machine set can not have both machines and machine class defined`,
+	* maxSurge requires the rolling update strategy`,
 		},
 		{
 			name: "clusterInvalidBootstrapSpec",
@@ -292,6 +358,7 @@ func TestTranslate(t *testing.T) {
 		name     string
 		template []byte
 		expected []byte
+		loadOpts []template.LoadOption
 	}{
 		{
 			name:     "cluster1",
@@ -313,9 +380,15 @@ func TestTranslate(t *testing.T) {
 			template: clusterValidBootstrapSpec,
 			expected: clusterValidBootstrapSpecResources,
 		},
+		{
+			name:     "cluster2Variables",
+			template: cluster2Variables,
+			expected: cluster2Resources,
+			loadOpts: []template.LoadOption{template.WithVariables(variables(t, cluster2Values, "versions.talos=v1.3.1"))},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
-			templ, err := template.Load(bytes.NewReader(tt.template))
+			templ, err := template.Load(bytes.NewReader(tt.template), tt.loadOpts...)
 			require.NoError(t, err)
 
 			require.NoError(t, templ.Validate())
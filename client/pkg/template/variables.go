@@ -0,0 +1,269 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package template
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Variables are the values of the template variables.
+//
+// Variables are referenced in the template as ${name}, the values nested in the maps are referenced by the dotted path, e.g. ${cluster.name}.
+// When the whole value is a reference, it is replaced with the variable value as is, so the variables might hold numbers, lists and maps.
+// Otherwise, the reference is replaced with the string representation of the variable, which should be a scalar.
+// Use $${ to keep a literal ${ in the template.
+//
+// The zero value is empty and ready to use.
+type Variables struct {
+	values *yaml.Node
+}
+
+var variableNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*(\.[A-Za-z_][A-Za-z0-9_-]*)*$`)
+
+// LoadValues reads the variables from the YAML values file, merging them with the existing ones.
+//
+// The maps are merged recursively, other values replace the existing ones.
+func (v *Variables) LoadValues(input io.Reader) error {
+	var doc yaml.Node
+
+	if err := yaml.NewDecoder(input).Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return fmt.Errorf("error decoding values: %w", err)
+	}
+
+	if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("values should be a map at line %d:%d", doc.Line, doc.Column)
+	}
+
+	v.root().Content = mergeMappings(v.root().Content, doc.Content[0].Content)
+
+	return nil
+}
+
+// Set sets the variable from the key=value string, the key might be a dotted path.
+//
+// The value is resolved the same way as a plain YAML scalar, but it keeps its original text when used as a string.
+func (v *Variables) Set(keyValue string) error {
+	key, value, ok := strings.Cut(keyValue, "=")
+	if !ok {
+		return fmt.Errorf("invalid variable %q: should be in the key=value format", keyValue)
+	}
+
+	if !variableNameRegexp.MatchString(key) {
+		return fmt.Errorf("invalid variable name %q", key)
+	}
+
+	path := strings.Split(key, ".")
+	node := v.root()
+
+	for _, name := range path[:len(path)-1] {
+		child := mappingValue(node, name)
+		if child == nil || child.Kind != yaml.MappingNode {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+
+			node.Content = setMappingValue(node.Content, name, child)
+		}
+
+		node = child
+	}
+
+	node.Content = setMappingValue(node.Content, path[len(path)-1], &yaml.Node{Kind: yaml.ScalarNode, Value: value})
+
+	return nil
+}
+
+// IsEmpty returns true if there are no variables.
+func (v *Variables) IsEmpty() bool {
+	return v.values == nil || len(v.values.Content) == 0
+}
+
+func (v *Variables) root() *yaml.Node {
+	if v.values == nil {
+		v.values = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+
+	return v.values
+}
+
+func (v *Variables) lookup(name string) *yaml.Node {
+	node := v.values
+
+	for _, key := range strings.Split(name, ".") {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		node = mappingValue(node, key)
+	}
+
+	return node
+}
+
+var referenceRegexp = regexp.MustCompile(`\$\$\{|\$\{([^}]*)\}`)
+
+// substitute replaces the variable references in the values of the node tree.
+func (v *Variables) substitute(node *yaml.Node) error {
+	switch node.Kind { //nolint:exhaustive
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			if err := v.substitute(child); err != nil {
+				return err
+			}
+		}
+	case yaml.MappingNode:
+		// only the values are substituted, keys are kept as is
+		for i := 1; i < len(node.Content); i += 2 {
+			if err := v.substitute(node.Content[i]); err != nil {
+				return err
+			}
+		}
+	case yaml.ScalarNode:
+		return v.substituteScalar(node)
+	}
+
+	return nil
+}
+
+func (v *Variables) substituteScalar(node *yaml.Node) error {
+	if !strings.Contains(node.Value, "${") {
+		return nil
+	}
+
+	quoted := node.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) != 0
+
+	// the whole value is a reference, so it's replaced with the variable node to keep its type
+	if match := referenceRegexp.FindStringSubmatchIndex(node.Value); !quoted && match != nil && match[0] == 0 && match[1] == len(node.Value) && match[2] >= 0 {
+		value, err := v.resolve(node, node.Value[match[2]:match[3]])
+		if err != nil {
+			return err
+		}
+
+		line, column := node.Line, node.Column
+
+		*node = *cloneNode(value, line, column)
+
+		return nil
+	}
+
+	var err error
+
+	result := referenceRegexp.ReplaceAllStringFunc(node.Value, func(reference string) string {
+		if reference == "$${" {
+			return "${"
+		}
+
+		value, resolveErr := v.resolve(node, reference[2:len(reference)-1])
+		if resolveErr != nil {
+			err = errors.Join(err, resolveErr)
+
+			return reference
+		}
+
+		if value.Kind != yaml.ScalarNode {
+			err = errors.Join(err, fmt.Errorf("line %d:%d: variable %q is not a scalar, so it can't be a part of a string", node.Line, node.Column, reference[2:len(reference)-1]))
+
+			return reference
+		}
+
+		return value.Value
+	})
+	if err != nil {
+		return err
+	}
+
+	node.Value = result
+
+	// let the plain scalars resolve their type from the new value
+	if !quoted {
+		node.Tag = ""
+	}
+
+	return nil
+}
+
+func (v *Variables) resolve(node *yaml.Node, name string) (*yaml.Node, error) {
+	if !variableNameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("line %d:%d: invalid variable name %q", node.Line, node.Column, name)
+	}
+
+	value := v.lookup(name)
+	if value == nil {
+		return nil, fmt.Errorf("line %d:%d: variable %q is not defined", node.Line, node.Column, name)
+	}
+
+	return value, nil
+}
+
+// cloneNode returns a deep copy of the node placed at the given position.
+func cloneNode(node *yaml.Node, line, column int) *yaml.Node {
+	clone := *node
+	clone.Line, clone.Column = line, column
+	clone.HeadComment, clone.LineComment, clone.FootComment = "", "", ""
+
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		return cloneNode(node.Alias, line, column)
+	}
+
+	clone.Content = make([]*yaml.Node, 0, len(node.Content))
+
+	for _, child := range node.Content {
+		clone.Content = append(clone.Content, cloneNode(child, line, column))
+	}
+
+	return &clone
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func setMappingValue(content []*yaml.Node, key string, value *yaml.Node) []*yaml.Node {
+	for i := 0; i+1 < len(content); i += 2 {
+		if content[i].Value == key {
+			content[i+1] = value
+
+			return content
+		}
+	}
+
+	return append(content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+func mergeMappings(dst, src []*yaml.Node) []*yaml.Node {
+	for i := 0; i+1 < len(src); i += 2 {
+		key, value := src[i].Value, src[i+1]
+
+		for j := 0; j+1 < len(dst); j += 2 {
+			if dst[j].Value == key && dst[j+1].Kind == yaml.MappingNode && value.Kind == yaml.MappingNode {
+				dst[j+1].Content = mergeMappings(dst[j+1].Content, value.Content)
+
+				value = nil
+
+				break
+			}
+		}
+
+		if value != nil {
+			dst = setMappingValue(dst, key, value)
+		}
+	}
+
+	return dst
+}
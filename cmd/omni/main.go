@@ -29,9 +29,11 @@ import (
 	"github.com/siderolabs/omni/client/pkg/constants"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/internal/backend"
+	"github.com/siderolabs/omni/internal/backend/audit"
 	"github.com/siderolabs/omni/internal/backend/discovery"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
+	"github.com/siderolabs/omni/internal/backend/logforward"
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/resourcelogger"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
@@ -71,6 +73,16 @@ var rootCmd = &cobra.Command{
 			return errors.New("flags --auth-saml-url and --auth-saml-metadata are mutually exclusive")
 		}
 
+		if config.Config.LoadBalancer.SNI.Enabled && config.Config.LoadBalancer.SNI.Domain == "" {
+			return errors.New("flag --lb-sni-domain must be set when --lb-sni-enabled is set")
+		}
+
+		if config.Config.LoadBalancer.SNI.Enabled &&
+			config.Config.LoadBalancer.SNI.Port >= config.Config.LoadBalancer.MinPort && config.Config.LoadBalancer.SNI.Port <= config.Config.LoadBalancer.MaxPort {
+			return fmt.Errorf("flag --lb-sni-port %d must not be in the --lb-min-port..--lb-max-port range %d-%d",
+				config.Config.LoadBalancer.SNI.Port, config.Config.LoadBalancer.MinPort, config.Config.LoadBalancer.MaxPort)
+		}
+
 		var loggerConfig zap.Config
 
 		if constants.IsDebugBuild {
@@ -183,9 +195,24 @@ func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtua
 			}
 		}()
 
+		var auditLog *audit.Log
+
+		if config.Config.AuditLog.Enabled {
+			auditLog, err = audit.NewLog(&config.Config.AuditLog)
+			if err != nil {
+				return fmt.Errorf("failed to set up audit log: %w", err)
+			}
+
+			defer func() {
+				if closeErr := auditLog.Close(); closeErr != nil {
+					logger.Error("failed to close audit log", zap.Error(closeErr))
+				}
+			}()
+		}
+
 		omniRuntime, err := omni.New(talosClientFactory, dnsService, workloadProxyServiceRegistry, resourceLogger,
 			imageFactoryClient, linkCounterDeltaCh, resourceState, virtualState,
-			prometheus.DefaultRegisterer, discoveryClient, logger.With(logging.Component("omni_runtime")))
+			prometheus.DefaultRegisterer, discoveryClient, auditLog, logger.With(logging.Component("omni_runtime")))
 		if err != nil {
 			return fmt.Errorf("failed to set up the controller runtime: %w", err)
 		}
@@ -198,6 +225,25 @@ func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtua
 			logger.With(logging.Component("siderolink_log_handler")),
 		)
 
+		if len(config.Config.LogForwarding.Endpoints) > 0 {
+			sinks := make([]logforward.Sink, 0, len(config.Config.LogForwarding.Endpoints))
+
+			for _, endpoint := range config.Config.LogForwarding.Endpoints {
+				sink, sinkErr := logforward.NewSink(endpoint)
+				if sinkErr != nil {
+					return fmt.Errorf("failed to set up log forwarding: %w", sinkErr)
+				}
+
+				sinks = append(sinks, sink)
+			}
+
+			forwarder := logforward.New(resourceState, sinks, config.Config.LogForwarding.BufferSize, logger.With(logging.Component("log_forwarder")))
+
+			prometheus.MustRegister(forwarder)
+
+			logHandler.Forwarder = forwarder
+		}
+
 		talosRuntime := talos.New(talosClientFactory, logger)
 
 		err = user.EnsureInitialResources(ctx, omniRuntime.State(), logger, config.Config.InitialUsers)
@@ -233,6 +279,7 @@ func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtua
 			omniRuntime,
 			talosRuntime,
 			logHandler,
+			auditLog,
 			authConfig,
 			rootCmdArgs.keyFile,
 			rootCmdArgs.certFile,
@@ -286,6 +333,11 @@ func init() {
 	rootCmd.Flags().StringVar(&config.Config.APIURL, "advertised-api-url", config.Config.APIURL, "advertised API frontend URL.")
 	rootCmd.Flags().StringVar(&config.Config.KubernetesProxyURL, "advertised-kubernetes-proxy-url", config.Config.KubernetesProxyURL, "advertised Kubernetes proxy URL.")
 	rootCmd.Flags().BoolVar(&config.Config.SiderolinkDisableLastEndpoint, "siderolink-disable-last-endpoint", false, "do not populate last known peer endpoint for the wireguard peers")
+	rootCmd.Flags().BoolVar(
+		&config.Config.SiderolinkRequireMachineApproval,
+		"siderolink-require-machine-approval",
+		config.Config.SiderolinkRequireMachineApproval,
+		"require the new machines to be accepted by an admin before they can be allocated to the clusters.")
 	rootCmd.Flags().StringVar(
 		&config.Config.SiderolinkWireguardAdvertisedAddress,
 		"siderolink-wireguard-advertised-addr",
@@ -309,11 +361,31 @@ func init() {
 	rootCmd.Flags().StringVar(&config.Config.SideroLinkAPIURL, "siderolink-api-advertised-url", config.Config.SideroLinkAPIURL, "SideroLink advertised API URL.")
 	rootCmd.Flags().IntVar(&config.Config.LoadBalancer.MinPort, "lb-min-port", config.Config.LoadBalancer.MinPort, "cluster load balancer port range min value.")
 	rootCmd.Flags().IntVar(&config.Config.LoadBalancer.MaxPort, "lb-max-port", config.Config.LoadBalancer.MaxPort, "cluster load balancer port range max value.")
+	rootCmd.Flags().BoolVar(&config.Config.LoadBalancer.SNI.Enabled, "lb-sni-enabled", config.Config.LoadBalancer.SNI.Enabled,
+		"serve the cluster load balancers on a single port, routing the connections by the TLS SNI instead of allocating a port per cluster. "+
+			"Only the clusters created after enabling it use the single port, the existing clusters keep their own ports until they are recreated. "+
+			"Disabling it works the same way, the clusters which use the single port keep using it. "+
+			"The clients must connect using the cluster hostname, as the connections without the TLS SNI can't be routed.")
+	rootCmd.Flags().IntVar(&config.Config.LoadBalancer.SNI.Port, "lb-sni-port", config.Config.LoadBalancer.SNI.Port, "port of the single port cluster load balancer, it must be outside of the --lb-min-port..--lb-max-port range.")
+	rootCmd.Flags().StringVar(&config.Config.LoadBalancer.SNI.Domain, "lb-sni-domain", config.Config.LoadBalancer.SNI.Domain,
+		"domain of the cluster load balancer hostnames in the single port mode, each cluster gets the '<cluster>.<domain>' hostname.")
 	rootCmd.Flags().IntVar(&config.Config.LogServerPort, "log-server-port", config.Config.LogServerPort, "port for TCP log server")
 
 	rootCmd.Flags().BoolVar(&config.Config.LogStorage.Enabled, "log-storage-enabled", config.Config.LogStorage.Enabled, "enable log storage")
 	rootCmd.Flags().StringVar(&config.Config.LogStorage.Path, "log-storage-path", config.Config.LogStorage.Path, "path of the directory for storing logs")
 	rootCmd.Flags().DurationVar(&config.Config.LogStorage.FlushPeriod, "log-storage-flush-period", config.Config.LogStorage.FlushPeriod, "period for flushing logs to disk")
+	rootCmd.Flags().Int64Var(&config.Config.LogStorage.SegmentSize, "log-storage-segment-size", config.Config.LogStorage.SegmentSize, "size in bytes after which the active log segment of a machine is compressed")
+	rootCmd.Flags().Int64Var(&config.Config.LogStorage.MaxSizePerMachine, "log-storage-max-size-per-machine", config.Config.LogStorage.MaxSizePerMachine, "maximum size in bytes of the stored logs of a single machine (0 means unlimited)")
+	rootCmd.Flags().DurationVar(&config.Config.LogStorage.MaxAge, "log-storage-max-age", config.Config.LogStorage.MaxAge, "retention period of the stored logs (0 means unlimited)")
+
+	rootCmd.Flags().StringSliceVar(&config.Config.LogForwarding.Endpoints, "log-forwarding-endpoints", config.Config.LogForwarding.Endpoints,
+		"URLs of the sinks to forward machine logs to (syslog+tcp://host:port, syslog+udp://host:port, loki+http(s)://host:port[/path], otlp+http(s)://host:port[/path])")
+	rootCmd.Flags().IntVar(&config.Config.LogForwarding.BufferSize, "log-forwarding-buffer-size", config.Config.LogForwarding.BufferSize, "number of log lines kept per sink while it is unavailable")
+
+	rootCmd.Flags().BoolVar(&config.Config.AuditLog.Enabled, "audit-log-enabled", config.Config.AuditLog.Enabled, "enable audit log of the state-mutating API calls")
+	rootCmd.Flags().StringVar(&config.Config.AuditLog.Path, "audit-log-path", config.Config.AuditLog.Path, "path of the directory for storing the audit log")
+	rootCmd.Flags().Int64Var(&config.Config.AuditLog.MaxFileSize, "audit-log-max-file-size", config.Config.AuditLog.MaxFileSize, "size in bytes after which the audit log file is rotated")
+	rootCmd.Flags().IntVar(&config.Config.AuditLog.MaxFiles, "audit-log-max-files", config.Config.AuditLog.MaxFiles, "number of the rotated audit log files to keep, the older ones are removed (0, the default, keeps all of them)")
 
 	rootCmd.Flags().BoolVar(&config.Config.Auth.Auth0.Enabled, "auth-auth0-enabled", config.Config.Auth.Auth0.Enabled,
 		"enable Auth0 authentication. Once set to true, it cannot be set back to false.")
@@ -404,7 +476,14 @@ func init() {
 		"path to local directory for cluster etcd backups",
 	)
 
-	rootCmd.MarkFlagsMutuallyExclusive("etcd-backup-s3", "etcd-backup-local-path")
+	rootCmd.Flags().StringVar(
+		&config.Config.EtcdBackup.Command,
+		"etcd-backup-command",
+		config.Config.EtcdBackup.Command,
+		"path to the external command which stores cluster etcd backups (called with upload, download and list subcommands)",
+	)
+
+	rootCmd.MarkFlagsMutuallyExclusive("etcd-backup-s3", "etcd-backup-local-path", "etcd-backup-command")
 
 	rootCmd.Flags().DurationVar(
 		&config.Config.EtcdBackup.TickInterval,
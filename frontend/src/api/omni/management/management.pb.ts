@@ -32,6 +32,10 @@ export type MachineLogsRequest = {
   machine_id?: string
   follow?: boolean
   tail_lines?: number
+  since?: GoogleProtobufTimestamp.Timestamp
+  until?: GoogleProtobufTimestamp.Timestamp
+  filter?: string
+  filter_regex?: boolean
 }
 
 export type ValidateConfigRequest = {
@@ -81,6 +85,47 @@ export type ListServiceAccountsResponse = {
   service_accounts?: ListServiceAccountsResponseServiceAccount[]
 }
 
+export type ReadAuditLogRequest = {
+  since?: GoogleProtobufTimestamp.Timestamp
+  until?: GoogleProtobufTimestamp.Timestamp
+  identity?: string
+}
+
+export type ClusterLogsRequest = {
+  machine_set?: string
+  selector?: string
+  follow?: boolean
+  tail_lines?: number
+}
+
+export type ClusterLogsResponse = {
+  machine_id?: string
+  hostname?: string
+  role?: string
+  data?: Uint8Array
+}
+
+export type MachineConfigPreviewRequest = {
+  resources?: string[]
+  deleted_config_patches?: string[]
+  talos_version?: string
+  kubernetes_version?: string
+}
+
+export type MachineConfigPreviewResponseMachine = {
+  id?: string
+  machine_set?: string
+  diff?: string
+  apply_mode?: string
+  reboot?: boolean
+  talos_upgrade?: boolean
+  error?: string
+}
+
+export type MachineConfigPreviewResponse = {
+  machines?: MachineConfigPreviewResponseMachine[]
+}
+
 export type KubeconfigRequest = {
   service_account?: boolean
   service_account_ttl?: GoogleProtobufDuration.Duration
@@ -180,4 +225,13 @@ export class ManagementService {
   static GetSupportBundle(req: GetSupportBundleRequest, entityNotifier?: fm.NotifyStreamEntityArrival<GetSupportBundleResponse>, ...options: fm.fetchOption[]): Promise<void> {
     return fm.fetchStreamingRequest<GetSupportBundleRequest, GetSupportBundleResponse>("POST", `/management.ManagementService/GetSupportBundle`, req, entityNotifier, ...options)
   }
+  static ReadAuditLog(req: ReadAuditLogRequest, entityNotifier?: fm.NotifyStreamEntityArrival<CommonCommon.Data>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<ReadAuditLogRequest, CommonCommon.Data>("POST", `/management.ManagementService/ReadAuditLog`, req, entityNotifier, ...options)
+  }
+  static ClusterLogs(req: ClusterLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ClusterLogsResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<ClusterLogsRequest, ClusterLogsResponse>("POST", `/management.ManagementService/ClusterLogs`, req, entityNotifier, ...options)
+  }
+  static MachineConfigPreview(req: MachineConfigPreviewRequest, ...options: fm.fetchOption[]): Promise<MachineConfigPreviewResponse> {
+    return fm.fetchReq<MachineConfigPreviewRequest, MachineConfigPreviewResponse>("POST", `/management.ManagementService/MachineConfigPreview`, req, ...options)
+  }
 }
\ No newline at end of file
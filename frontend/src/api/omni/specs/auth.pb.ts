@@ -93,6 +93,12 @@ export type AccessPolicyRule = {
   role?: string
 }
 
+export type AccessPolicyRole = {
+  base_role?: string
+  capabilities?: string[]
+  description?: string
+}
+
 export type AccessPolicyTestExpectedKubernetesImpersonate = {
   groups?: string[]
 }
@@ -127,6 +133,27 @@ export type AccessPolicySpec = {
   cluster_groups?: {[key: string]: AccessPolicyClusterGroup}
   rules?: AccessPolicyRule[]
   tests?: AccessPolicyTest[]
+  roles?: {[key: string]: AccessPolicyRole}
+}
+
+export type AccessGrantSpec = {
+  identity?: string
+  clusters?: string[]
+  role?: string
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  reason?: string
+}
+
+export type AccessGrantRecordSpec = {
+  grant_id?: string
+  identity?: string
+  clusters?: string[]
+  role?: string
+  reason?: string
+  granted_at?: GoogleProtobufTimestamp.Timestamp
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  revoked_at?: GoogleProtobufTimestamp.Timestamp
+  revocation_reason?: string
 }
 
 export type SAMLLabelRuleSpec = {
@@ -46,6 +46,11 @@ export enum MachineStatusSpecRole {
   WORKER = 2,
 }
 
+export enum TalosUpgradePolicyFailureAction {
+  Pause = 0,
+  Rollback = 1,
+}
+
 export enum EtcdBackupStatusSpecStatus {
   Unknown = 0,
   Ok = 1,
@@ -53,6 +58,14 @@ export enum EtcdBackupStatusSpecStatus {
   Running = 3,
 }
 
+export enum EtcdRestoreStatusSpecPhase {
+  Unknown = 0,
+  Resetting = 1,
+  Recovering = 2,
+  Done = 3,
+  Failed = 4,
+}
+
 export enum ClusterMachineStatusSpecStage {
   UNKNOWN = 0,
   BOOTING = 1,
@@ -74,6 +87,17 @@ export enum ClusterStatusSpecPhase {
   DESTROYING = 4,
 }
 
+export enum ClusterMachineDrainStatusSpecPhase {
+  DRAINING = 0,
+  DRAINED = 1,
+}
+
+export enum LoadBalancerStatusSpecUpstreamState {
+  Unknown = 0,
+  Healthy = 1,
+  Unhealthy = 2,
+}
+
 export enum MachineSetSpecUpdateStrategy {
   Unset = 0,
   Rolling = 1,
@@ -92,6 +116,14 @@ export enum TalosUpgradeStatusSpecPhase {
   Reverting = 4,
 }
 
+export enum TalosUpgradeStatusSpecStagePhase {
+  Pending = 0,
+  Upgrading = 1,
+  HealthCheck = 2,
+  Passed = 3,
+  Failed = 4,
+}
+
 export enum ControlPlaneStatusSpecConditionStatus {
   Unknown = 0,
   Ready = 1,
@@ -112,6 +144,12 @@ export enum KubernetesUpgradeStatusSpecPhase {
   Reverting = 4,
 }
 
+export enum ExposedServiceSpecProtocol {
+  HTTP = 0,
+  H2C = 2,
+  TCP = 3,
+}
+
 export enum ExtensionsConfigurationStatusSpecPhase {
   Unknown = 0,
   Ready = 1,
@@ -233,11 +271,42 @@ export type ClusterSpec = {
   talos_version?: string
   features?: ClusterSpecFeatures
   backup_configuration?: EtcdBackupConf
+  config_patch_libraries?: ConfigPatchLibraryRef[]
+  maintenance_window?: MaintenanceWindow
+  talos_upgrade_policy?: TalosUpgradePolicy
+  upgrade_channel?: UpgradeChannel
+}
+
+export type TalosUpgradePolicy = {
+  workers_percentage?: number
+  health_timeout?: GoogleProtobufDuration.Duration
+  on_failure?: TalosUpgradePolicyFailureAction
+}
+
+export type UpgradeChannel = {
+  talos?: string
+  kubernetes?: string
+  paused?: boolean
+}
+
+export type MaintenanceWindow = {
+  schedule?: string
+  duration?: GoogleProtobufDuration.Duration
+  timezone?: string
 }
 
 export type EtcdBackupConf = {
   interval?: GoogleProtobufDuration.Duration
   enabled?: boolean
+  retention?: EtcdBackupRetention
+}
+
+export type EtcdBackupRetention = {
+  keep_last?: number
+  keep_hourly?: number
+  keep_daily?: number
+  keep_weekly?: number
+  max_age?: GoogleProtobufDuration.Duration
 }
 
 export type EtcdBackupEncryptionSpec = {
@@ -260,6 +329,7 @@ export type BackupDataSpec = {
   encryption_key?: Uint8Array
   aes_cbc_encryption_secret?: string
   secretbox_encryption_secret?: string
+  retention?: EtcdBackupRetention
 }
 
 export type EtcdBackupS3ConfSpec = {
@@ -276,12 +346,30 @@ export type EtcdBackupStatusSpec = {
   error?: string
   last_backup_time?: GoogleProtobufTimestamp.Timestamp
   last_backup_attempt?: GoogleProtobufTimestamp.Timestamp
+  last_prune_time?: GoogleProtobufTimestamp.Timestamp
+  last_pruned_count?: number
+  prune_error?: string
 }
 
 export type EtcdManualBackupSpec = {
   backup_at?: GoogleProtobufTimestamp.Timestamp
 }
 
+export type EtcdRestoreSpec = {
+  cluster_uuid?: string
+  snapshot?: string
+}
+
+export type EtcdRestoreStatusSpec = {
+  phase?: EtcdRestoreStatusSpecPhase
+  error?: string
+  step?: string
+  cluster_uuid?: string
+  snapshot?: string
+  reset_machines?: string[]
+  total_machines?: number
+}
+
 export type EtcdBackupStoreStatusSpec = {
   configuration_name?: string
   configuration_error?: string
@@ -338,6 +426,7 @@ export type ClusterMachineStatusSpec = {
   last_config_error?: string
   management_address?: string
   config_apply_status?: ConfigApplyStatus
+  drain?: ClusterMachineDrainStatusSpec
 }
 
 export type Machines = {
@@ -372,6 +461,19 @@ export type ClusterMachineConfigStatusSpec = {
   last_config_error?: string
   talos_version?: string
   schematic_id?: string
+  drain_requested?: boolean
+}
+
+export type ClusterMachineDrainStatusSpec = {
+  phase?: ClusterMachineDrainStatusSpecPhase
+  reason?: string
+  nodename?: string
+  cordoned?: boolean
+  pods_remaining?: number
+  pods_blocked?: number
+  forced?: boolean
+  error?: string
+  timed_out?: boolean
 }
 
 export type ClusterBootstrapStatusSpec = {
@@ -386,11 +488,18 @@ export type LoadBalancerConfigSpec = {
   bind_port?: string
   siderolink_endpoint?: string
   endpoints?: string[]
+  sni_hostname?: string
+}
+
+export type LoadBalancerStatusSpecUpstream = {
+  address?: string
+  state?: LoadBalancerStatusSpecUpstreamState
 }
 
 export type LoadBalancerStatusSpec = {
   healthy?: boolean
   stopped?: boolean
+  upstreams?: LoadBalancerStatusSpecUpstream[]
 }
 
 export type KubernetesVersionSpec = {
@@ -418,10 +527,32 @@ export type ConfigPatchSpec = {
   data?: string
 }
 
+export type ConfigPatchLibrarySpec = {
+  data?: string
+  description?: string
+}
+
+export type ConfigPatchLibraryRef = {
+  name?: string
+  match_labels?: string[]
+  version?: number
+}
+
+export type ConfigPatchLibraryVersionSpec = {
+  data?: string
+  version?: number
+}
+
+export type MachineSetSpecMachineClassTopologySpread = {
+  key?: string
+  max_skew?: number
+}
+
 export type MachineSetSpecMachineClass = {
   name?: string
   machine_count?: number
   allocation_type?: MachineSetSpecMachineClassAllocationType
+  topology_spread?: MachineSetSpecMachineClassTopologySpread
 }
 
 export type MachineSetSpecBootstrapSpec = {
@@ -431,10 +562,17 @@ export type MachineSetSpecBootstrapSpec = {
 
 export type MachineSetSpecRollingUpdateStrategyConfig = {
   max_parallelism?: number
+  max_surge?: number
+}
+
+export type MachineSetSpecDrainConfig = {
+  timeout?: GoogleProtobufDuration.Duration
+  force?: boolean
 }
 
 export type MachineSetSpecUpdateStrategyConfig = {
   rolling?: MachineSetSpecRollingUpdateStrategyConfig
+  drain?: MachineSetSpecDrainConfig
 }
 
 export type MachineSetSpec = {
@@ -444,6 +582,16 @@ export type MachineSetSpec = {
   delete_strategy?: MachineSetSpecUpdateStrategy
   update_strategy_config?: MachineSetSpecUpdateStrategyConfig
   delete_strategy_config?: MachineSetSpecUpdateStrategyConfig
+  config_patch_libraries?: ConfigPatchLibraryRef[]
+  maintenance_window?: MaintenanceWindow
+}
+
+export type TalosUpgradeStatusSpecStage = {
+  name?: string
+  machines?: string[]
+  phase?: TalosUpgradeStatusSpecStagePhase
+  error?: string
+  health_check_started?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type TalosUpgradeStatusSpec = {
@@ -454,6 +602,8 @@ export type TalosUpgradeStatusSpec = {
   last_upgrade_version?: string
   current_upgrade_version?: string
   upgrade_versions?: string[]
+  stages?: TalosUpgradeStatusSpecStage[]
+  failed_upgrade_version?: string
 }
 
 export type MachineSetStatusSpec = {
@@ -522,6 +672,13 @@ export type KubernetesUpgradeStatusSpec = {
   upgrade_versions?: string[]
 }
 
+export type ClusterUpgradeChannelStatusSpec = {
+  talos_version?: string
+  kubernetes_version?: string
+  status?: string
+  error?: string
+}
+
 export type KubernetesUpgradeManifestStatusSpec = {
   out_of_sync?: number
   last_fatal_error?: string
@@ -537,7 +694,7 @@ type BaseOngoingTaskSpec = {
 }
 
 export type OngoingTaskSpec = BaseOngoingTaskSpec
-  & OneOf<{ talos_upgrade: TalosUpgradeStatusSpec; kubernetes_upgrade: KubernetesUpgradeStatusSpec; destroy: DestroyStatusSpec }>
+  & OneOf<{ talos_upgrade: TalosUpgradeStatusSpec; kubernetes_upgrade: KubernetesUpgradeStatusSpec; destroy: DestroyStatusSpec; etcd_restore: EtcdRestoreStatusSpec; drain: ClusterMachineDrainStatusSpec }>
 
 export type ClusterMachineEncryptionKeySpec = {
   data?: Uint8Array
@@ -547,6 +704,7 @@ export type ExposedServiceSpec = {
   port?: number
   label?: string
   icon_base64?: string
+  protocol?: ExposedServiceSpecProtocol
 }
 
 export type FeaturesConfigSpec = {
@@ -560,8 +718,36 @@ export type EtcdBackupSettings = {
   max_interval?: GoogleProtobufDuration.Duration
 }
 
+export type MachineClassSpecRequirementsDisk = {
+  type?: string
+  min_size_gb?: string
+  model?: string
+  count?: number
+}
+
+export type MachineClassSpecRequirements = {
+  min_cores?: number
+  min_memory_mb?: string
+  disks?: MachineClassSpecRequirementsDisk[]
+  min_nic_speed_mbps?: number
+  regions?: string[]
+  zones?: string[]
+  archs?: string[]
+}
+
 export type MachineClassSpec = {
   match_labels?: string[]
+  requirements?: MachineClassSpecRequirements
+}
+
+export type MachineClassStatusSpecMismatch = {
+  machine_id?: string
+  reasons?: string[]
+}
+
+export type MachineClassStatusSpec = {
+  matching_machines?: number
+  mismatches?: MachineClassStatusSpecMismatch[]
 }
 
 export type MachineConfigGenOptionsSpec = {
@@ -5,6 +5,13 @@
 */
 
 import * as GoogleProtobufTimestamp from "../../google/protobuf/timestamp.pb"
+
+export enum MachineAcceptanceSpecPhase {
+  Pending = 0,
+  Accepted = 1,
+  Rejected = 2,
+}
+
 export type SiderolinkConfigSpec = {
   private_key?: string
   public_key?: string
@@ -21,6 +28,7 @@ export type SiderolinkSpec = {
   last_endpoint?: string
   connected?: boolean
   virtual_addrport?: string
+  join_token_id?: string
 }
 
 export type SiderolinkCounterSpec = {
@@ -34,4 +42,19 @@ export type ConnectionParamsSpec = {
   api_endpoint?: string
   wireguard_endpoint?: string
   join_token?: string
-}
\ No newline at end of file
+}
+
+export type JoinTokenSpec = {
+  token?: string
+  expiration_time?: GoogleProtobufTimestamp.Timestamp
+  max_uses?: number
+  revoked?: boolean
+  machine_labels?: {[key: string]: string}
+  cluster?: string
+  machine_class?: string
+  uses?: number
+}
+
+export type MachineAcceptanceSpec = {
+  phase?: MachineAcceptanceSpecPhase
+}
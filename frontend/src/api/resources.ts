@@ -24,6 +24,7 @@ export const DefaultKubernetesVersion = "1.29.1";
 export const ServiceLabelAnnotationKey = "omni-kube-service-exposer.sidero.dev/label";
 export const ServicePortAnnotationKey = "omni-kube-service-exposer.sidero.dev/port";
 export const ServiceIconAnnotationKey = "omni-kube-service-exposer.sidero.dev/icon";
+export const ServiceProtocolAnnotationKey = "omni-kube-service-exposer.sidero.dev/protocol";
 export const installDiskMinSize = 5e+09;
 export const workloadProxyHostPrefix = "p";
 export const workloadProxyPublicKeyIdCookie = "publicKeyId";
@@ -34,6 +35,8 @@ export const EphemeralNamespace = "ephemeral";
 export const MetricsNamespace = "metrics";
 export const VirtualNamespace = "virtual";
 export const ExternalNamespace = "external";
+export const AccessGrantType = "AccessGrants.omni.sidero.dev";
+export const AccessGrantRecordType = "AccessGrantRecords.omni.sidero.dev";
 export const AccessPolicyType = "AccessPolicies.omni.sidero.dev";
 export const AuthConfigID = "auth-config";
 export const AuthConfigType = "AuthConfigs.omni.sidero.dev";
@@ -49,6 +52,7 @@ export const MachineLocked = "omni.sidero.dev/locked";
 export const ResourceManagedByClusterTemplates = "omni.sidero.dev/managed-by-cluster-templates";
 export const ConfigPatchName = "name";
 export const ConfigPatchDescription = "description";
+export const ClusterMachinePendingReplacement = "omni.sidero.dev/pending-replacement";
 export const EtcdBackupS3ConfID = "etcd-backup-s3-conf";
 export const EtcdBackupS3ConfType = "EtcdBackupS3Configs.omni.sidero.dev";
 export const BackupDataType = "BackupDatas.omni.sidero.dev";
@@ -61,14 +65,18 @@ export const ClusterMachineType = "ClusterMachines.omni.sidero.dev";
 export const ClusterMachineConfigType = "ClusterMachineConfigs.omni.sidero.dev";
 export const ClusterMachineConfigPatchesType = "ClusterMachineConfigPatches.omni.sidero.dev";
 export const ClusterMachineConfigStatusType = "ClusterMachineConfigStatuses.omni.sidero.dev";
+export const ClusterMachineDrainStatusType = "ClusterMachineDrainStatuses.omni.sidero.dev";
 export const ClusterMachineEncryptionKeyType = "ClusterMachineEncryptionKeys.omni.sidero.dev";
 export const ClusterMachineIdentityType = "ClusterMachineIdentities.omni.sidero.dev";
 export const ClusterMachineTalosVersionType = "ClusterMachineTalosVersions.omni.sidero.dev";
 export const ClusterMachineStatusType = "ClusterMachineStatuses.omni.sidero.dev";
 export const ClusterMachineTemplateType = "ClusterMachineTemplates.omni.sidero.dev";
 export const ClusterStatusType = "ClusterStatuses.omni.sidero.dev";
+export const ClusterUpgradeChannelStatusType = "ClusterUpgradeChannelStatuses.omni.sidero.dev";
 export const ClusterUUIDType = "ClusterUUIDs.omni.sidero.dev";
 export const ConfigPatchType = "ConfigPatches.omni.sidero.dev";
+export const ConfigPatchLibraryType = "ConfigPatchLibraries.omni.sidero.dev";
+export const ConfigPatchLibraryVersionType = "ConfigPatchLibraryVersions.omni.sidero.dev";
 export const ControlPlaneStatusType = "ControlPlaneStatuses.omni.sidero.dev";
 export const EtcdBackupType = "EtcdBackups.omni.sidero.dev";
 export const EtcdBackupStoreStatusID = "etcdbackup-store-status";
@@ -78,6 +86,8 @@ export const EtcdBackupOverallStatusID = "etcdbackup-overall-status";
 export const EtcdBackupOverallStatusType = "EtcdBackupOverallStatuses.omni.sidero.dev";
 export const EtcdBackupStatusType = "EtcdBackupStatuses.omni.sidero.dev";
 export const EtcdManualBackupType = "EtcdManualBackups.omni.sidero.dev";
+export const EtcdRestoreType = "EtcdRestores.omni.sidero.dev";
+export const EtcdRestoreStatusType = "EtcdRestoreStatuses.omni.sidero.dev";
 export const ExposedServiceType = "ExposedServices.omni.sidero.dev";
 export const ExtensionsConfigurationType = "ExtensionsConfigurations.omni.sidero.dev";
 export const ExtensionsConfigurationStatusType = "ExtensionsConfigurationStatuses.omni.sidero.dev";
@@ -101,6 +111,7 @@ export const LabelClusterMachine = "omni.sidero.dev/cluster-machine";
 export const LabelMachine = "omni.sidero.dev/machine";
 export const LabelSystemPatch = "omni.sidero.dev/system-patch";
 export const LabelExposedServiceAlias = "omni.sidero.dev/exposed-service-alias";
+export const LabelConfigPatchLibrary = "omni.sidero.dev/config-patch-library";
 export const MachineStatusLabelConnected = "omni.sidero.dev/connected";
 export const MachineStatusLabelDisconnected = "omni.sidero.dev/disconnected";
 export const MachineStatusLabelInvalidState = "omni.sidero.dev/invalid-state";
@@ -116,9 +127,13 @@ export const MachineStatusLabelPlatform = "omni.sidero.dev/platform";
 export const MachineStatusLabelRegion = "omni.sidero.dev/region";
 export const MachineStatusLabelZone = "omni.sidero.dev/zone";
 export const MachineStatusLabelInstance = "omni.sidero.dev/instance";
+export const MachineStatusLabelPendingAcceptance = "omni.sidero.dev/pending-acceptance";
 export const ClusterMachineStatusLabelNodeName = "omni.sidero.dev/node-name";
+export const LabelTargetCluster = "omni.sidero.dev/target-cluster";
+export const LabelTargetMachineClass = "omni.sidero.dev/target-machine-class";
 export const MachineType = "Machines.omni.sidero.dev";
 export const MachineClassType = "MachineClasses.omni.sidero.dev";
+export const MachineClassStatusType = "MachineClassStatuses.omni.sidero.dev";
 export const MachineConfigGenOptionsType = "MachineConfigGenOptions.omni.sidero.dev";
 export const MachineExtensionsType = "MachineExtensions.omni.sidero.dev";
 export const MachineLabelsType = "MachineLabels.omni.sidero.dev";
@@ -145,6 +160,8 @@ export const ConfigType = "Configs.omni.sidero.dev";
 export const ConfigID = "siderolink-config";
 export const ConnectionParamsType = "ConnectionParams.omni.sidero.dev";
 export const SiderolinkResourceType = "Links.omni.sidero.dev";
+export const JoinTokenType = "JoinTokens.omni.sidero.dev";
+export const MachineAcceptanceType = "MachineAcceptances.omni.sidero.dev";
 export const SiderolinkCounterNamespace = "metrics";
 export const SysVersionType = "SysVersions.system.sidero.dev";
 export const SysVersionID = "current";
@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package audit implements the append-only audit log of the state-mutating API calls and of the changes Omni makes to the resources owned by the users.
+package audit
+
+import (
+	"strings"
+	"time"
+)
+
+// Operation is the kind of the audited API call.
+type Operation string
+
+// Operation values.
+const (
+	OperationCreate   Operation = "create"
+	OperationUpdate   Operation = "update"
+	OperationDestroy  Operation = "destroy"
+	OperationTeardown Operation = "teardown"
+	OperationSync     Operation = "sync"
+)
+
+// Entry is a single audit log record.
+type Entry struct {
+	Time              time.Time `json:"time"`
+	Identity          string    `json:"identity,omitempty"`
+	Role              string    `json:"role,omitempty"`
+	Method            string    `json:"method"`
+	Operation         Operation `json:"operation"`
+	ResourceNamespace string    `json:"resource_namespace,omitempty"`
+	ResourceType      string    `json:"resource_type,omitempty"`
+	ResourceID        string    `json:"resource_id,omitempty"`
+	Diff              string    `json:"diff,omitempty"`
+	Error             string    `json:"error,omitempty"`
+}
+
+// Filter selects the audit log entries.
+//
+// Zero values match everything.
+type Filter struct {
+	Since    time.Time
+	Until    time.Time
+	Identity string
+}
+
+func (f Filter) matches(entry *Entry) bool {
+	if !f.Since.IsZero() && entry.Time.Before(f.Since) {
+		return false
+	}
+
+	if !f.Until.IsZero() && entry.Time.After(f.Until) {
+		return false
+	}
+
+	if f.Identity != "" && !strings.EqualFold(f.Identity, entry.Identity) {
+		return false
+	}
+
+	return true
+}
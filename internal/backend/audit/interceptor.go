@@ -0,0 +1,299 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/api/v1alpha1"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"github.com/siderolabs/go-api-signature/pkg/message"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/api/common"
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/resources"
+	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
+	omniresources "github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/grpc/router"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// operations maps the audited gRPC methods to the operations they perform.
+var operations = map[string]Operation{
+	v1alpha1.State_Create_FullMethodName:  OperationCreate,
+	v1alpha1.State_Update_FullMethodName:  OperationUpdate,
+	v1alpha1.State_Destroy_FullMethodName: OperationDestroy,
+
+	resources.ResourceService_Create_FullMethodName:   OperationCreate,
+	resources.ResourceService_Update_FullMethodName:   OperationUpdate,
+	resources.ResourceService_Delete_FullMethodName:   OperationDestroy,
+	resources.ResourceService_Teardown_FullMethodName: OperationTeardown,
+
+	management.ManagementService_CreateServiceAccount_FullMethodName:    OperationCreate,
+	management.ManagementService_RenewServiceAccount_FullMethodName:     OperationUpdate,
+	management.ManagementService_DestroyServiceAccount_FullMethodName:   OperationDestroy,
+	management.ManagementService_KubernetesSyncManifests_FullMethodName: OperationSync,
+}
+
+// Interceptor records the state-mutating API calls to the audit log.
+//
+// It should be installed after the authentication interceptors, so that the identity and the role of the caller are known.
+// Failed calls are recorded as well, together with the error.
+type Interceptor struct {
+	log    *Log
+	state  state.State
+	logger *zap.Logger
+}
+
+// NewInterceptor creates a new audit interceptor.
+//
+// The state is used to read the resources before and after the call to record the diff.
+func NewInterceptor(log *Log, st state.State, logger *zap.Logger) *Interceptor {
+	return &Interceptor{
+		log:    log,
+		state:  st,
+		logger: logger,
+	}
+}
+
+// Unary returns a new unary audit interceptor.
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		operation, ok := operations[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		ptr := requestResource(ctx, req)
+		old := i.get(ctx, ptr)
+
+		resp, err := handler(ctx, req)
+
+		i.record(ctx, info.FullMethod, operation, ptr, old, err)
+
+		return resp, err
+	}
+}
+
+// Stream returns a new stream audit interceptor.
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		operation, ok := operations[info.FullMethod]
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		wrapped := &requestRecordingStream{ServerStream: ss}
+
+		err := handler(srv, wrapped)
+
+		// dry runs do not change anything
+		if dryRun, ok := wrapped.request.(interface{ GetDryRun() bool }); ok && dryRun.GetDryRun() {
+			return err
+		}
+
+		i.record(ss.Context(), info.FullMethod, operation, requestResource(ss.Context(), wrapped.request), nil, err)
+
+		return err
+	}
+}
+
+func (i *Interceptor) record(ctx context.Context, method string, operation Operation, ptr *resource.Metadata, old resource.Resource, callErr error) {
+	entry := &Entry{
+		Time:      time.Now(),
+		Method:    method,
+		Operation: operation,
+	}
+
+	if identity, ok := ctx.Value(auth.IdentityContextKey{}).(string); ok {
+		entry.Identity = identity
+	} else if email, ok := ctx.Value(auth.VerifiedEmailContextKey{}).(string); ok {
+		entry.Identity = email
+	}
+
+	if r, ok := ctx.Value(auth.RoleContextKey{}).(role.Role); ok {
+		entry.Role = string(r)
+	}
+
+	if ptr != nil {
+		entry.ResourceNamespace = ptr.Namespace()
+		entry.ResourceType = ptr.Type()
+		entry.ResourceID = ptr.ID()
+	}
+
+	if callErr != nil {
+		entry.Error = callErr.Error()
+	} else if ptr != nil {
+		diff, err := resourceDiff(old, i.get(ctx, ptr))
+		if err != nil {
+			i.logger.Warn("failed to compute audit log diff", zap.String("resource", ptr.String()), zap.Error(err))
+		}
+
+		entry.Diff = diff
+	}
+
+	if err := i.log.Write(entry); err != nil {
+		i.logger.Error("failed to write audit log entry", zap.String("method", method), zap.Error(err))
+	}
+}
+
+// get returns the resource from the state, or nil if it doesn't exist or can't be read.
+func (i *Interceptor) get(ctx context.Context, ptr *resource.Metadata) resource.Resource {
+	if ptr == nil || ptr.Type() == "" || ptr.ID() == "" {
+		return nil
+	}
+
+	res, err := i.state.Get(actor.MarkContextAsInternalActor(ctx), ptr)
+	if err != nil {
+		return nil
+	}
+
+	return res
+}
+
+// requestResource returns the resource which is changed by the request, or nil if it is not known.
+func requestResource(ctx context.Context, req any) *resource.Metadata {
+	switch r := req.(type) {
+	case *v1alpha1.CreateRequest:
+		return metadataPointer(r.GetResource().GetMetadata())
+	case *v1alpha1.UpdateRequest:
+		return metadataPointer(r.GetNewResource().GetMetadata())
+	case *v1alpha1.DestroyRequest:
+		return newMetadata(r.GetNamespace(), r.GetType(), r.GetId())
+	case *resources.CreateRequest:
+		return omniRuntimeResource(ctx, metadataPointer(r.GetResource().GetMetadata()))
+	case *resources.UpdateRequest:
+		return omniRuntimeResource(ctx, metadataPointer(r.GetResource().GetMetadata()))
+	case *resources.DeleteRequest:
+		return omniRuntimeResource(ctx, newMetadata(r.GetNamespace(), r.GetType(), r.GetId()))
+	case *management.RenewServiceAccountRequest:
+		return authres.NewIdentity(omniresources.DefaultNamespace, r.GetName()+pkgaccess.ServiceAccountNameSuffix).Metadata()
+	case *management.DestroyServiceAccountRequest:
+		return authres.NewIdentity(omniresources.DefaultNamespace, r.GetName()+pkgaccess.ServiceAccountNameSuffix).Metadata()
+	case *management.KubernetesSyncManifestRequest:
+		if requestContext := router.ExtractContext(ctx); requestContext != nil {
+			return omni.NewCluster(omniresources.DefaultNamespace, requestContext.Name).Metadata()
+		}
+	}
+
+	return nil
+}
+
+func metadataPointer(md *v1alpha1.Metadata) *resource.Metadata {
+	if md == nil {
+		return nil
+	}
+
+	return newMetadata(md.GetNamespace(), md.GetType(), md.GetId())
+}
+
+func newMetadata(ns resource.Namespace, typ resource.Type, id resource.ID) *resource.Metadata {
+	md := resource.NewMetadata(ns, typ, id, resource.VersionUndefined)
+
+	return &md
+}
+
+// omniRuntimeResource returns the pointer only if the resource API call targets Omni resources, and not Talos or Kubernetes ones.
+func omniRuntimeResource(ctx context.Context, ptr *resource.Metadata) *resource.Metadata {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if source := md.Get(message.RuntimeHeaderHey); len(source) == 0 || source[0] != common.Runtime_Omni.String() {
+		return nil
+	}
+
+	return ptr
+}
+
+// resourceDiff returns the unified diff of the resource YAML representations.
+//
+// Diffs of the sensitive resources are not recorded.
+func resourceDiff(oldRes, newRes resource.Resource) (string, error) {
+	if oldRes == nil && newRes == nil {
+		return "", nil
+	}
+
+	for _, res := range []resource.Resource{oldRes, newRes} {
+		if res == nil {
+			continue
+		}
+
+		if rd, ok := res.(meta.ResourceDefinitionProvider); !ok || rd.ResourceDefinition().Sensitivity == meta.Sensitive {
+			return "", nil
+		}
+	}
+
+	oldYAML, err := resourceAsYAML(oldRes)
+	if err != nil {
+		return "", err
+	}
+
+	newYAML, err := resourceAsYAML(newRes)
+	if err != nil {
+		return "", err
+	}
+
+	if oldYAML == newYAML {
+		return "", nil
+	}
+
+	name := resource.String(oldRes)
+	if oldRes == nil {
+		name = resource.String(newRes)
+	}
+
+	edits := myers.ComputeEdits(span.URIFromPath(name), oldYAML, newYAML)
+
+	return fmt.Sprint(gotextdiff.ToUnified(name, name, oldYAML, edits)), nil
+}
+
+func resourceAsYAML(res resource.Resource) (string, error) {
+	if res == nil {
+		return "", nil
+	}
+
+	resYAML, err := resource.MarshalYAML(res)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal resource to YAML: %w", err)
+	}
+
+	yamlBytes, err := yaml.Marshal(resYAML)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal resource to YAML bytes: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// requestRecordingStream keeps the first message received from the client.
+type requestRecordingStream struct {
+	grpc.ServerStream
+
+	request any
+}
+
+func (s *requestRecordingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.request == nil {
+		s.request = m
+	}
+
+	return err
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/api/v1alpha1"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/audit"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestInterceptorUnary(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	log, err := audit.NewLog(&config.AuditLogParams{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, log.Close()) })
+
+	interceptor := audit.NewInterceptor(log, st, zaptest.NewLogger(t)).Unary()
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "prod")
+	cluster.TypedSpec().Value.KubernetesVersion = "1.29.0"
+	require.NoError(t, st.Create(ctx, cluster))
+
+	updated := cluster.DeepCopy().(*omni.Cluster) //nolint:forcetypeassert,errcheck
+	updated.TypedSpec().Value.KubernetesVersion = "1.30.0"
+
+	protoRes, err := protobuf.FromResource(updated)
+	require.NoError(t, err)
+
+	protoMsg, err := protoRes.Marshal()
+	require.NoError(t, err)
+
+	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, "user@example.com")
+	ctx = context.WithValue(ctx, auth.RoleContextKey{}, role.Operator)
+
+	updateInfo := &grpc.UnaryServerInfo{FullMethod: v1alpha1.State_Update_FullMethodName}
+
+	_, err = interceptor(ctx, &v1alpha1.UpdateRequest{NewResource: protoMsg}, updateInfo, func(ctx context.Context, _ any) (any, error) {
+		return nil, st.Update(ctx, updated)
+	})
+	require.NoError(t, err)
+
+	_, err = interceptor(ctx, &v1alpha1.UpdateRequest{NewResource: protoMsg}, updateInfo, func(context.Context, any) (any, error) {
+		return nil, errors.New("permission denied")
+	})
+	require.Error(t, err)
+
+	// read-only calls are not recorded
+	_, err = interceptor(ctx, &v1alpha1.GetRequest{}, &grpc.UnaryServerInfo{FullMethod: v1alpha1.State_Get_FullMethodName}, func(context.Context, any) (any, error) {
+		return nil, nil //nolint:nilnil
+	})
+	require.NoError(t, err)
+
+	entries := readAll(t, log, audit.Filter{})
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "user@example.com", entries[0].Identity)
+	assert.Equal(t, string(role.Operator), entries[0].Role)
+	assert.Equal(t, audit.OperationUpdate, entries[0].Operation)
+	assert.Equal(t, omni.ClusterType, entries[0].ResourceType)
+	assert.Equal(t, "prod", entries[0].ResourceID)
+	assert.Contains(t, entries[0].Diff, "-    kubernetesversion: 1.29.0")
+	assert.Contains(t, entries[0].Diff, "+    kubernetesversion: 1.30.0")
+	assert.Empty(t, entries[0].Error)
+
+	assert.Equal(t, "permission denied", entries[1].Error)
+	assert.Empty(t, entries[1].Diff)
+}
+
+func TestInterceptorStream(t *testing.T) {
+	log, err := audit.NewLog(&config.AuditLogParams{Path: t.TempDir()})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, log.Close()) })
+
+	interceptor := audit.NewInterceptor(log, state.WrapCore(namespaced.NewState(inmem.Build)), zaptest.NewLogger(t)).Stream()
+	info := &grpc.StreamServerInfo{FullMethod: management.ManagementService_KubernetesSyncManifests_FullMethodName}
+
+	for _, dryRun := range []bool{true, false} {
+		ss := &requestStream{
+			ctx:     context.WithValue(context.Background(), auth.IdentityContextKey{}, "user@example.com"),
+			request: &management.KubernetesSyncManifestRequest{DryRun: dryRun},
+		}
+
+		require.NoError(t, interceptor(nil, ss, info, func(_ any, stream grpc.ServerStream) error {
+			return stream.RecvMsg(&management.KubernetesSyncManifestRequest{})
+		}))
+	}
+
+	// the dry run is not recorded
+	entries := readAll(t, log, audit.Filter{})
+	require.Len(t, entries, 1)
+
+	assert.Equal(t, audit.OperationSync, entries[0].Operation)
+	assert.Equal(t, "user@example.com", entries[0].Identity)
+}
+
+type requestStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context //nolint:containedctx
+	request proto.Message
+}
+
+func (s *requestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *requestStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.request) //nolint:forcetypeassert,errcheck
+
+	return nil
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+const (
+	currentFileName   = "audit.jsonl"
+	rotatedFilePrefix = "audit-"
+	fileSuffix        = ".jsonl"
+
+	// rotatedFileTimeFormat sorts lexicographically in the chronological order.
+	rotatedFileTimeFormat = "20060102T150405.000000000Z"
+)
+
+// Log is an append-only audit log stored as rotated JSON-lines files.
+//
+// Entries are appended to the current file, which is rotated once it reaches the maximum size.
+// Rotated files are named after the rotation time, so all entries of a rotated file are older than its name.
+type Log struct {
+	file *os.File
+
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+	size        int64
+
+	mu sync.Mutex
+}
+
+// NewLog opens the audit log in the directory from the params.
+func NewLog(params *config.AuditLogParams) (*Log, error) {
+	if params.Path == "" {
+		return nil, errors.New("audit log path is not set")
+	}
+
+	if err := os.MkdirAll(params.Path, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+
+	l := &Log{
+		dir:         params.Path,
+		maxFileSize: params.MaxFileSize,
+		maxFiles:    params.MaxFiles,
+	}
+
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Write appends the entry to the log.
+func (l *Log) Write(entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log entry: %w", err)
+	}
+
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return errors.New("audit log is closed")
+	}
+
+	if l.maxFileSize > 0 && l.size > 0 && l.size+int64(len(data)) > l.maxFileSize {
+		if err = l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(data)
+	l.size += int64(n)
+
+	if err != nil {
+		return fmt.Errorf("failed to write audit log entry: %w", err)
+	}
+
+	return nil
+}
+
+// WriteUpdate appends the entry for the update of the resource made by Omni itself rather than by an API call.
+//
+// The method is the name of the component which made the update, e.g. the name of the controller.
+func (l *Log) WriteUpdate(method string, oldRes, newRes resource.Resource) error {
+	diff, err := resourceDiff(oldRes, newRes)
+	if err != nil {
+		return err
+	}
+
+	return l.Write(&Entry{
+		Time:              time.Now(),
+		Method:            method,
+		Operation:         OperationUpdate,
+		ResourceNamespace: newRes.Metadata().Namespace(),
+		ResourceType:      newRes.Metadata().Type(),
+		ResourceID:        newRes.Metadata().ID(),
+		Diff:              diff,
+	})
+}
+
+// Read calls the callback with each entry matching the filter in the chronological order of the files.
+//
+// The callback receives the entry as it is stored in the log, as a single JSON line.
+func (l *Log) Read(filter Filter, callback func(line []byte) error) error {
+	current, currentSize, rotated, err := l.snapshot()
+	if err != nil {
+		return err
+	}
+
+	defer current.Close() //nolint:errcheck
+
+	for _, name := range rotated {
+		rotatedAt, parseErr := time.Parse(rotatedFileTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, rotatedFilePrefix), fileSuffix))
+		if parseErr == nil && !filter.Since.IsZero() && rotatedAt.Before(filter.Since) {
+			continue
+		}
+
+		if err = readRotatedFile(filepath.Join(l.dir, name), filter, callback); err != nil {
+			return err
+		}
+	}
+
+	return readEntries(io.LimitReader(current, currentSize), currentFileName, filter, callback)
+}
+
+// snapshot opens the current file and lists the rotated files consistently with each other.
+//
+// Only the part of the current file written before the call is read, the file might be appended concurrently.
+func (l *Log) snapshot() (*os.File, int64, []string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rotated, err := l.rotatedFiles()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	current, err := os.Open(filepath.Join(l.dir, currentFileName))
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+
+	return current, l.size, rotated, nil
+}
+
+// Close closes the log.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	err := l.file.Close()
+	l.file = nil
+
+	return err
+}
+
+func (l *Log) open() error {
+	f, err := os.OpenFile(filepath.Join(l.dir, currentFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log file: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close() //nolint:errcheck
+
+		return fmt.Errorf("failed to stat audit log file: %w", err)
+	}
+
+	l.file = f
+	l.size = info.Size()
+
+	return nil
+}
+
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit log file: %w", err)
+	}
+
+	l.file = nil
+
+	rotatedName := rotatedFilePrefix + time.Now().UTC().Format(rotatedFileTimeFormat) + fileSuffix
+
+	if err := os.Rename(filepath.Join(l.dir, currentFileName), filepath.Join(l.dir, rotatedName)); err != nil {
+		return fmt.Errorf("failed to rotate audit log file: %w", err)
+	}
+
+	if err := l.open(); err != nil {
+		return err
+	}
+
+	return l.prune()
+}
+
+func (l *Log) prune() error {
+	if l.maxFiles <= 0 {
+		return nil
+	}
+
+	rotated, err := l.rotatedFiles()
+	if err != nil {
+		return err
+	}
+
+	for len(rotated) > l.maxFiles {
+		if err = os.Remove(filepath.Join(l.dir, rotated[0])); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove rotated audit log file: %w", err)
+		}
+
+		rotated = rotated[1:]
+	}
+
+	return nil
+}
+
+// rotatedFiles returns the names of the rotated files sorted from the oldest to the newest.
+func (l *Log) rotatedFiles() ([]string, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log directory: %w", err)
+	}
+
+	var result []string
+
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasPrefix(entry.Name(), rotatedFilePrefix) && strings.HasSuffix(entry.Name(), fileSuffix) {
+			result = append(result, entry.Name())
+		}
+	}
+
+	slices.Sort(result)
+
+	return result, nil
+}
+
+func readRotatedFile(path string, filter Filter, callback func(line []byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		// the file could be pruned in the meantime
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to open audit log file: %w", err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return readEntries(f, filepath.Base(path), filter, callback)
+}
+
+func readEntries(r io.Reader, name string, filter Filter, callback func(line []byte) error) error {
+	br := bufio.NewReader(r)
+
+	for {
+		line, err := br.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read audit log file %q: %w", name, err)
+		}
+
+		var entry Entry
+
+		if err = json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("failed to unmarshal audit log entry in %q: %w", name, err)
+		}
+
+		if !filter.matches(&entry) {
+			continue
+		}
+
+		if err = callback(line[:len(line)-1]); err != nil {
+			return err
+		}
+	}
+}
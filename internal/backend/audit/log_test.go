@@ -0,0 +1,149 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package audit_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/audit"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func readAll(t *testing.T, log *audit.Log, filter audit.Filter) []audit.Entry {
+	t.Helper()
+
+	var entries []audit.Entry
+
+	require.NoError(t, log.Read(filter, func(line []byte) error {
+		var entry audit.Entry
+
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+
+		return nil
+	}))
+
+	return entries
+}
+
+func TestLog(t *testing.T) {
+	dir := t.TempDir()
+	params := &config.AuditLogParams{
+		Path:        dir,
+		MaxFileSize: 512,
+		MaxFiles:    2,
+	}
+
+	log, err := audit.NewLog(params)
+	require.NoError(t, err)
+
+	start := time.Now().Add(-time.Hour)
+
+	for i := range 20 {
+		require.NoError(t, log.Write(&audit.Entry{
+			Time:         start.Add(time.Duration(i) * time.Minute),
+			Identity:     fmt.Sprintf("user-%d@example.com", i%2),
+			Method:       "/cosi.resource.State/Update",
+			Operation:    audit.OperationUpdate,
+			ResourceType: "Clusters.omni.sidero.dev",
+			ResourceID:   fmt.Sprintf("cluster-%d", i),
+		}))
+	}
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	// the current file and at most two rotated ones
+	assert.Len(t, files, 3)
+
+	for _, file := range files {
+		info, infoErr := file.Info()
+		require.NoError(t, infoErr)
+
+		assert.LessOrEqual(t, info.Size(), params.MaxFileSize)
+	}
+
+	// the oldest entries were pruned, the rest is in order
+	entries := readAll(t, log, audit.Filter{})
+	require.NotEmpty(t, entries)
+	assert.Less(t, len(entries), 20)
+	assert.Equal(t, "cluster-19", entries[len(entries)-1].ResourceID)
+
+	for i := 1; i < len(entries); i++ {
+		assert.True(t, entries[i-1].Time.Before(entries[i].Time))
+	}
+
+	filtered := readAll(t, log, audit.Filter{
+		Since:    start.Add(15 * time.Minute),
+		Until:    start.Add(18 * time.Minute),
+		Identity: "USER-1@example.com",
+	})
+
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "cluster-15", filtered[0].ResourceID)
+	assert.Equal(t, "cluster-17", filtered[1].ResourceID)
+
+	// the log is appended to after reopening
+	require.NoError(t, log.Close())
+
+	log, err = audit.NewLog(params)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, log.Close()) })
+
+	require.NoError(t, log.Write(&audit.Entry{
+		Time:       time.Now(),
+		Method:     "/cosi.resource.State/Destroy",
+		Operation:  audit.OperationDestroy,
+		ResourceID: "cluster-20",
+	}))
+
+	entries = readAll(t, log, audit.Filter{Since: start.Add(19 * time.Minute)})
+	require.Len(t, entries, 2)
+	assert.Equal(t, "cluster-19", entries[0].ResourceID)
+	assert.Equal(t, "cluster-20", entries[1].ResourceID)
+
+	_, err = os.Stat(filepath.Join(dir, "audit.jsonl"))
+	require.NoError(t, err)
+}
+
+func TestLogDefaultRetention(t *testing.T) {
+	dir := t.TempDir()
+
+	// the default params keep all rotated files
+	params := config.Config.AuditLog
+	params.Path = dir
+	params.MaxFileSize = 512
+
+	log, err := audit.NewLog(&params)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, log.Close()) })
+
+	for i := range 20 {
+		require.NoError(t, log.Write(&audit.Entry{
+			Time:         time.Now(),
+			Identity:     "user@example.com",
+			Method:       "/cosi.resource.State/Update",
+			Operation:    audit.OperationUpdate,
+			ResourceType: "Clusters.omni.sidero.dev",
+			ResourceID:   fmt.Sprintf("cluster-%d", i),
+		}))
+	}
+
+	assert.Len(t, readAll(t, log, audit.Filter{}), 20)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/backend/audit"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+func (s *managementServer) ReadAuditLog(req *management.ReadAuditLogRequest, serv management.ManagementService_ReadAuditLogServer) error {
+	if _, err := auth.CheckGRPC(serv.Context(), auth.WithRole(role.Admin)); err != nil {
+		return err
+	}
+
+	if s.auditLog == nil {
+		return status.Error(codes.FailedPrecondition, "audit log is disabled")
+	}
+
+	filter := audit.Filter{
+		Identity: req.Identity,
+	}
+
+	if req.Since != nil {
+		filter.Since = req.Since.AsTime()
+	}
+
+	if req.Until != nil {
+		filter.Until = req.Until.AsTime()
+	}
+
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && filter.Until.Before(filter.Since) {
+		return status.Error(codes.InvalidArgument, "until should not be before since")
+	}
+
+	return s.auditLog.Read(filter, func(line []byte) error {
+		return serv.Send(&common.Data{
+			Bytes: line,
+		})
+	})
+}
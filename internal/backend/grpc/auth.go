@@ -31,8 +31,8 @@ import (
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
-	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
 )
 
@@ -124,8 +124,8 @@ func (s *authServer) RegisterPublicKey(ctx context.Context, request *authpb.Regi
 		roleStr = user.TypedSpec().Value.Role
 	}
 
-	pubKeyRole, err := role.Parse(roleStr)
-	if err != nil {
+	// the role can be a custom one, make sure it resolves
+	if _, _, err = accesspolicy.ResolveRoleFromState(ctx, s.state, roleStr); err != nil {
 		return nil, fmt.Errorf("failed to parse role for public key: %w", err)
 	}
 
@@ -135,7 +135,7 @@ func (s *authServer) RegisterPublicKey(ctx context.Context, request *authpb.Regi
 		k.TypedSpec().Value.Confirmed = false
 		k.TypedSpec().Value.PublicKey = pubKey.data
 		k.TypedSpec().Value.Expiration = timestamppb.New(pubKey.expiration)
-		k.TypedSpec().Value.Role = string(pubKeyRole)
+		k.TypedSpec().Value.Role = roleStr
 		k.TypedSpec().Value.Identity = &specs.Identity{
 			Email: email,
 		}
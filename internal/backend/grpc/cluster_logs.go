@@ -0,0 +1,350 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"container/heap"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/grpc/router"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+// clusterLogsMergeWindow is the time the followed lines are held for to be sorted with the lines of the other machines.
+const clusterLogsMergeWindow = 500 * time.Millisecond
+
+func (s *managementServer) ClusterLogs(req *management.ClusterLogsRequest, srv management.ManagementService_ClusterLogsServer) error {
+	ctx := srv.Context()
+
+	requestContext := router.ExtractContext(ctx)
+	if requestContext == nil {
+		return status.Error(codes.InvalidArgument, "unable to extract request context")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, requestContext.Name)
+	if err != nil {
+		return err
+	}
+
+	// getting the cluster logs is equivalent to reading the machine resources of the cluster
+	if _, err = s.authCheckGRPC(ctx, auth.WithRole(role.Reader)); err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machines, err := s.clusterLogsMachines(ctx, requestContext.Name, req)
+	if err != nil {
+		return err
+	}
+
+	if len(machines) == 0 {
+		return status.Errorf(codes.NotFound, "no machines of the cluster %q match the request", requestContext.Name)
+	}
+
+	query := siderolink.LogQuery{
+		Follow:    req.Follow,
+		TailLines: optional.None[int32](),
+	}
+
+	if req.TailLines >= 0 {
+		query.TailLines = optional.Some(req.TailLines)
+	}
+
+	sources := make([]*clusterLogSource, 0, len(machines))
+
+	for _, machine := range machines {
+		reader, err := s.logHandler.Query(siderolink.MachineID(machine.MachineId), query)
+		if err != nil {
+			// the machine hasn't sent any logs yet
+			if siderolink.IsBufferNotFoundError(err) {
+				continue
+			}
+
+			for _, source := range sources {
+				source.reader.Close() //nolint:errcheck
+			}
+
+			return handleError(err)
+		}
+
+		sources = append(sources, &clusterLogSource{
+			reader:  reader,
+			machine: machine,
+		})
+	}
+
+	return handleError(mergeClusterLogs(ctx, sources, req.Follow, srv.Send))
+}
+
+// clusterLogsMachines returns the machines of the cluster matching the machine set and the label selector of the request.
+//
+// The returned responses have the line data unset.
+func (s *managementServer) clusterLogsMachines(ctx context.Context, cluster string, req *management.ClusterLogsRequest) ([]*management.ClusterLogsResponse, error) {
+	clusterMachineQuery := []resource.LabelQueryOption{resource.LabelEqual(omni.LabelCluster, cluster)}
+
+	if req.MachineSet != "" {
+		clusterMachineQuery = append(clusterMachineQuery, resource.LabelEqual(omni.LabelMachineSet, req.MachineSet))
+	}
+
+	clusterMachines, err := safe.StateListAll[*omni.ClusterMachine](ctx, s.omniState, state.WithLabelQuery(clusterMachineQuery...))
+	if err != nil {
+		return nil, err
+	}
+
+	machineStatusQuery := []resource.LabelQueryOption{resource.LabelEqual(omni.LabelCluster, cluster)}
+
+	if req.Selector != "" {
+		selector, err := labels.ParseQuery(req.Selector)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid selector: %s", err)
+		}
+
+		machineStatusQuery = append([]resource.LabelQueryOption{resource.RawLabelQuery(*selector)}, machineStatusQuery...)
+	}
+
+	machineStatuses, err := safe.StateListAll[*omni.MachineStatus](ctx, s.omniState, state.WithLabelQuery(machineStatusQuery...))
+	if err != nil {
+		return nil, err
+	}
+
+	hostnames := make(map[resource.ID]string, machineStatuses.Len())
+
+	machineStatuses.ForEach(func(machineStatus *omni.MachineStatus) {
+		hostnames[machineStatus.Metadata().ID()] = machineStatus.TypedSpec().Value.GetNetwork().GetHostname()
+	})
+
+	machines := make([]*management.ClusterLogsResponse, 0, clusterMachines.Len())
+
+	clusterMachines.ForEach(func(clusterMachine *omni.ClusterMachine) {
+		hostname, ok := hostnames[clusterMachine.Metadata().ID()]
+		if !ok && req.Selector != "" {
+			return
+		}
+
+		machineRole := "worker"
+
+		if _, controlPlane := clusterMachine.Metadata().Labels().Get(omni.LabelControlPlaneRole); controlPlane {
+			machineRole = "controlplane"
+		}
+
+		machines = append(machines, &management.ClusterLogsResponse{
+			MachineId: clusterMachine.Metadata().ID(),
+			Hostname:  hostname,
+			Role:      machineRole,
+		})
+	})
+
+	return machines, nil
+}
+
+// clusterLogSource is the log reader of a single machine of the cluster.
+type clusterLogSource struct {
+	reader  siderolink.LogReader
+	machine *management.ClusterLogsResponse
+
+	// lastTimestamp is the timestamp of the last line, used for the lines without a timestamp.
+	lastTimestamp time.Time
+}
+
+// timestamp returns the time of the line set by Talos.
+func (source *clusterLogSource) timestamp(line []byte) time.Time {
+	var fields struct {
+		TalosTime time.Time `json:"talos-time"`
+	}
+
+	if err := json.Unmarshal(line, &fields); err == nil && !fields.TalosTime.IsZero() {
+		source.lastTimestamp = fields.TalosTime
+	} else if source.lastTimestamp.IsZero() {
+		source.lastTimestamp = time.Now()
+	}
+
+	return source.lastTimestamp
+}
+
+type clusterLogLine struct {
+	timestamp time.Time
+	received  time.Time
+	data      []byte
+	source    int
+	seq       int
+}
+
+// clusterLogHeap orders the lines by the timestamp, keeping the order of the lines of a single machine.
+type clusterLogHeap []*clusterLogLine
+
+func (h clusterLogHeap) Len() int { return len(h) }
+
+func (h clusterLogHeap) Less(i, j int) bool {
+	if !h[i].timestamp.Equal(h[j].timestamp) {
+		return h[i].timestamp.Before(h[j].timestamp)
+	}
+
+	if h[i].source != h[j].source {
+		return h[i].source < h[j].source
+	}
+
+	return h[i].seq < h[j].seq
+}
+
+func (h clusterLogHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *clusterLogHeap) Push(x any) { *h = append(*h, x.(*clusterLogLine)) } //nolint:forcetypeassert,errcheck
+
+func (h *clusterLogHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return x
+}
+
+// mergeClusterLogs reads the lines of all sources and sends them ordered by the timestamp.
+//
+// Without follow, all lines are read before sending them.
+// When following, each line is held for the merge window, so the lines received together are sent in order.
+// The readers of the sources are closed on return.
+//
+//nolint:gocognit,gocyclo,cyclop
+func mergeClusterLogs(ctx context.Context, sources []*clusterLogSource, follow bool, send func(*management.ClusterLogsResponse) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	lineCh := make(chan *clusterLogLine)
+	errCh := make(chan error, len(sources))
+
+	for i, source := range sources {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for seq := 0; ; seq++ {
+				data, err := source.reader.ReadLine()
+				if err != nil {
+					if errors.Is(err, io.EOF) || ctx.Err() != nil {
+						err = nil
+					}
+
+					errCh <- err
+
+					return
+				}
+
+				line := &clusterLogLine{
+					timestamp: source.timestamp(data),
+					received:  time.Now(),
+					data:      data,
+					source:    i,
+					seq:       seq,
+				}
+
+				select {
+				case lineCh <- line:
+				case <-ctx.Done():
+					errCh <- nil
+
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		// the readers are closed to unblock the followed reads
+		<-ctx.Done()
+
+		for _, source := range sources {
+			source.reader.Close() //nolint:errcheck
+		}
+	}()
+
+	// the context is canceled before waiting for the readers to stop
+	defer wg.Wait()
+	defer cancel()
+
+	var (
+		pending clusterLogHeap
+		ticker  *time.Ticker
+		tickCh  <-chan time.Time
+	)
+
+	if follow {
+		ticker = time.NewTicker(clusterLogsMergeWindow / 5)
+		defer ticker.Stop()
+
+		tickCh = ticker.C
+	}
+
+	sendLine := func(line *clusterLogLine) error {
+		machine := sources[line.source].machine
+
+		return send(&management.ClusterLogsResponse{
+			MachineId: machine.MachineId,
+			Hostname:  machine.Hostname,
+			Role:      machine.Role,
+			Data:      line.data,
+		})
+	}
+
+	// flush sends the pending lines which were received before the deadline, all lines if the deadline is zero
+	flush := func(deadline time.Time) error {
+		for pending.Len() > 0 {
+			if !deadline.IsZero() && pending[0].received.After(deadline) {
+				return nil
+			}
+
+			if err := sendLine(heap.Pop(&pending).(*clusterLogLine)); err != nil { //nolint:forcetypeassert,errcheck
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for running := len(sources); running > 0; {
+		select {
+		case <-ctx.Done():
+			return nil
+		case line := <-lineCh:
+			heap.Push(&pending, line)
+		case err := <-errCh:
+			if err != nil {
+				return err
+			}
+
+			running--
+		case now := <-tickCh:
+			if err := flush(now.Add(-clusterLogsMergeWindow)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush(time.Time{})
+}
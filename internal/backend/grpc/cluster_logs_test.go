@@ -0,0 +1,157 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/backend/grpc"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+// logReader returns the lines sent to the channel, and io.EOF once the channel is closed or the reader is closed.
+type logReader struct {
+	lines  chan string
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newLogReader(lines ...string) *logReader {
+	r := &logReader{
+		lines:  make(chan string, len(lines)),
+		closed: make(chan struct{}),
+	}
+
+	for _, line := range lines {
+		r.lines <- line
+	}
+
+	return r
+}
+
+func (r *logReader) ReadLine() ([]byte, error) {
+	select {
+	case line, ok := <-r.lines:
+		if !ok {
+			return nil, io.EOF
+		}
+
+		return []byte(line), nil
+	case <-r.closed:
+		return nil, io.EOF
+	}
+}
+
+func (r *logReader) Close() error {
+	r.once.Do(func() { close(r.closed) })
+
+	return nil
+}
+
+func logLine(msg, talosTime string) string {
+	return `{"msg":"` + msg + `","talos-time":"` + talosTime + `"}`
+}
+
+var clusterLogMachines = []*management.ClusterLogsResponse{
+	{MachineId: "machine-1", Hostname: "cp-1", Role: "controlplane"},
+	{MachineId: "machine-2", Hostname: "worker-1", Role: "worker"},
+}
+
+func TestMergeClusterLogs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	controlPlane := newLogReader(
+		logLine("cp 1", "2024-05-01T10:00:01Z"),
+		"not a json line",
+		logLine("cp 3", "2024-05-01T10:00:04Z"),
+	)
+	close(controlPlane.lines)
+
+	worker := newLogReader(
+		logLine("worker 1", "2024-05-01T10:00:00Z"),
+		logLine("worker 2", "2024-05-01T10:00:02Z"),
+		logLine("worker 3", "2024-05-01T10:00:03Z"),
+	)
+	close(worker.lines)
+
+	var received []string
+
+	require.NoError(t, grpc.MergeClusterLogs(ctx, []siderolink.LogReader{controlPlane, worker}, clusterLogMachines, false,
+		func(resp *management.ClusterLogsResponse) error {
+			received = append(received, resp.Hostname+"/"+resp.Role+": "+string(resp.Data))
+
+			return nil
+		},
+	))
+
+	assert.Equal(t, []string{
+		"worker-1/worker: " + logLine("worker 1", "2024-05-01T10:00:00Z"),
+		"cp-1/controlplane: " + logLine("cp 1", "2024-05-01T10:00:01Z"),
+		// the lines without the time keep the position after the previous line of the machine
+		"cp-1/controlplane: not a json line",
+		"worker-1/worker: " + logLine("worker 2", "2024-05-01T10:00:02Z"),
+		"worker-1/worker: " + logLine("worker 3", "2024-05-01T10:00:03Z"),
+		"cp-1/controlplane: " + logLine("cp 3", "2024-05-01T10:00:04Z"),
+	}, received)
+}
+
+func TestMergeClusterLogsFollow(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	controlPlane := newLogReader(logLine("cp 1", "2024-05-01T10:00:01Z"))
+	worker := newLogReader(logLine("worker 1", "2024-05-01T10:00:00Z"))
+
+	receivedCh := make(chan string)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- grpc.MergeClusterLogs(ctx, []siderolink.LogReader{controlPlane, worker}, clusterLogMachines, true,
+			func(resp *management.ClusterLogsResponse) error {
+				select {
+				case receivedCh <- resp.MachineId + ": " + string(resp.Data):
+				case <-ctx.Done():
+				}
+
+				return nil
+			},
+		)
+	}()
+
+	receive := func() string {
+		select {
+		case line := <-receivedCh:
+			return line
+		case <-ctx.Done():
+			require.FailNow(t, "timeout waiting for the log line")
+		}
+
+		return ""
+	}
+
+	// the lines received together are sorted by the time
+	assert.Equal(t, "machine-2: "+logLine("worker 1", "2024-05-01T10:00:00Z"), receive())
+	assert.Equal(t, "machine-1: "+logLine("cp 1", "2024-05-01T10:00:01Z"), receive())
+
+	// the followed lines are sent as they come
+	controlPlane.lines <- logLine("cp 2", "2024-05-01T10:00:05Z")
+
+	assert.Equal(t, "machine-1: "+logLine("cp 2", "2024-05-01T10:00:05Z"), receive())
+
+	cancel()
+
+	require.NoError(t, <-errCh)
+}
@@ -6,10 +6,14 @@
 package grpc
 
 import (
+	"context"
+
 	"github.com/cosi-project/runtime/pkg/state"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/management"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
 )
 
 type ManagementServer = managementServer
@@ -26,3 +30,18 @@ func NewManagementServer(st state.State, imageFactoryClient *imagefactory.Client
 func GenerateDest(apiurl string) (string, error) {
 	return generateDest(apiurl)
 }
+
+func MergeClusterLogs(ctx context.Context, readers []siderolink.LogReader, machines []*management.ClusterLogsResponse, follow bool,
+	send func(*management.ClusterLogsResponse) error,
+) error {
+	sources := make([]*clusterLogSource, 0, len(readers))
+
+	for i, reader := range readers {
+		sources = append(sources, &clusterLogSource{
+			reader:  reader,
+			machine: machines[i],
+		})
+	}
+
+	return mergeClusterLogs(ctx, sources, follow, send)
+}
@@ -23,6 +23,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/siderolabs/omni/client/api/talos/machine"
+	"github.com/siderolabs/omni/internal/backend/audit"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
 	"github.com/siderolabs/omni/internal/backend/logging"
@@ -43,6 +44,7 @@ type ServiceServer interface {
 func MakeServiceServers(
 	state state.State,
 	logHandler *siderolink.LogHandler,
+	auditLog *audit.Log,
 	oidcProvider OIDCProvider,
 	jwtSigningKeyProvider JWTSigningKeyProvider,
 	dnsService *dns.Service,
@@ -61,6 +63,7 @@ func MakeServiceServers(
 		},
 		&managementServer{
 			logHandler:            logHandler,
+			auditLog:              auditLog,
 			omniconfigDest:        dest,
 			omniState:             state,
 			dnsService:            dnsService,
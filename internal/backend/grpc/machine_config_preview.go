@@ -0,0 +1,193 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"github.com/siderolabs/gen/xerrors"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/grpc/router"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// machineConfigPreviewApplyTimeout is the timeout of the Talos apply-config dry run of each machine.
+const machineConfigPreviewApplyTimeout = 10 * time.Second
+
+func (s *managementServer) MachineConfigPreview(ctx context.Context, req *management.MachineConfigPreviewRequest) (*management.MachineConfigPreviewResponse, error) {
+	requestContext := router.ExtractContext(ctx)
+	if requestContext == nil {
+		return nil, status.Error(codes.InvalidArgument, "unable to extract request context")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, requestContext.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// the preview shows the redacted machine configs, which are readable by the cluster readers
+	if _, err = s.authCheckGRPC(ctx, auth.WithRole(role.Reader)); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	changes, err := parseConfigChanges(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	previews, err := omniCtrl.PreviewMachineConfigs(ctx, s.omniState, requestContext.Name, changes, config.Config.DefaultConfigGenOptions)
+	if err != nil {
+		if xerrors.TagIs[omniCtrl.InvalidConfigChangesTag](err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	talosClient, err := s.getPreviewTalosClient(ctx, requestContext.Name)
+	if err != nil {
+		s.logger.Warn("failed to get talos client for the config preview", zap.String("cluster", requestContext.Name), zap.Error(err))
+	}
+
+	response := &management.MachineConfigPreviewResponse{
+		Machines: make([]*management.MachineConfigPreviewResponse_Machine, 0, len(previews)),
+	}
+
+	for _, preview := range previews {
+		machine := &management.MachineConfigPreviewResponse_Machine{
+			Id:           preview.ID,
+			MachineSet:   preview.MachineSet,
+			TalosUpgrade: preview.TalosUpgrade,
+			Reboot:       preview.TalosUpgrade,
+			Error:        preview.Error,
+		}
+
+		response.Machines = append(response.Machines, machine)
+
+		if preview.Error != "" || preview.CurrentConfig == preview.ProposedConfig {
+			continue
+		}
+
+		edits := myers.ComputeEdits(span.URIFromPath(preview.ID), preview.CurrentConfig, preview.ProposedConfig)
+		machine.Diff = fmt.Sprint(gotextdiff.ToUnified(preview.ID, preview.ID, preview.CurrentConfig, edits))
+
+		switch {
+		case talosClient == nil:
+			machine.Error = "the apply mode is not known: the cluster is not reachable"
+		case preview.NodeAddress == "":
+			machine.Error = "the apply mode is not known: the node address of the machine is not known yet"
+		default:
+			mode, err := previewApplyMode(ctx, talosClient, preview)
+			if err != nil {
+				machine.Error = fmt.Sprintf("the apply mode is not known: %s", err)
+
+				continue
+			}
+
+			machine.ApplyMode = strings.ToLower(mode.String())
+			machine.Reboot = machine.Reboot || mode == machineapi.ApplyConfigurationRequest_REBOOT
+		}
+	}
+
+	return response, nil
+}
+
+func (s *managementServer) getPreviewTalosClient(ctx context.Context, clusterName string) (*talos.Client, error) {
+	type talosClientGetter interface {
+		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
+	}
+
+	talosRuntime, err := runtime.LookupInterface[talosClientGetter](talos.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return talosRuntime.GetClient(ctx, clusterName)
+}
+
+// previewApplyMode asks Talos which mode it would apply the proposed config in, without applying it.
+func previewApplyMode(ctx context.Context, talosClient *talos.Client, preview omniCtrl.MachineConfigPreview) (machineapi.ApplyConfigurationRequest_Mode, error) {
+	ctx, cancel := context.WithTimeout(ctx, machineConfigPreviewApplyTimeout)
+	defer cancel()
+
+	resp, err := talosClient.ApplyConfiguration(client.WithNode(ctx, preview.NodeAddress), &machineapi.ApplyConfigurationRequest{
+		Data:   preview.Config,
+		Mode:   machineapi.ApplyConfigurationRequest_AUTO,
+		DryRun: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(resp.Messages) != 1 {
+		return 0, fmt.Errorf("unexpected number of responses: %d", len(resp.Messages))
+	}
+
+	return resp.Messages[0].GetMode(), nil
+}
+
+func parseConfigChanges(req *management.MachineConfigPreviewRequest) (omniCtrl.ConfigChanges, error) {
+	changes := omniCtrl.ConfigChanges{
+		DeletedConfigPatches: req.DeletedConfigPatches,
+		TalosVersion:         strings.TrimPrefix(req.TalosVersion, "v"),
+		KubernetesVersion:    strings.TrimPrefix(req.KubernetesVersion, "v"),
+	}
+
+	for _, raw := range req.Resources {
+		var res protobuf.YAMLResource
+
+		if err := yaml.Unmarshal([]byte(raw), &res); err != nil {
+			return changes, fmt.Errorf("failed to parse the resource: %w", err)
+		}
+
+		switch r := res.Resource().(type) {
+		case *omnires.ConfigPatch:
+			if r.Metadata().Namespace() != resources.DefaultNamespace {
+				return changes, fmt.Errorf("config patch %q should be in the %q namespace", r.Metadata().ID(), resources.DefaultNamespace)
+			}
+
+			if err := omnires.ValidateConfigPatch(r.TypedSpec().Value.Data); err != nil {
+				return changes, fmt.Errorf("config patch %q is invalid: %w", r.Metadata().ID(), err)
+			}
+
+			changes.ConfigPatches = append(changes.ConfigPatches, r)
+		case *omnires.MachineSet:
+			if r.Metadata().Namespace() != resources.DefaultNamespace {
+				return changes, fmt.Errorf("machine set %q should be in the %q namespace", r.Metadata().ID(), resources.DefaultNamespace)
+			}
+
+			changes.MachineSets = append(changes.MachineSets, r)
+		default:
+			return changes, fmt.Errorf("resource %s can't be previewed, only config patches and machine sets are supported", res.Resource().Metadata())
+		}
+	}
+
+	return changes, nil
+}
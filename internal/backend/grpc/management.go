@@ -6,11 +6,13 @@
 package grpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -46,6 +48,7 @@ import (
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	ctlcfg "github.com/siderolabs/omni/client/pkg/omnictl/config"
+	"github.com/siderolabs/omni/internal/backend/audit"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/grpc/router"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
@@ -74,6 +77,7 @@ type managementServer struct {
 	jwtSigningKeyProvider JWTSigningKeyProvider
 
 	logHandler         *siderolink.LogHandler
+	auditLog           *audit.Log
 	logger             *zap.Logger
 	dnsService         *dns.Service
 	imageFactoryClient *imagefactory.Client
@@ -107,7 +111,7 @@ func (s *managementServer) Kubeconfig(ctx context.Context, req *management.Kubec
 
 	// not a service account, generate OIDC (user) kubeconfig
 
-	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader))
+	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader), auth.WithCapabilities(role.CapabilityDownloadKubeconfig))
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +138,15 @@ func (s *managementServer) Kubeconfig(ctx context.Context, req *management.Kubec
 func (s *managementServer) Talosconfig(ctx context.Context, request *management.TalosconfigRequest) (*management.TalosconfigResponse, error) {
 	// getting talosconfig is low risk, as it doesn't contain any sensitive data
 	// real check for authentication happens in the Talos API gRPC proxy
-	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader))
+	if commonContext := router.ExtractContext(ctx); commonContext != nil && commonContext.Name != "" {
+		var err error
+
+		if ctx, err = s.applyClusterAccessPolicy(ctx, commonContext.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	authResult, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader), auth.WithCapabilities(role.CapabilityDownloadTalosconfig))
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +208,14 @@ func (s *managementServer) MachineLogs(request *management.MachineLogsRequest, r
 		tailLines = optional.Some(request.TailLines)
 	}
 
-	logReader, err := s.logHandler.GetReader(siderolink.MachineID(machineID), request.Follow, tailLines)
+	query, err := machineLogsQuery(request)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	query.TailLines = tailLines
+
+	logReader, err := s.logHandler.Query(siderolink.MachineID(machineID), query)
 	if err != nil {
 		return handleError(err)
 	}
@@ -230,6 +249,46 @@ func (s *managementServer) MachineLogs(request *management.MachineLogsRequest, r
 	}
 }
 
+// machineLogsQuery builds the log query from the time range and the filter of the request.
+func machineLogsQuery(request *management.MachineLogsRequest) (siderolink.LogQuery, error) {
+	query := siderolink.LogQuery{
+		Follow: request.Follow,
+	}
+
+	if request.Since != nil {
+		query.Since = request.Since.AsTime()
+	}
+
+	if request.Until != nil {
+		query.Until = request.Until.AsTime()
+	}
+
+	if request.Follow && (request.Since != nil || request.Until != nil) {
+		return query, errors.New("since and until can't be used with follow")
+	}
+
+	if !query.Since.IsZero() && !query.Until.IsZero() && query.Until.Before(query.Since) {
+		return query, errors.New("until is before since")
+	}
+
+	switch {
+	case request.Filter == "":
+	case request.FilterRegex:
+		re, err := regexp.Compile(request.Filter)
+		if err != nil {
+			return query, fmt.Errorf("invalid filter: %w", err)
+		}
+
+		query.Match = re.Match
+	default:
+		filter := []byte(request.Filter)
+
+		query.Match = func(line []byte) bool { return bytes.Contains(line, filter) }
+	}
+
+	return query, nil
+}
+
 func (s *managementServer) ValidateConfig(ctx context.Context, request *management.ValidateConfigRequest) (*emptypb.Empty, error) {
 	// validating machine config is low risk, require any valid signature
 	if _, err := auth.CheckGRPC(ctx, auth.WithValidSignature(true)); err != nil {
@@ -323,7 +382,8 @@ func (s *managementServer) CreateServiceAccount(ctx context.Context, req *manage
 	} else {
 		var reqRole role.Role
 
-		reqRole, err = role.Parse(req.GetRole())
+		// the service account can have a custom role, its capabilities are always within the Admin role
+		reqRole, _, err = accesspolicy.ResolveRoleFromState(ctx, s.omniState, req.GetRole())
 		if err != nil {
 			return nil, err
 		}
@@ -541,89 +601,46 @@ func (s *managementServer) DestroyServiceAccount(ctx context.Context, req *manag
 }
 
 func (s *managementServer) KubernetesUpgradePreChecks(ctx context.Context, req *management.KubernetesUpgradePreChecksRequest) (*management.KubernetesUpgradePreChecksResponse, error) {
-	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
-		return nil, err
-	}
-
-	ctx = actor.MarkContextAsInternalActor(ctx)
-
 	requestContext := router.ExtractContext(ctx)
 	if requestContext == nil {
 		return nil, status.Error(codes.InvalidArgument, "unable to extract request context")
 	}
 
-	upgradeStatus, err := safe.StateGet[*omnires.KubernetesUpgradeStatus](ctx, s.omniState, omnires.NewKubernetesUpgradeStatus(resources.DefaultNamespace, requestContext.Name).Metadata())
+	ctx, err := s.applyClusterAccessPolicy(ctx, requestContext.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	currentVersion := upgradeStatus.TypedSpec().Value.LastUpgradeVersion
-	if currentVersion == "" {
-		return nil, status.Error(codes.FailedPrecondition, "current version is not known yet")
-	}
-
-	path, err := upgrade.NewPath(currentVersion, req.NewVersion)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid upgrade path: %v", err)
-	}
-
-	if !path.IsSupported() {
-		return nil, status.Errorf(codes.InvalidArgument, "unsupported upgrade path: %s", path)
-	}
-
-	type kubeConfigGetter interface {
-		GetKubeconfig(ctx context.Context, cluster *commonOmni.Context) (*rest.Config, error)
-	}
-
-	k8sRuntime, err := runtime.LookupInterface[kubeConfigGetter](kubernetes.Name)
-	if err != nil {
+	if _, err = s.authCheckGRPC(ctx, auth.WithRole(role.Operator), auth.WithCapabilities(role.CapabilityUpdateKubernetes)); err != nil {
 		return nil, err
 	}
 
-	restConfig, err := k8sRuntime.GetKubeconfig(ctx, requestContext)
-	if err != nil {
-		return nil, fmt.Errorf("error getting kubeconfig: %w", err)
-	}
-
-	type talosClientGetter interface {
-		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
-	}
+	ctx = actor.MarkContextAsInternalActor(ctx)
 
-	talosRuntime, err := runtime.LookupInterface[talosClientGetter](talos.Name)
+	upgradeStatus, err := safe.StateGet[*omnires.KubernetesUpgradeStatus](ctx, s.omniState, omnires.NewKubernetesUpgradeStatus(resources.DefaultNamespace, requestContext.Name).Metadata())
 	if err != nil {
 		return nil, err
 	}
 
-	talosClient, err := talosRuntime.GetClient(ctx, requestContext.Name)
-	if err != nil {
-		return nil, fmt.Errorf("error getting talos client: %w", err)
+	currentVersion := upgradeStatus.TypedSpec().Value.LastUpgradeVersion
+	if currentVersion == "" {
+		return nil, status.Error(codes.FailedPrecondition, "current version is not known yet")
 	}
 
-	var controlplaneNodes []string
-
-	cmis, err := safe.StateListAll[*omnires.ClusterMachineIdentity](
-		ctx,
-		s.omniState,
-		state.WithLabelQuery(
-			resource.LabelEqual(omnires.LabelCluster, requestContext.Name),
-			resource.LabelExists(omnires.LabelControlPlaneRole),
-		),
-	)
+	path, err := upgrade.NewPath(currentVersion, req.NewVersion)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid upgrade path: %v", err)
 	}
 
-	for iter := cmis.Iterator(); iter.Next(); {
-		if len(iter.Value().TypedSpec().Value.NodeIps) > 0 {
-			controlplaneNodes = append(controlplaneNodes, iter.Value().TypedSpec().Value.NodeIps[0])
-		}
+	if !path.IsSupported() {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported upgrade path: %s", path)
 	}
 
-	s.logger.Debug("running k8s upgrade pre-checks", zap.Strings("controlplane_nodes", controlplaneNodes), zap.String("cluster", requestContext.Name))
+	s.logger.Debug("running k8s upgrade pre-checks", zap.String("cluster", requestContext.Name))
 
 	var logBuffer strings.Builder
 
-	preCheck, err := upgrade.NewChecks(path, talosClient.COSI, restConfig, controlplaneNodes, nil, func(format string, args ...any) {
+	preCheck, err := omniCtrl.NewKubernetesUpgradePreChecks(ctx, s.omniState, requestContext.Name, path, func(format string, args ...any) {
 		fmt.Fprintf(&logBuffer, format, args...)
 		fmt.Fprintln(&logBuffer)
 	})
@@ -653,17 +670,22 @@ func (s *managementServer) KubernetesUpgradePreChecks(ctx context.Context, req *
 func (s *managementServer) KubernetesSyncManifests(req *management.KubernetesSyncManifestRequest, srv management.ManagementService_KubernetesSyncManifestsServer) error {
 	ctx := srv.Context()
 
-	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
-		return err
-	}
-
-	ctx = actor.MarkContextAsInternalActor(ctx)
-
 	requestContext := router.ExtractContext(ctx)
 	if requestContext == nil {
 		return status.Error(codes.InvalidArgument, "unable to extract request context")
 	}
 
+	ctx, err := s.applyClusterAccessPolicy(ctx, requestContext.Name)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.authCheckGRPC(ctx, auth.WithRole(role.Operator), auth.WithCapabilities(role.CapabilitySyncKubernetesManifests)); err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
 	type kubernetesConfigurator interface {
 		GetKubeconfig(ctx context.Context, context *commonOmni.Context) (*rest.Config, error)
 	}
@@ -817,12 +839,16 @@ func (s *managementServer) authCheckGRPC(ctx context.Context, opts ...auth.Check
 // applyClusterAccessPolicy checks the ACLs for the user in the context against the given cluster ID.
 // If there is a match and the matched role is higher than the user's role,
 // a child context containing the given role will be returned.
+//
+// The returned context also contains the additional capabilities granted to the user on the cluster by the custom roles.
 func (s *managementServer) applyClusterAccessPolicy(ctx context.Context, clusterID resource.ID) (context.Context, error) {
-	clusterRole, _, err := accesspolicy.RoleForCluster(ctx, clusterID, s.omniState)
+	clusterRole, clusterCapabilities, _, err := accesspolicy.RoleAndCapabilitiesForCluster(ctx, clusterID, s.omniState)
 	if err != nil {
 		return nil, err
 	}
 
+	ctx = context.WithValue(ctx, auth.CapabilitiesContextKey{}, clusterCapabilities)
+
 	userRole, userRoleExists := ctx.Value(auth.RoleContextKey{}).(role.Role)
 	if !userRoleExists {
 		userRole = role.None
@@ -846,6 +872,8 @@ func handleError(err error) error {
 		return nil
 	case siderolink.IsBufferNotFoundError(err):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, siderolink.ErrLogStorageDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	}
 
 	return err
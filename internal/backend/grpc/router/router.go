@@ -175,7 +175,7 @@ func (r *Router) getTalosBackend(ctx context.Context, md metadata.MD) ([]proxy.B
 
 		r.metricActiveClients.Inc()
 
-		backend := NewTalosBackend(clusterName, r.nodeResolver, conn, r.authEnabled, r.verifier)
+		backend := NewTalosBackend(clusterName, r.nodeResolver, conn, r.cosiState, r.authEnabled, r.verifier)
 		r.talosBackends.Add(clusterName, backend)
 
 		runtime.SetFinalizer(backend, func(backend *TalosBackend) {
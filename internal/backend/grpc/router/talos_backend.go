@@ -7,8 +7,10 @@ package router
 
 import (
 	"context"
+	"slices"
 
 	"github.com/blang/semver"
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-api-signature/pkg/message"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
@@ -19,6 +21,7 @@ import (
 
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/grpcutil"
 )
@@ -38,21 +41,30 @@ var operatorMethodSet = xslices.ToSet([]string{
 	grpcutil.MustFullMethodName(&machine.MachineService_ServiceDesc, "Shutdown"),
 })
 
+// methodCapabilities maps the methods which require modify access to the capabilities which allow calling them.
+var methodCapabilities = map[string]role.Capability{
+	grpcutil.MustFullMethodName(&machine.MachineService_ServiceDesc, "Reboot"): role.CapabilityRebootMachines,
+}
+
 // TalosBackend implements a backend (proxying one2one to a Talos node).
 type TalosBackend struct {
 	conn         *grpc.ClientConn
 	nodeResolver NodeResolver
+	omniState    state.State
 	verifier     grpc.UnaryServerInterceptor
 	name         string
 	authEnabled  bool
 }
 
 // NewTalosBackend builds new Talos API backend.
-func NewTalosBackend(name string, nodeResolver NodeResolver, conn *grpc.ClientConn, authEnabled bool, verifier grpc.UnaryServerInterceptor) *TalosBackend {
+//
+// The access policy rules and the access grants from the given state are applied to the clusters of the target nodes.
+func NewTalosBackend(name string, nodeResolver NodeResolver, conn *grpc.ClientConn, omniState state.State, authEnabled bool, verifier grpc.UnaryServerInterceptor) *TalosBackend {
 	backend := &TalosBackend{
 		name:         name,
 		nodeResolver: nodeResolver,
 		conn:         conn,
+		omniState:    omniState,
 		authEnabled:  authEnabled,
 		verifier:     verifier,
 	}
@@ -92,22 +104,14 @@ func (l *TalosBackend) GetConnection(ctx context.Context, fullMethodName string)
 		return ctx, nil, err
 	}
 
-	hasModifyAccess := false
-
-	_, modifyErr := auth.Check(ctx, auth.WithRole(role.Operator))
-	if modifyErr == nil {
-		hasModifyAccess = true
-	}
+	resolved := resolveNodes(l.nodeResolver, md)
 
-	if !hasModifyAccess {
-		// at least read access is required
-		if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Reader)); err != nil {
-			return ctx, nil, err
-		}
+	hasModifyAccess, err := l.checkAccess(ctx, fullMethodName, resolved)
+	if err != nil {
+		return ctx, nil, err
 	}
 
 	// overwrite the node headers with the resolved ones
-	resolved := resolveNodes(l.nodeResolver, md)
 	if resolved.node.Address != "" {
 		md = md.Copy()
 
@@ -127,6 +131,79 @@ func (l *TalosBackend) GetConnection(ctx context.Context, fullMethodName string)
 	return outCtx, l.conn, nil
 }
 
+// checkAccess checks that the caller has at least read access to the clusters of the target nodes, and whether it is allowed to call the modifying method.
+//
+// The role and the capabilities of the caller are extended on each cluster by the access policy rules and the access grants,
+// so the custom roles granted on the cluster are taken into account.
+func (l *TalosBackend) checkAccess(ctx context.Context, fullMethodName string, resolved resolvedNodeInfo) (bool, error) {
+	checkOpts := []auth.CheckOption{auth.WithRole(role.Operator)}
+
+	// a custom role might grant the capability to call the method without having the Operator role
+	if capability, ok := methodCapabilities[fullMethodName]; ok {
+		checkOpts = append(checkOpts, auth.WithCapabilities(capability))
+	}
+
+	hasModifyAccess := true
+
+	for _, cluster := range l.targetClusters(resolved) {
+		clusterCtx, err := l.applyClusterAccessPolicy(ctx, cluster)
+		if err != nil {
+			return false, err
+		}
+
+		if _, err = auth.Check(clusterCtx, checkOpts...); err == nil {
+			continue
+		}
+
+		hasModifyAccess = false
+
+		// at least read access is required
+		if _, err = auth.CheckGRPC(clusterCtx, auth.WithRole(role.Reader)); err != nil {
+			return false, err
+		}
+	}
+
+	return hasModifyAccess, nil
+}
+
+// targetClusters returns the clusters of the resolved nodes, falling back to the cluster of the backend.
+func (l *TalosBackend) targetClusters(resolved resolvedNodeInfo) []string {
+	var clusters []string
+
+	for _, info := range append([]dns.Info{resolved.node}, resolved.nodes...) {
+		if info.Cluster != "" && !slices.Contains(clusters, info.Cluster) {
+			clusters = append(clusters, info.Cluster)
+		}
+	}
+
+	if len(clusters) == 0 {
+		clusters = append(clusters, l.name)
+	}
+
+	return clusters
+}
+
+// applyClusterAccessPolicy returns the context with the role and the capabilities of the caller on the given cluster.
+func (l *TalosBackend) applyClusterAccessPolicy(ctx context.Context, cluster string) (context.Context, error) {
+	if !l.authEnabled || l.omniState == nil {
+		return ctx, nil
+	}
+
+	clusterRole, clusterCapabilities, _, err := accesspolicy.RoleAndCapabilitiesForCluster(ctx, cluster, l.omniState)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, auth.CapabilitiesContextKey{}, clusterCapabilities)
+
+	// the role is only set when the request is signed, see auth.Check
+	if _, ok := ctx.Value(auth.RoleContextKey{}).(role.Role); ok {
+		ctx = context.WithValue(ctx, auth.RoleContextKey{}, clusterRole)
+	}
+
+	return ctx, nil
+}
+
 func (l *TalosBackend) setRoleHeaders(ctx context.Context, md metadata.MD, fullMethodName string, resolvedInfo resolvedNodeInfo, hasModifyAccess bool) {
 	if !hasModifyAccess {
 		setHeaderData(ctx, md, constants.APIAuthzRoleMetadataKey, talosrole.MakeSet(talosrole.Reader).Strings()...)
@@ -13,6 +13,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/siderolabs/gen/xtesting/must"
 	"github.com/siderolabs/grpc-proxy/proxy"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
@@ -25,8 +28,13 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/grpc/router"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	omnirole "github.com/siderolabs/omni/internal/pkg/auth/role"
 )
 
 type testNodeResolver struct{}
@@ -50,7 +58,12 @@ func TestTalosBackendRoles(t *testing.T) {
 
 	g, ctx := errgroup.WithContext(ctx)
 
-	grpcProxy, err := makeGRPCProxy(ctx, proxyEndpoint, serverEndpoint)
+	grpcProxy, err := makeGRPCProxy(ctx, proxyEndpoint, &testDirector{
+		serverEndpoint: serverEndpoint,
+		verifier: func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+			return handler(ctx, req)
+		},
+	})
 	require.NoError(t, err)
 
 	g.Go(grpcProxy)
@@ -65,8 +78,81 @@ func TestTalosBackendRoles(t *testing.T) {
 	require.Equal(t, "talos-machine", hostnameResult.Messages[0].Hostname)
 }
 
-func makeGRPCProxy(ctx context.Context, endpoint, serverEndpoint string) (func() error, error) {
-	grpcProxyServer := router.NewServer(&testDirector{serverEndpoint: serverEndpoint})
+func TestTalosBackendAccessPolicyCustomRole(t *testing.T) {
+	const serverEndpoint = "127.0.0.1:10503"
+	serverCloser := startTestServer(must.Value(net.Listen("tcp", serverEndpoint))(t))
+
+	t.Cleanup(func() { require.NoError(t, serverCloser()) })
+
+	const proxyEndpoint = "127.0.0.1:10502"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Second)
+	defer cancel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	// the custom role which allows rebooting the machines is granted on the cluster by the access policy
+	accessPolicy := authres.NewAccessPolicy()
+	accessPolicy.TypedSpec().Value.Roles = map[string]*specs.AccessPolicyRole{
+		"rebooter": {
+			BaseRole:     string(omnirole.Reader),
+			Capabilities: []string{string(omnirole.CapabilityRebootMachines)},
+		},
+	}
+	accessPolicy.TypedSpec().Value.Rules = []*specs.AccessPolicyRule{
+		{
+			Users:    []string{"rebooter@example.com"},
+			Clusters: []string{"test-backend"},
+			Role:     "rebooter",
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, accessPolicy))
+
+	for _, identity := range []string{"rebooter@example.com", "reader@example.com"} {
+		require.NoError(t, st.Create(ctx, authres.NewIdentity(resources.DefaultNamespace, identity)))
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	grpcProxy, err := makeGRPCProxy(ctx, proxyEndpoint, &testDirector{
+		serverEndpoint: serverEndpoint,
+		state:          st,
+		authEnabled:    true,
+		// the users are authenticated with the Reader role, the identity is taken from the request metadata
+		verifier: func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+
+			ctx = context.WithValue(ctx, auth.RoleContextKey{}, omnirole.Reader)
+			ctx = context.WithValue(ctx, auth.IdentityContextKey{}, md.Get("identity")[0])
+
+			return handler(ctx, req)
+		},
+	})
+	require.NoError(t, err)
+
+	g.Go(grpcProxy)
+
+	t.Cleanup(func() { require.NoError(t, g.Wait()) })
+
+	conn := must.Value(grpc.DialContext(ctx, proxyEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials())))(t)
+	client := machine.NewMachineServiceClient(conn)
+
+	rebooterCtx := metadata.AppendToOutgoingContext(ctx, "identity", "rebooter@example.com")
+
+	_, err = client.Reboot(rebooterCtx, &machine.RebootRequest{})
+	require.NoError(t, err)
+
+	// the custom role doesn't grant the access to the other modifying methods
+	_, err = client.Shutdown(rebooterCtx, &machine.ShutdownRequest{})
+	require.ErrorContains(t, err, "unexpected role")
+
+	_, err = client.Reboot(metadata.AppendToOutgoingContext(ctx, "identity", "reader@example.com"), &machine.RebootRequest{})
+	require.ErrorContains(t, err, "unexpected role")
+}
+
+func makeGRPCProxy(ctx context.Context, endpoint string, director *testDirector) (func() error, error) {
+	grpcProxyServer := router.NewServer(director)
 
 	lis, err := net.Listen("tcp", endpoint)
 	if err != nil {
@@ -93,7 +179,10 @@ func makeGRPCProxy(ctx context.Context, endpoint, serverEndpoint string) (func()
 }
 
 type testDirector struct {
+	state          state.State
+	verifier       grpc.UnaryServerInterceptor
 	serverEndpoint string
+	authEnabled    bool
 }
 
 func (t *testDirector) Director(ctx context.Context, _ string) (proxy.Mode, []proxy.Backend, error) {
@@ -106,10 +195,9 @@ func (t *testDirector) Director(ctx context.Context, _ string) (proxy.Mode, []pr
 		"test-backend",
 		&testNodeResolver{},
 		conn,
-		false,
-		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-			return handler(ctx, req)
-		},
+		t.state,
+		t.authEnabled,
+		t.verifier,
 	)
 
 	return proxy.One2One, []proxy.Backend{backend}, nil
@@ -171,6 +259,19 @@ func (ts *testServer) Reboot(ctx context.Context, _ *machine.RebootRequest) (*ma
 	return &machine.RebootResponse{}, nil
 }
 
+func (ts *testServer) Shutdown(ctx context.Context, _ *machine.ShutdownRequest) (*machine.ShutdownResponse, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errors.New("no metadata")
+	}
+
+	if got := md.Get("talos-role")[0]; got != string(role.Admin) {
+		return nil, fmt.Errorf("unexpected role: %s", got)
+	}
+
+	return &machine.ShutdownResponse{}, nil
+}
+
 func (ts *testServer) Hostname(ctx context.Context, _ *emptypb.Empty) (*machine.HostnameResponse, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -0,0 +1,232 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+const httpTimeout = 30 * time.Second
+
+// httpSink posts the entries as JSON documents built by the payload function.
+type httpSink struct {
+	client   *http.Client
+	endpoint *url.URL
+	payload  func(entries []*Entry) any
+}
+
+func newHTTPSink(endpoint *url.URL, payload func(entries []*Entry) any) *httpSink {
+	return &httpSink{
+		client:   &http.Client{Timeout: httpTimeout},
+		endpoint: endpoint,
+		payload:  payload,
+	}
+}
+
+// String implements fmt.Stringer.
+func (s *httpSink) String() string {
+	return s.endpoint.Redacted()
+}
+
+// Send implements Sink.
+func (s *httpSink) Send(ctx context.Context, entries []*Entry) error {
+	body, err := json.Marshal(s.payload(entries))
+	if err != nil {
+		return fmt.Errorf("failed to marshal logs: %w", err)
+	}
+
+	endpoint := *s.endpoint
+	endpoint.User = nil
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if s.endpoint.User != nil {
+		password, _ := s.endpoint.User.Password()
+
+		req.SetBasicAuth(s.endpoint.User.Username(), password)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send logs: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:errcheck
+
+		err = fmt.Errorf("failed to send logs: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+
+		// the client errors (e.g. an out-of-order or an oversized batch) won't go away on retry, except for the rate limiting
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			return backoff.Permanent(err)
+		}
+
+		return err
+	}
+
+	// drain the body to reuse the connection
+	io.Copy(io.Discard, resp.Body) //nolint:errcheck
+
+	return nil
+}
+
+// Close implements Sink.
+func (s *httpSink) Close() error {
+	s.client.CloseIdleConnections()
+
+	return nil
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// lokiPayload builds the Loki push API request, with a stream per machine.
+func lokiPayload(entries []*Entry) any {
+	var (
+		streams []*lokiStream
+		byID    = map[string]*lokiStream{}
+	)
+
+	for _, entry := range entries {
+		stream, ok := byID[entry.MachineID]
+		if !ok {
+			stream = &lokiStream{
+				Stream: map[string]string{
+					"job":        "omni",
+					"machine_id": entry.MachineID,
+				},
+			}
+
+			if entry.Cluster != "" {
+				stream.Stream["cluster"] = entry.Cluster
+			}
+
+			if entry.Hostname != "" {
+				stream.Stream["hostname"] = entry.Hostname
+			}
+
+			byID[entry.MachineID] = stream
+			streams = append(streams, stream)
+		}
+
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(entry.Time.UnixNano(), 10), string(entry.Line)})
+	}
+
+	return map[string]any{"streams": streams}
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	Body           otlpValue       `json:"body"`
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityText   string          `json:"severityText,omitempty"`
+	Attributes     []otlpAttribute `json:"attributes,omitempty"`
+	SeverityNumber int             `json:"severityNumber,omitempty"`
+}
+
+type otlpScopeLogs struct {
+	Scope      map[string]string `json:"scope"`
+	LogRecords []otlpLogRecord   `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource  map[string][]otlpAttribute `json:"resource"`
+	ScopeLogs []*otlpScopeLogs           `json:"scopeLogs"`
+}
+
+// otlpPayload builds the OTLP/HTTP logs export request in the JSON encoding, with a resource per machine.
+func otlpPayload(entries []*Entry) any {
+	var (
+		resourceLogs []*otlpResourceLogs
+		byID         = map[string]*otlpScopeLogs{}
+	)
+
+	for _, entry := range entries {
+		scopeLogs, ok := byID[entry.MachineID]
+		if !ok {
+			attributes := []otlpAttribute{
+				{Key: "service.name", Value: otlpValue{StringValue: "talos"}},
+				{Key: "omni.machine.id", Value: otlpValue{StringValue: entry.MachineID}},
+			}
+
+			if entry.Cluster != "" {
+				attributes = append(attributes, otlpAttribute{Key: "omni.cluster", Value: otlpValue{StringValue: entry.Cluster}})
+			}
+
+			if entry.Hostname != "" {
+				attributes = append(attributes, otlpAttribute{Key: "host.name", Value: otlpValue{StringValue: entry.Hostname}})
+			}
+
+			scopeLogs = &otlpScopeLogs{Scope: map[string]string{"name": "omni"}}
+			byID[entry.MachineID] = scopeLogs
+
+			resourceLogs = append(resourceLogs, &otlpResourceLogs{
+				Resource:  map[string][]otlpAttribute{"attributes": attributes},
+				ScopeLogs: []*otlpScopeLogs{scopeLogs},
+			})
+		}
+
+		record := otlpLogRecord{
+			TimeUnixNano:   strconv.FormatInt(entry.Time.UnixNano(), 10),
+			SeverityText:   entry.Level,
+			SeverityNumber: otlpSeverity(entry.Level),
+			Body:           otlpValue{StringValue: entry.Message},
+		}
+
+		if entry.Service != "" {
+			record.Attributes = []otlpAttribute{{Key: "talos.service", Value: otlpValue{StringValue: entry.Service}}}
+		}
+
+		scopeLogs.LogRecords = append(scopeLogs.LogRecords, record)
+	}
+
+	return map[string]any{"resourceLogs": resourceLogs}
+}
+
+func otlpSeverity(level string) int {
+	switch strings.ToLower(level) {
+	case "debug":
+		return 5
+	case "info":
+		return 9
+	case "warn", "warning":
+		return 13
+	case "error":
+		return 17
+	case "panic", "fatal", "dpanic":
+		return 21
+	default:
+		return 0
+	}
+}
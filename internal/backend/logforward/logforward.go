@@ -0,0 +1,269 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package logforward implements forwarding of the machine logs received over SideroLink to the external sinks.
+package logforward
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+// Entry is a single machine log line enriched with the machine labels.
+type Entry struct {
+	// Time is the time of the log line set by Talos, or the time it was received if it's missing.
+	Time      time.Time
+	MachineID string
+	Cluster   string
+	Hostname  string
+	// Service is the Talos service which produced the line, if known.
+	Service string
+	// Level is the Talos log level of the line, if known.
+	Level string
+	// Message is the message of the line, or the whole line if it doesn't have one.
+	Message string
+	// Line is the raw JSON line as it was sent by Talos.
+	Line []byte
+}
+
+// Sink sends the log entries to an external system.
+//
+// Send is never called concurrently, and the failed batches are sent again,
+// unless the error is a *backoff.PermanentError, in which case the batch is dropped.
+type Sink interface {
+	fmt.Stringer
+
+	Send(ctx context.Context, entries []*Entry) error
+	Close() error
+}
+
+// NewSink creates a new sink from the endpoint URL.
+//
+// Supported schemes are syslog+tcp, syslog+udp, loki+http(s) and otlp+http(s).
+// HTTP sinks use the credentials from the URL for the basic authentication.
+func NewSink(endpoint string) (Sink, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log forwarding endpoint: %w", err)
+	}
+
+	kind, transport, ok := strings.Cut(u.Scheme, "+")
+	if !ok {
+		return nil, fmt.Errorf("invalid log forwarding endpoint %q: scheme should be in the <sink>+<transport> format", u.Redacted())
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid log forwarding endpoint %q: host is not set", u.Redacted())
+	}
+
+	switch {
+	case kind == "syslog" && (transport == "tcp" || transport == "udp"):
+		return newSyslogSink(transport, u.Host), nil
+	case kind == "loki" && (transport == "http" || transport == "https"):
+		return newHTTPSink(httpEndpoint(u, transport, "/loki/api/v1/push"), lokiPayload), nil
+	case kind == "otlp" && (transport == "http" || transport == "https"):
+		return newHTTPSink(httpEndpoint(u, transport, "/v1/logs"), otlpPayload), nil
+	}
+
+	return nil, fmt.Errorf("unsupported log forwarding endpoint scheme %q", u.Scheme)
+}
+
+func httpEndpoint(u *url.URL, scheme, defaultPath string) *url.URL {
+	result := *u
+	result.Scheme = scheme
+
+	if result.Path == "" || result.Path == "/" {
+		result.Path = defaultPath
+	}
+
+	return &result
+}
+
+type machineLabels struct {
+	cluster  string
+	hostname string
+}
+
+// Forwarder forwards the machine log lines to the sinks.
+//
+// Each sink has its own buffer, so an unavailable sink doesn't block the others.
+// When the buffer of a sink is full, the oldest lines are dropped.
+type Forwarder struct {
+	state               state.State
+	logger              *zap.Logger
+	machines            map[siderolink.MachineID]machineLabels
+	metricRejectedLines *prometheus.CounterVec
+	queues              []*queue
+	mu                  sync.Mutex
+}
+
+// New creates a new Forwarder.
+//
+// The state is used to look up the cluster and the hostname of the machines.
+func New(st state.State, sinks []Sink, bufferSize int, logger *zap.Logger) *Forwarder {
+	metricRejectedLines := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "omni_log_forwarding_rejected_lines_total",
+		Help: "Number of machine log lines dropped as the sink rejected them.",
+	}, []string{"sink"})
+
+	queues := make([]*queue, 0, len(sinks))
+
+	for _, sink := range sinks {
+		queues = append(queues, newQueue(sink, bufferSize, metricRejectedLines.WithLabelValues(sink.String()), logger.With(zap.Stringer("sink", sink))))
+	}
+
+	return &Forwarder{
+		state:               st,
+		logger:              logger,
+		machines:            map[siderolink.MachineID]machineLabels{},
+		metricRejectedLines: metricRejectedLines,
+		queues:              queues,
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (f *Forwarder) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(f, ch)
+}
+
+// Collect implements prometheus.Collector interface.
+func (f *Forwarder) Collect(ch chan<- prometheus.Metric) {
+	f.metricRejectedLines.Collect(ch)
+}
+
+// Forward enqueues the log line of the machine to all sinks.
+//
+// The line is copied, so the caller might reuse it.
+func (f *Forwarder) Forward(machineID siderolink.MachineID, timestamp time.Time, line []byte) {
+	var fields struct {
+		TalosTime    time.Time `json:"talos-time"`
+		Message      string    `json:"msg"`
+		TalosLevel   string    `json:"talos-level"`
+		TalosService string    `json:"talos-service"`
+	}
+
+	// the lines which are not JSON are forwarded as they are
+	if err := json.Unmarshal(line, &fields); err != nil {
+		fields.Message = string(line)
+	}
+
+	if !fields.TalosTime.IsZero() {
+		timestamp = fields.TalosTime
+	}
+
+	if fields.Message == "" {
+		fields.Message = string(line)
+	}
+
+	f.mu.Lock()
+	labels := f.machines[machineID]
+	f.mu.Unlock()
+
+	entry := &Entry{
+		Time:      timestamp,
+		MachineID: string(machineID),
+		Cluster:   labels.cluster,
+		Hostname:  labels.hostname,
+		Service:   fields.TalosService,
+		Level:     fields.TalosLevel,
+		Message:   fields.Message,
+		Line:      slices.Clone(line),
+	}
+
+	for _, q := range f.queues {
+		q.push(entry)
+	}
+}
+
+// Run sends the enqueued lines to the sinks until the context is canceled.
+func (f *Forwarder) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	for _, q := range f.queues {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			q.run(ctx)
+		}()
+	}
+
+	err := f.watchMachines(ctx)
+
+	cancel()
+	wg.Wait()
+
+	for _, q := range f.queues {
+		if closeErr := q.sink.Close(); closeErr != nil {
+			f.logger.Warn("failed to close log forwarding sink", zap.Stringer("sink", q.sink), zap.Error(closeErr))
+		}
+	}
+
+	return err
+}
+
+// watchMachines keeps the labels of the machines up to date.
+func (f *Forwarder) watchMachines(ctx context.Context) error {
+	eventCh := make(chan state.Event)
+
+	if err := f.state.WatchKind(
+		actor.MarkContextAsInternalActor(ctx),
+		resource.NewMetadata(resources.DefaultNamespace, omni.MachineStatusType, "", resource.VersionUndefined),
+		eventCh,
+		state.WithBootstrapContents(true),
+	); err != nil {
+		return fmt.Errorf("failed to watch machine statuses: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-eventCh:
+			switch event.Type {
+			case state.Created, state.Updated:
+				machineStatus, ok := event.Resource.(*omni.MachineStatus)
+				if !ok {
+					continue
+				}
+
+				f.mu.Lock()
+				f.machines[siderolink.MachineID(machineStatus.Metadata().ID())] = machineLabels{
+					cluster:  machineStatus.TypedSpec().Value.Cluster,
+					hostname: machineStatus.TypedSpec().Value.GetNetwork().GetHostname(),
+				}
+				f.mu.Unlock()
+			case state.Destroyed:
+				f.mu.Lock()
+				delete(f.machines, siderolink.MachineID(event.Resource.Metadata().ID()))
+				f.mu.Unlock()
+			case state.Bootstrapped:
+				// ignore
+			case state.Errored:
+				return fmt.Errorf("error watching machine statuses: %w", event.Error)
+			}
+		}
+	}
+}
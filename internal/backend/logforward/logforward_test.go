@@ -0,0 +1,372 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/logforward"
+)
+
+var testEntry = &logforward.Entry{
+	Time:      time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+	MachineID: "machine-1",
+	Cluster:   "prod",
+	Hostname:  "worker-1",
+	Service:   "kubelet",
+	Level:     "warn",
+	Message:   "node is not ready",
+	Line:      []byte(`{"msg":"node is not ready","talos-level":"warn","talos-service":"kubelet"}`),
+}
+
+func TestSyslogSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	sink, err := logforward.NewSink("syslog+tcp://" + listener.Addr().String())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, sink.Close()) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	require.NoError(t, sink.Send(ctx, []*logforward.Entry{testEntry, testEntry}))
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	r := bufio.NewReader(conn)
+
+	for range 2 {
+		length, err := r.ReadString(' ')
+		require.NoError(t, err)
+
+		n, err := strconv.Atoi(strings.TrimSpace(length))
+		require.NoError(t, err)
+
+		msg := make([]byte, n)
+
+		_, err = io.ReadFull(r, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, `<28>1 2024-05-01T10:00:00.000000Z worker-1 kubelet - - [omni@32473 machine="machine-1" cluster="prod"] node is not ready`, string(msg))
+	}
+}
+
+func TestHTTPSinks(t *testing.T) {
+	var (
+		path, user string
+		body       map[string]any
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		user, _, _ = r.BasicAuth()
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	endpoint := strings.Replace(server.URL, "http://", "loki+http://user:secret@", 1)
+
+	sink, err := logforward.NewSink(endpoint)
+	require.NoError(t, err)
+
+	assert.NotContains(t, sink.String(), "secret")
+
+	require.NoError(t, sink.Send(ctx, []*logforward.Entry{testEntry}))
+
+	assert.Equal(t, "/loki/api/v1/push", path)
+	assert.Equal(t, "user", user)
+	assert.Equal(t, map[string]any{
+		"streams": []any{
+			map[string]any{
+				"stream": map[string]any{"job": "omni", "machine_id": "machine-1", "cluster": "prod", "hostname": "worker-1"},
+				"values": []any{[]any{strconv.FormatInt(testEntry.Time.UnixNano(), 10), string(testEntry.Line)}},
+			},
+		},
+	}, body)
+
+	sink, err = logforward.NewSink(strings.Replace(server.URL, "http://", "otlp+http://", 1))
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send(ctx, []*logforward.Entry{testEntry}))
+
+	assert.Equal(t, "/v1/logs", path)
+
+	record := body["resourceLogs"].([]any)[0].(map[string]any)["scopeLogs"].([]any)[0].(map[string]any)["logRecords"].([]any)[0] //nolint:forcetypeassert,errcheck
+
+	assert.Equal(t, map[string]any{
+		"timeUnixNano":   strconv.FormatInt(testEntry.Time.UnixNano(), 10),
+		"severityText":   "warn",
+		"severityNumber": float64(13),
+		"body":           map[string]any{"stringValue": "node is not ready"},
+		"attributes":     []any{map[string]any{"key": "talos.service", "value": map[string]any{"stringValue": "kubelet"}}},
+	}, record)
+
+	_, err = logforward.NewSink("loki://localhost:3100")
+	require.Error(t, err)
+
+	_, err = logforward.NewSink("kafka+tcp://localhost:9092")
+	require.Error(t, err)
+}
+
+// blockingSink fails the first call, then blocks the second one until it is released.
+type blockingSink struct {
+	started  chan struct{}
+	release  chan struct{}
+	received []*logforward.Entry
+	calls    int
+	mu       sync.Mutex
+}
+
+func (s *blockingSink) String() string { return "test" }
+
+func (s *blockingSink) Close() error { return nil }
+
+func (s *blockingSink) Send(ctx context.Context, entries []*logforward.Entry) error {
+	s.mu.Lock()
+	s.calls++
+	calls := s.calls
+	s.mu.Unlock()
+
+	switch calls {
+	case 1:
+		return errors.New("sink is down")
+	case 2:
+		close(s.started)
+
+		select {
+		case <-s.release:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	s.mu.Lock()
+	s.received = append(s.received, entries...)
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *blockingSink) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]string, 0, len(s.received))
+
+	for _, entry := range s.received {
+		result = append(result, entry.Message)
+	}
+
+	return result
+}
+
+func TestForwarder(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")
+	machineStatus.TypedSpec().Value.Cluster = "prod"
+	machineStatus.TypedSpec().Value.Network = &specs.MachineStatusSpec_NetworkStatus{Hostname: "worker-1"}
+
+	require.NoError(t, st.Create(ctx, machineStatus))
+
+	sink := &blockingSink{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	forwarder := logforward.New(st, []logforward.Sink{sink}, 2, zaptest.NewLogger(t))
+
+	var eg errgroup.Group
+
+	eg.Go(func() error { return forwarder.Run(ctx) })
+
+	// the first line is retried after the failure, and the sink blocks while sending it
+	forwarder.Forward("machine-1", time.Now(), []byte(`{"msg":"line 1","talos-time":"2024-05-01T10:00:00Z"}`))
+
+	select {
+	case <-sink.started:
+	case <-ctx.Done():
+		require.FailNow(t, "timeout waiting for the sink")
+	}
+
+	// only the last two lines fit in the buffer
+	for _, line := range []string{"line 2", "line 3", "line 4"} {
+		forwarder.Forward("machine-1", time.Now(), []byte(`{"msg":"`+line+`"}`))
+	}
+
+	close(sink.release)
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, []string{"line 1", "line 3", "line 4"}, sink.messages())
+	}, 10*time.Second, 50*time.Millisecond)
+
+	sink.mu.Lock()
+	first := sink.received[0]
+	sink.mu.Unlock()
+
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), first.Time.UTC())
+	assert.Equal(t, "machine-1", first.MachineID)
+
+	// the lines are labeled once the machine status is loaded
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		forwarder.Forward("machine-1", time.Now(), []byte(`{"msg":"labeled"}`))
+
+		sink.mu.Lock()
+		last := sink.received[len(sink.received)-1]
+		sink.mu.Unlock()
+
+		assert.Equal(collect, "prod", last.Cluster)
+		assert.Equal(collect, "worker-1", last.Hostname)
+	}, 10*time.Second, 50*time.Millisecond)
+
+	cancel()
+
+	require.NoError(t, eg.Wait())
+}
+
+func TestHTTPSinkErrors(t *testing.T) {
+	var status atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(int(status.Load()))
+	}))
+
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	sink, err := logforward.NewSink(strings.Replace(server.URL, "http://", "loki+http://", 1))
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		status    int
+		permanent bool
+	}{
+		{status: http.StatusBadRequest, permanent: true},
+		{status: http.StatusRequestEntityTooLarge, permanent: true},
+		{status: http.StatusTooManyRequests},
+		{status: http.StatusServiceUnavailable},
+	} {
+		status.Store(int32(test.status))
+
+		err = sink.Send(ctx, []*logforward.Entry{testEntry})
+		require.ErrorContains(t, err, strconv.Itoa(test.status))
+
+		var permanentErr *backoff.PermanentError
+
+		assert.Equal(t, test.permanent, errors.As(err, &permanentErr), "status %d", test.status)
+	}
+}
+
+// rejectingSink rejects the first batch with a permanent error.
+type rejectingSink struct {
+	received []string
+	calls    int
+	mu       sync.Mutex
+}
+
+func (s *rejectingSink) String() string { return "test" }
+
+func (s *rejectingSink) Close() error { return nil }
+
+func (s *rejectingSink) Send(_ context.Context, entries []*logforward.Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+
+	if s.calls == 1 {
+		return backoff.Permanent(errors.New("entry out of order"))
+	}
+
+	for _, entry := range entries {
+		s.received = append(s.received, entry.Message)
+	}
+
+	return nil
+}
+
+func (s *rejectingSink) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.received)
+}
+
+func TestForwarderRejectedBatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	t.Cleanup(cancel)
+
+	sink := &rejectingSink{}
+
+	forwarder := logforward.New(state.WrapCore(namespaced.NewState(inmem.Build)), []logforward.Sink{sink}, 10, zaptest.NewLogger(t))
+
+	var eg errgroup.Group
+
+	eg.Go(func() error { return forwarder.Run(ctx) })
+
+	forwarder.Forward("machine-1", time.Now(), []byte(`{"msg":"rejected"}`))
+
+	// the rejected batch is dropped and counted, so it doesn't block the following lines
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, float64(1), testutil.ToFloat64(forwarder))
+	}, 10*time.Second, 50*time.Millisecond)
+
+	forwarder.Forward("machine-1", time.Now(), []byte(`{"msg":"accepted"}`))
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, []string{"accepted"}, sink.messages())
+	}, 10*time.Second, 50*time.Millisecond)
+
+	cancel()
+
+	require.NoError(t, eg.Wait())
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const maxBatchSize = 500
+
+// queue is a bounded buffer of the entries of a single sink, dropping the oldest entries when it is full.
+type queue struct {
+	sink     Sink
+	rejected prometheus.Counter
+	logger   *zap.Logger
+	notify   chan struct{}
+	entries  []*Entry
+	head     int
+	size     int
+	dropped  int
+	mu       sync.Mutex
+}
+
+func newQueue(sink Sink, bufferSize int, rejected prometheus.Counter, logger *zap.Logger) *queue {
+	return &queue{
+		sink:     sink,
+		rejected: rejected,
+		logger:   logger,
+		notify:   make(chan struct{}, 1),
+		entries:  make([]*Entry, max(bufferSize, 1)),
+	}
+}
+
+func (q *queue) push(entry *Entry) {
+	q.mu.Lock()
+
+	if q.size == len(q.entries) {
+		q.head = (q.head + 1) % len(q.entries)
+		q.size--
+		q.dropped++
+	}
+
+	q.entries[(q.head+q.size)%len(q.entries)] = entry
+	q.size++
+
+	q.mu.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop removes up to n oldest entries from the queue, and returns them with the number of entries dropped since the last call.
+func (q *queue) pop(n int) ([]*Entry, int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n = min(n, q.size)
+	batch := make([]*Entry, 0, n)
+
+	for range n {
+		batch = append(batch, q.entries[q.head])
+		q.entries[q.head] = nil
+		q.head = (q.head + 1) % len(q.entries)
+		q.size--
+	}
+
+	dropped := q.dropped
+	q.dropped = 0
+
+	return batch, dropped
+}
+
+// run sends the entries to the sink, retrying the failed batches with an exponential backoff.
+//
+// The batches rejected by the sink with a permanent error are dropped, so they don't block the following entries.
+func (q *queue) run(ctx context.Context) {
+	bo := backoff.NewExponentialBackOff()
+
+	// disable number of retries limit
+	bo.MaxElapsedTime = 0
+
+	var batch []*Entry
+
+	for {
+		if len(batch) == 0 {
+			var dropped int
+
+			batch, dropped = q.pop(maxBatchSize)
+
+			if dropped > 0 {
+				q.logger.Warn("dropped machine log lines as the log forwarding buffer is full", zap.Int("count", dropped))
+			}
+
+			if len(batch) == 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case <-q.notify:
+				}
+
+				continue
+			}
+		}
+
+		err := q.sink.Send(ctx, batch)
+		if err == nil {
+			batch = nil
+
+			bo.Reset()
+
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		var permanentErr *backoff.PermanentError
+
+		if errors.As(err, &permanentErr) {
+			q.logger.Warn("dropped machine log lines as the sink rejected them", zap.Int("count", len(batch)), zap.Error(permanentErr.Err))
+
+			q.rejected.Add(float64(len(batch)))
+
+			batch = nil
+
+			bo.Reset()
+
+			continue
+		}
+
+		interval := bo.NextBackOff()
+
+		q.logger.Warn("failed to forward machine logs", zap.Duration("retry_in", interval), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	syslogTimeout = 10 * time.Second
+
+	// syslogFacility is the daemon facility.
+	syslogFacility = 3
+
+	// syslogSDID is the structured data ID of the machine labels, 32473 is the private enterprise number reserved for documentation.
+	syslogSDID = "omni@32473"
+)
+
+// syslogSink sends the entries as RFC5424 messages, using the octet counting framing over TCP.
+type syslogSink struct {
+	conn    net.Conn
+	network string
+	address string
+}
+
+func newSyslogSink(network, address string) *syslogSink {
+	return &syslogSink{
+		network: network,
+		address: address,
+	}
+}
+
+// String implements fmt.Stringer.
+func (s *syslogSink) String() string {
+	return "syslog+" + s.network + "://" + s.address
+}
+
+// Send implements Sink.
+func (s *syslogSink) Send(ctx context.Context, entries []*Entry) error {
+	if s.conn == nil {
+		dialer := net.Dialer{Timeout: syslogTimeout}
+
+		conn, err := dialer.DialContext(ctx, s.network, s.address)
+		if err != nil {
+			return fmt.Errorf("failed to connect to syslog server: %w", err)
+		}
+
+		s.conn = conn
+	}
+
+	if err := s.write(entries); err != nil {
+		// reconnect on the next attempt
+		s.conn.Close() //nolint:errcheck
+		s.conn = nil
+
+		return fmt.Errorf("failed to send syslog messages: %w", err)
+	}
+
+	return nil
+}
+
+func (s *syslogSink) write(entries []*Entry) error {
+	if err := s.conn.SetWriteDeadline(time.Now().Add(syslogTimeout)); err != nil {
+		return err
+	}
+
+	// each UDP datagram carries a single message
+	if s.network == "udp" {
+		for _, entry := range entries {
+			if _, err := s.conn.Write(formatSyslogMessage(entry)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	w := bufio.NewWriter(s.conn)
+
+	for _, entry := range entries {
+		msg := formatSyslogMessage(entry)
+
+		if _, err := w.WriteString(strconv.Itoa(len(msg)) + " "); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(msg); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// Close implements Sink.
+func (s *syslogSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn = nil
+
+	return err
+}
+
+// formatSyslogMessage formats the entry as an RFC5424 message.
+func formatSyslogMessage(entry *Entry) []byte {
+	hostname := entry.Hostname
+	if hostname == "" {
+		hostname = entry.MachineID
+	}
+
+	appName := entry.Service
+	if appName == "" {
+		appName = "talos"
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "<%d>1 %s %s %s - - [%s machine=\"%s\"",
+		syslogFacility*8+syslogSeverity(entry.Level),
+		entry.Time.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		syslogHeaderField(hostname, 255),
+		syslogHeaderField(appName, 48),
+		syslogSDID,
+		escapeSDParam(entry.MachineID),
+	)
+
+	if entry.Cluster != "" {
+		fmt.Fprintf(&sb, " cluster=\"%s\"", escapeSDParam(entry.Cluster))
+	}
+
+	sb.WriteString("] ")
+	sb.WriteString(entry.Message)
+
+	return []byte(sb.String())
+}
+
+func syslogSeverity(level string) int {
+	switch strings.ToLower(level) {
+	case "panic", "fatal", "dpanic":
+		return 2
+	case "error":
+		return 3
+	case "warn", "warning":
+		return 4
+	case "debug":
+		return 7
+	default:
+		return 6
+	}
+}
+
+// syslogHeaderField returns the value limited to the printable US-ASCII characters and the maximum length, or the nil value.
+func syslogHeaderField(value string, maxLen int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+
+		return r
+	}, value)
+
+	if value == "" {
+		return "-"
+	}
+
+	if len(value) > maxLen {
+		value = value[:maxLen]
+	}
+
+	return value
+}
+
+var sdParamEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+func escapeSDParam(value string) string {
+	return sdParamEscaper.Replace(value)
+}
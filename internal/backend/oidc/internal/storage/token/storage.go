@@ -282,7 +282,7 @@ func (s *Storage) impersonateGroupsFromUser(ctx context.Context, userID string)
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	userRole, err := role.Parse(user.TypedSpec().Value.GetRole())
+	userRole, _, err := accesspolicy.ResolveRoleFromState(ctx, s.state, user.TypedSpec().Value.GetRole())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse user role: %w", err)
 	}
@@ -299,12 +299,17 @@ func (s *Storage) impersonateGroupsFromAccessPolicy(ctx context.Context, cluster
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
 	accessPolicy, err := safe.StateGet[*auth.AccessPolicy](ctx, s.state, auth.NewAccessPolicy().Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to get access policy: %w", err)
+	}
+
+	grants, err := accesspolicy.ActiveGrants(ctx, s.state, userID)
 	if err != nil {
-		if state.IsNotFoundError(err) {
-			return nil, nil
-		}
+		return nil, fmt.Errorf("failed to get access grants: %w", err)
+	}
 
-		return nil, fmt.Errorf("failed to get access policy: %w", err)
+	if accessPolicy == nil && len(grants) == 0 {
+		return nil, nil
 	}
 
 	clusterRes, err := safe.StateGet[*omni.Cluster](ctx, s.state, omni.NewCluster(resources.DefaultNamespace, cluster).Metadata())
@@ -317,7 +322,7 @@ func (s *Storage) impersonateGroupsFromAccessPolicy(ctx context.Context, cluster
 		return nil, fmt.Errorf("failed to get identity: %w", err)
 	}
 
-	checkResult, err := accesspolicy.Check(accessPolicy, clusterRes.Metadata(), identityRes.Metadata())
+	checkResult, err := accesspolicy.Check(accessPolicy, clusterRes.Metadata(), identityRes.Metadata(), grants...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check access policy: %w", err)
 	}
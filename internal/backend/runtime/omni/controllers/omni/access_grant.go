@@ -0,0 +1,234 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
+)
+
+const (
+	// AccessGrantRevocationExpired is the revocation reason of the access grants which were revoked on expiry.
+	AccessGrantRevocationExpired = "expired"
+
+	// AccessGrantRevocationRemoved is the revocation reason of the access grants which were removed before the expiry.
+	AccessGrantRevocationRemoved = "removed"
+)
+
+// AccessGrantController records the access grants, and revokes them when they expire.
+//
+// Each access grant gets an AccessGrantRecord, which is kept after the grant is revoked.
+type AccessGrantController struct {
+	clock clock.Clock
+
+	interval time.Duration
+}
+
+// AccessGrantControllerOption is a functional option for AccessGrantController.
+type AccessGrantControllerOption func(*AccessGrantController)
+
+// WithAccessGrantClock sets the clock to use for the controller.
+func WithAccessGrantClock(clock clock.Clock) AccessGrantControllerOption {
+	return func(ctrl *AccessGrantController) {
+		ctrl.clock = clock
+	}
+}
+
+// NewAccessGrantController initializes a new AccessGrantController.
+func NewAccessGrantController(interval time.Duration, opts ...AccessGrantControllerOption) *AccessGrantController {
+	result := &AccessGrantController{interval: interval}
+
+	for _, opt := range opts {
+		opt(result)
+	}
+
+	if result.clock == nil {
+		result.clock = clock.New()
+	}
+
+	return result
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *AccessGrantController) Name() string {
+	return "AccessGrantController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *AccessGrantController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Type:      auth.AccessGrantType,
+			Kind:      controller.InputWeak,
+			Namespace: resources.DefaultNamespace,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *AccessGrantController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: auth.AccessGrantType,
+			Kind: controller.OutputShared,
+		},
+		{
+			Type: auth.AccessGrantRecordType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *AccessGrantController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := ctrl.clock.Ticker(ctrl.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		if err := ctrl.run(ctx, r, logger); err != nil {
+			return fmt.Errorf("error running access grant controller: %w", err)
+		}
+	}
+}
+
+func (ctrl *AccessGrantController) run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	grants, err := safe.ReaderListAll[*auth.AccessGrant](ctx, r)
+	if err != nil {
+		return err
+	}
+
+	records, err := safe.ReaderListAll[*auth.AccessGrantRecord](ctx, r)
+	if err != nil {
+		return err
+	}
+
+	now := ctrl.clock.Now()
+	existingGrants := make(map[resource.ID]struct{}, grants.Len())
+
+	for iter := grants.Iterator(); iter.Next(); {
+		grant := iter.Value()
+
+		if grant.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		recordID := accessGrantRecordID(grant)
+
+		existingGrants[recordID] = struct{}{}
+
+		if err = ctrl.record(ctx, r, logger, grant, recordID); err != nil {
+			return err
+		}
+
+		if accesspolicy.GrantActive(grant, now) {
+			continue
+		}
+
+		if err = ctrl.revoke(ctx, r, logger, recordID, grant.TypedSpec().Value.GetExpiration().AsTime(), AccessGrantRevocationExpired); err != nil {
+			return err
+		}
+
+		if err = r.Destroy(ctx, grant.Metadata(), controller.WithOwner("")); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error destroying expired access grant %q: %w", grant.Metadata().ID(), err)
+		}
+	}
+
+	// the grants which were removed before they expired
+	for iter := records.Iterator(); iter.Next(); {
+		record := iter.Value()
+
+		if _, ok := existingGrants[record.Metadata().ID()]; ok || record.TypedSpec().Value.RevokedAt != nil {
+			continue
+		}
+
+		if err = ctrl.revoke(ctx, r, logger, record.Metadata().ID(), now, AccessGrantRevocationRemoved); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *AccessGrantController) record(ctx context.Context, r controller.Runtime, logger *zap.Logger, grant *auth.AccessGrant, recordID resource.ID) error {
+	_, err := safe.ReaderGetByID[*auth.AccessGrantRecord](ctx, r, recordID)
+	if err == nil {
+		return nil
+	}
+
+	if !state.IsNotFoundError(err) {
+		return err
+	}
+
+	spec := grant.TypedSpec().Value
+
+	logger.Info("access granted",
+		zap.String("grant", grant.Metadata().ID()),
+		zap.String("identity", spec.Identity),
+		zap.String("role", spec.Role),
+		zap.Strings("clusters", spec.Clusters),
+		zap.Time("expiration", spec.GetExpiration().AsTime()),
+		zap.String("reason", spec.Reason),
+	)
+
+	return safe.WriterModify(ctx, r, auth.NewAccessGrantRecord(recordID), func(res *auth.AccessGrantRecord) error {
+		res.TypedSpec().Value.GrantId = grant.Metadata().ID()
+		res.TypedSpec().Value.Identity = spec.Identity
+		res.TypedSpec().Value.Clusters = spec.Clusters
+		res.TypedSpec().Value.Role = spec.Role
+		res.TypedSpec().Value.Reason = spec.Reason
+		res.TypedSpec().Value.GrantedAt = timestamppb.New(grant.Metadata().Created())
+		res.TypedSpec().Value.Expiration = spec.Expiration
+
+		return nil
+	})
+}
+
+func (ctrl *AccessGrantController) revoke(ctx context.Context, r controller.Runtime, logger *zap.Logger, recordID resource.ID, revokedAt time.Time, reason string) error {
+	return safe.WriterModify(ctx, r, auth.NewAccessGrantRecord(recordID), func(res *auth.AccessGrantRecord) error {
+		if res.TypedSpec().Value.RevokedAt != nil {
+			return nil
+		}
+
+		logger.Info("access revoked",
+			zap.String("grant", res.TypedSpec().Value.GrantId),
+			zap.String("identity", res.TypedSpec().Value.Identity),
+			zap.String("role", res.TypedSpec().Value.Role),
+			zap.String("reason", reason),
+		)
+
+		res.TypedSpec().Value.RevokedAt = timestamppb.New(revokedAt)
+		res.TypedSpec().Value.RevocationReason = reason
+
+		return nil
+	})
+}
+
+// accessGrantRecordID returns the ID of the record of the access grant.
+//
+// The grant ID can be reused after the grant is revoked, so the creation time is included in the record ID.
+func accessGrantRecordID(grant *auth.AccessGrant) resource.ID {
+	return fmt.Sprintf("%s-%d", grant.Metadata().ID(), grant.Metadata().Created().Unix())
+}
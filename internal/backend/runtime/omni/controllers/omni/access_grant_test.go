@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+type AccessGrantSuite struct {
+	OmniSuite
+}
+
+func (suite *AccessGrantSuite) TestReconcile() {
+	fakeClock := clock.NewMock()
+	fakeClock.Set(time.Now())
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(
+		omnictrl.NewAccessGrantController(time.Second, omnictrl.WithAccessGrantClock(fakeClock)),
+	))
+
+	expiration := fakeClock.Now().Add(2 * time.Hour)
+
+	breakGlass := authres.NewAccessGrant("break-glass")
+	breakGlass.TypedSpec().Value.Identity = "on-call@example.com"
+	breakGlass.TypedSpec().Value.Role = string(role.Operator)
+	breakGlass.TypedSpec().Value.Clusters = []string{"prod-*"}
+	breakGlass.TypedSpec().Value.Expiration = timestamppb.New(expiration)
+	breakGlass.TypedSpec().Value.Reason = "incident"
+
+	removed := authres.NewAccessGrant("removed")
+	removed.TypedSpec().Value.Identity = "on-call@example.com"
+	removed.TypedSpec().Value.Role = string(role.Reader)
+	removed.TypedSpec().Value.Clusters = []string{"staging"}
+	removed.TypedSpec().Value.Expiration = timestamppb.New(expiration)
+	removed.TypedSpec().Value.Reason = "investigation"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, breakGlass))
+	suite.Require().NoError(suite.state.Create(suite.ctx, removed))
+
+	recordID := func(grant *authres.AccessGrant) resource.ID {
+		res, err := safe.StateGet[*authres.AccessGrant](suite.ctx, suite.state, grant.Metadata())
+		suite.Require().NoError(err)
+
+		return fmt.Sprintf("%s-%d", grant.Metadata().ID(), res.Metadata().Created().Unix())
+	}
+
+	breakGlassRecordID := recordID(breakGlass)
+	removedRecordID := recordID(removed)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{breakGlassRecordID, removedRecordID},
+		func(res *authres.AccessGrantRecord, assertions *assert.Assertions) {
+			assertions.Equal("on-call@example.com", res.TypedSpec().Value.Identity)
+			assertions.NotNil(res.TypedSpec().Value.GrantedAt)
+			assertions.True(expiration.Equal(res.TypedSpec().Value.Expiration.AsTime()))
+			assertions.Nil(res.TypedSpec().Value.RevokedAt)
+		},
+	)
+
+	// removing the grant before its expiry is recorded
+	rtestutils.Destroy[*authres.AccessGrant](suite.ctx, suite.T(), suite.state, []resource.ID{removed.Metadata().ID()})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, removedRecordID, func(res *authres.AccessGrantRecord, assertions *assert.Assertions) {
+		assertions.NotNil(res.TypedSpec().Value.RevokedAt)
+		assertions.Equal(omnictrl.AccessGrantRevocationRemoved, res.TypedSpec().Value.RevocationReason)
+	})
+
+	// the grant is revoked on expiry
+	fakeClock.Add(time.Hour)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, breakGlass.Metadata().ID(), func(*authres.AccessGrant, *assert.Assertions) {})
+
+	fakeClock.Add(time.Hour)
+
+	rtestutils.AssertNoResource[*authres.AccessGrant](suite.ctx, suite.T(), suite.state, breakGlass.Metadata().ID())
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, breakGlassRecordID, func(res *authres.AccessGrantRecord, assertions *assert.Assertions) {
+		assertions.Equal(omnictrl.AccessGrantRevocationExpired, res.TypedSpec().Value.RevocationReason)
+		assertions.True(expiration.Equal(res.TypedSpec().Value.RevokedAt.AsTime()))
+	})
+}
+
+func TestAccessGrantSuite(t *testing.T) {
+	suite.Run(t, new(AccessGrantSuite))
+}
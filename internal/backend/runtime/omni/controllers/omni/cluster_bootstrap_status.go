@@ -19,7 +19,6 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"go.uber.org/zap"
 
-	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
@@ -104,7 +103,7 @@ func NewClusterBootstrapStatusController(etcdBackupStoreFactory store.Factory) *
 						zap.String("snapshot", bootstrapSpec.GetSnapshot()),
 					)
 
-					if err = recoverEtcdFromBackup(ctx, r, talosCli, etcdBackupStoreFactory, bootstrapSpec); err != nil {
+					if err = recoverEtcdFromBackup(ctx, r, talosCli, etcdBackupStoreFactory, bootstrapSpec.GetClusterUuid(), bootstrapSpec.GetSnapshot()); err != nil {
 						return err
 					}
 				}
@@ -155,11 +154,11 @@ func NewClusterBootstrapStatusController(etcdBackupStoreFactory store.Factory) *
 	)
 }
 
-// recoverEtcdFromBackup recovers etcd of the given cluster using the given bootstrap spec.
+// recoverEtcdFromBackup uploads the snapshot of the cluster with the given UUID to the node for the etcd recovery.
 func recoverEtcdFromBackup(ctx context.Context, r controller.Reader, talosCli *client.Client,
-	etcdBackupStoreFactory store.Factory, bootstrapSpec *specs.MachineSetSpec_BootstrapSpec,
+	etcdBackupStoreFactory store.Factory, clusterUUID, snapshot string,
 ) error {
-	clusterUUIDs, err := safe.ReaderListAll[*omni.ClusterUUID](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelClusterUUID, bootstrapSpec.GetClusterUuid())))
+	clusterUUIDs, err := safe.ReaderListAll[*omni.ClusterUUID](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelClusterUUID, clusterUUID)))
 	if err != nil {
 		return fmt.Errorf("failed to list cluster uuids: %w", err)
 	}
@@ -180,7 +179,7 @@ func recoverEtcdFromBackup(ctx context.Context, r controller.Reader, talosCli *c
 		return fmt.Errorf("failed to get backup store: %w", err)
 	}
 
-	downloadedBackupData, readCloser, err := backupStore.Download(ctx, backupData.TypedSpec().Value.GetEncryptionKey(), bootstrapSpec.GetClusterUuid(), bootstrapSpec.GetSnapshot())
+	downloadedBackupData, readCloser, err := backupStore.Download(ctx, backupData.TypedSpec().Value.GetEncryptionKey(), clusterUUID, snapshot)
 	if err != nil {
 		return fmt.Errorf("failed to download backup: %w", err)
 	}
@@ -203,15 +202,6 @@ func recoverEtcdFromBackup(ctx context.Context, r controller.Reader, talosCli *c
 }
 
 func getTalosClientForBootstrap(ctx context.Context, r controller.Reader, clusterName string) (*client.Client, error) {
-	talosConfig, err := safe.ReaderGet[*omni.TalosConfig](ctx, r, omni.NewTalosConfig(resources.DefaultNamespace, clusterName).Metadata())
-	if err != nil {
-		if state.IsNotFoundError(err) {
-			return nil, talos.NewClientNotReadyError(fmt.Errorf("talosconfig not found for cluster %q", clusterName))
-		}
-
-		return nil, fmt.Errorf("failed to get talosconfig for cluster %q: %w", clusterName, err)
-	}
-
 	clusterEndpoint, err := safe.ReaderGetByID[*omni.ClusterEndpoint](ctx, r, clusterName)
 	if err != nil {
 		if state.IsNotFoundError(err) {
@@ -228,7 +218,19 @@ func getTalosClientForBootstrap(ctx context.Context, r controller.Reader, cluste
 
 	// We always pick the first management address to ensure that we always target the same node for recover & bootstrap, even across controller crashes.
 	// This makes us avoid the rare case where due to some failure in GRPC calls, we could bootstrap two separate nodes.
-	managementAddress := addresses[0]
+	return getTalosClientForAddress(ctx, r, clusterName, addresses[0])
+}
+
+// getTalosClientForAddress creates a Talos client for the cluster node with the given management address.
+func getTalosClientForAddress(ctx context.Context, r controller.Reader, clusterName, managementAddress string) (*client.Client, error) {
+	talosConfig, err := safe.ReaderGet[*omni.TalosConfig](ctx, r, omni.NewTalosConfig(resources.DefaultNamespace, clusterName).Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, talos.NewClientNotReadyError(fmt.Errorf("talosconfig not found for cluster %q", clusterName))
+		}
+
+		return nil, fmt.Errorf("failed to get talosconfig for cluster %q: %w", clusterName, err)
+	}
 
 	opts := talos.GetSocketOptions(managementAddress)
 
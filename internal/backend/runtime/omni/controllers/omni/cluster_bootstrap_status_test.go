@@ -53,6 +53,10 @@ func (m *mockEtcdBackupStore) Upload(context.Context, etcdbackup.Description, io
 	return nil
 }
 
+func (m *mockEtcdBackupStore) Remove(context.Context, string, string) error {
+	return nil
+}
+
 func (m *mockEtcdBackupStore) Download(context.Context, []byte, string, string) (etcdbackup.BackupData, io.ReadCloser, error) {
 	return m.etcdBackupDataMock, io.NopCloser(strings.NewReader("test-data")), nil
 }
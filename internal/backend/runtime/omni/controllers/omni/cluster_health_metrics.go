@@ -0,0 +1,273 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// ClusterHealthMetricsController provides per-cluster health metrics based on ClusterStatus, ControlPlaneStatus and the upgrade statuses.
+//
+//nolint:govet
+type ClusterHealthMetricsController struct {
+	metricsMu sync.Mutex
+	metrics   []prometheus.Metric
+
+	metricsOnce                  sync.Once
+	metricPhase                  *prometheus.Desc
+	metricReady                  *prometheus.Desc
+	metricAvailable              *prometheus.Desc
+	metricKubernetesAPIReady     *prometheus.Desc
+	metricControlPlaneReady      *prometheus.Desc
+	metricControlPlaneCondition  *prometheus.Desc
+	metricKubernetesUpgradePhase *prometheus.Desc
+	metricTalosUpgradePhase      *prometheus.Desc
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ClusterHealthMetricsController) Name() string {
+	return "ClusterHealthMetricsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ClusterHealthMetricsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ClusterStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ControlPlaneStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.KubernetesUpgradeStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.TalosUpgradeStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ClusterHealthMetricsController) Outputs() []controller.Output {
+	return nil
+}
+
+func (ctrl *ClusterHealthMetricsController) initMetrics() {
+	ctrl.metricsOnce.Do(func() {
+		ctrl.metricPhase = prometheus.NewDesc(
+			"omni_cluster_phase",
+			"Phase of the cluster, 1 for the current phase and 0 for the others.",
+			[]string{"cluster", "phase"},
+			nil,
+		)
+
+		ctrl.metricReady = prometheus.NewDesc(
+			"omni_cluster_ready",
+			"Whether the cluster is ready.",
+			[]string{"cluster"},
+			nil,
+		)
+
+		ctrl.metricAvailable = prometheus.NewDesc(
+			"omni_cluster_available",
+			"Whether the cluster is available, i.e. at least one control plane node has apid up.",
+			[]string{"cluster"},
+			nil,
+		)
+
+		ctrl.metricKubernetesAPIReady = prometheus.NewDesc(
+			"omni_cluster_kubernetes_api_ready",
+			"Whether the Kubernetes API of the cluster is ready.",
+			[]string{"cluster"},
+			nil,
+		)
+
+		ctrl.metricControlPlaneReady = prometheus.NewDesc(
+			"omni_cluster_control_plane_ready",
+			"Whether the control plane of the cluster is ready.",
+			[]string{"cluster"},
+			nil,
+		)
+
+		ctrl.metricControlPlaneCondition = prometheus.NewDesc(
+			"omni_cluster_control_plane_condition",
+			"Status of the control plane condition, 1 for the current status and 0 for the others.",
+			[]string{"cluster", "machine_set", "condition", "status"},
+			nil,
+		)
+
+		ctrl.metricKubernetesUpgradePhase = prometheus.NewDesc(
+			"omni_cluster_kubernetes_upgrade_phase",
+			"Phase of the Kubernetes upgrade of the cluster, 1 for the current phase and 0 for the others.",
+			[]string{"cluster", "phase"},
+			nil,
+		)
+
+		ctrl.metricTalosUpgradePhase = prometheus.NewDesc(
+			"omni_cluster_talos_upgrade_phase",
+			"Phase of the Talos upgrade of the cluster, 1 for the current phase and 0 for the others.",
+			[]string{"cluster", "phase"},
+			nil,
+		)
+	})
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ClusterHealthMetricsController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ctrl.initMetrics()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		metrics, err := ctrl.gatherMetrics(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		// the metrics are replaced as a whole, so the metrics of the removed clusters are dropped
+		ctrl.metricsMu.Lock()
+		ctrl.metrics = metrics
+		ctrl.metricsMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(10 * time.Second): // don't reconcile too often, as metrics are not scraped that often
+		}
+	}
+}
+
+func (ctrl *ClusterHealthMetricsController) gatherMetrics(ctx context.Context, r controller.Runtime) ([]prometheus.Metric, error) {
+	clusterStatuses, err := safe.ReaderListAll[*omni.ClusterStatus](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlaneStatuses, err := safe.ReaderListAll[*omni.ControlPlaneStatus](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	kubernetesUpgradeStatuses, err := safe.ReaderListAll[*omni.KubernetesUpgradeStatus](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	talosUpgradeStatuses, err := safe.ReaderListAll[*omni.TalosUpgradeStatus](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []prometheus.Metric
+
+	for iter := clusterStatuses.Iterator(); iter.Next(); {
+		cluster := iter.Value().Metadata().ID()
+		spec := iter.Value().TypedSpec().Value
+
+		metrics = appendPhaseMetrics(metrics, ctrl.metricPhase, specs.ClusterStatusSpec_Phase_name, int32(spec.Phase), cluster)
+		metrics = append(metrics,
+			prometheus.MustNewConstMetric(ctrl.metricReady, prometheus.GaugeValue, boolToFloat(spec.Ready), cluster),
+			prometheus.MustNewConstMetric(ctrl.metricAvailable, prometheus.GaugeValue, boolToFloat(spec.Available), cluster),
+			prometheus.MustNewConstMetric(ctrl.metricKubernetesAPIReady, prometheus.GaugeValue, boolToFloat(spec.KubernetesAPIReady), cluster),
+			prometheus.MustNewConstMetric(ctrl.metricControlPlaneReady, prometheus.GaugeValue, boolToFloat(spec.ControlplaneReady), cluster),
+		)
+	}
+
+	for iter := controlPlaneStatuses.Iterator(); iter.Next(); {
+		cluster, ok := iter.Value().Metadata().Labels().Get(omni.LabelCluster)
+		if !ok {
+			continue
+		}
+
+		machineSet := iter.Value().Metadata().ID()
+
+		for _, condition := range iter.Value().TypedSpec().Value.Conditions {
+			metrics = appendPhaseMetrics(metrics, ctrl.metricControlPlaneCondition, specs.ControlPlaneStatusSpec_Condition_Status_name, int32(condition.Status),
+				cluster, machineSet, condition.Type.String())
+		}
+	}
+
+	for iter := kubernetesUpgradeStatuses.Iterator(); iter.Next(); {
+		metrics = appendPhaseMetrics(metrics, ctrl.metricKubernetesUpgradePhase, specs.KubernetesUpgradeStatusSpec_Phase_name,
+			int32(iter.Value().TypedSpec().Value.Phase), iter.Value().Metadata().ID())
+	}
+
+	for iter := talosUpgradeStatuses.Iterator(); iter.Next(); {
+		metrics = appendPhaseMetrics(metrics, ctrl.metricTalosUpgradePhase, specs.TalosUpgradeStatusSpec_Phase_name,
+			int32(iter.Value().TypedSpec().Value.Phase), iter.Value().Metadata().ID())
+	}
+
+	return metrics, nil
+}
+
+// Describe implements prom.Collector interface.
+func (ctrl *ClusterHealthMetricsController) Describe(ch chan<- *prometheus.Desc) {
+	ctrl.initMetrics()
+
+	ch <- ctrl.metricPhase
+	ch <- ctrl.metricReady
+	ch <- ctrl.metricAvailable
+	ch <- ctrl.metricKubernetesAPIReady
+	ch <- ctrl.metricControlPlaneReady
+	ch <- ctrl.metricControlPlaneCondition
+	ch <- ctrl.metricKubernetesUpgradePhase
+	ch <- ctrl.metricTalosUpgradePhase
+}
+
+// Collect implements prom.Collector interface.
+func (ctrl *ClusterHealthMetricsController) Collect(ch chan<- prometheus.Metric) {
+	ctrl.metricsMu.Lock()
+	defer ctrl.metricsMu.Unlock()
+
+	for _, metric := range ctrl.metrics {
+		ch <- metric
+	}
+}
+
+var _ prometheus.Collector = &ClusterHealthMetricsController{}
+
+// appendPhaseMetrics appends a metric for each value of the enum, set to 1 for the current value and 0 for the others.
+//
+// The enum value name is the last label value.
+func appendPhaseMetrics(metrics []prometheus.Metric, desc *prometheus.Desc, names map[int32]string, current int32, labelValues ...string) []prometheus.Metric {
+	for value, name := range names {
+		metrics = append(metrics, prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, boolToFloat(value == current), slices.Concat(labelValues, []string{name})...))
+	}
+
+	return metrics
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
@@ -24,20 +24,46 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 )
 
+// ClusterLoadBalancerControllerOption is a functional option for ClusterLoadBalancerController.
+type ClusterLoadBalancerControllerOption func(*ClusterLoadBalancerController)
+
+// WithLoadBalancerSNI enables the single port mode: the load balancers share the port,
+// and the connections are routed to them by the TLS SNI hostname '<cluster>.<domain>'.
+//
+// Only the clusters created after the mode is enabled are moved to the shared port, the existing clusters keep their own ports,
+// as the endpoint is baked into the machine configs and the certificates of the running clusters.
+// A cluster can be moved to the shared port by recreating it.
+//
+// Disabling the mode works the same way: the clusters which use the shared port keep it, the new clusters get their own ports.
+func WithLoadBalancerSNI(port int, domain string) ClusterLoadBalancerControllerOption {
+	return func(ctrl *ClusterLoadBalancerController) {
+		ctrl.sniPort = port
+		ctrl.sniDomain = domain
+	}
+}
+
 // NewClusterLoadBalancerController creates new ClusterLoadBalancerController.
-func NewClusterLoadBalancerController(minPort, maxPort int) *ClusterLoadBalancerController {
-	return &ClusterLoadBalancerController{
+func NewClusterLoadBalancerController(minPort, maxPort int, opts ...ClusterLoadBalancerControllerOption) *ClusterLoadBalancerController {
+	ctrl := &ClusterLoadBalancerController{
 		minPort: minPort,
 		maxPort: maxPort,
 	}
+
+	for _, opt := range opts {
+		opt(ctrl)
+	}
+
+	return ctrl
 }
 
 // ClusterLoadBalancerController manages ClusterStatus resource lifecycle.
 //
 // ClusterLoadBalancerController applies the generated machine config  on each corresponding machine.
 type ClusterLoadBalancerController struct {
-	minPort int
-	maxPort int
+	sniDomain string
+	minPort   int
+	maxPort   int
+	sniPort   int
 }
 
 // Name implements controller.Controller interface.
@@ -107,6 +133,7 @@ func (ctrl *ClusterLoadBalancerController) Run(ctx context.Context, r controller
 			return err
 		}
 
+		// the port shared in the single port mode is also marked as allocated, so that it's never allocated to a cluster
 		allocatedPorts := map[string]struct{}{}
 		for iter := configs.Iterator(); iter.Next(); {
 			allocatedPorts[iter.Value().TypedSpec().Value.BindPort] = struct{}{}
@@ -131,7 +158,15 @@ func (ctrl *ClusterLoadBalancerController) Run(ctx context.Context, r controller
 
 				spec.Endpoints = endpoints
 
-				if spec.BindPort == "" {
+				switch {
+				case ctrl.sniDomain != "" && (spec.BindPort == "" || spec.SniHostname != ""):
+					// the new clusters and the clusters which are already in the single port mode
+					spec.BindPort = strconv.Itoa(ctrl.sniPort)
+					spec.SniHostname = cluster.Metadata().ID() + "." + ctrl.sniDomain
+				case spec.SniHostname != "":
+					// the single port mode was disabled, the clusters which use the shared port keep it,
+					// as the endpoint is baked into their machine configs and certificates
+				case spec.BindPort == "":
 					spec.BindPort, err = ctrl.getPort(allocatedPorts)
 					if err != nil {
 						return err
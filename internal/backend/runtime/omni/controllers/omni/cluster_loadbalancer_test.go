@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/go-retry/retry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -109,6 +110,114 @@ func (suite *ClusterLoadBalancerSuite) TestReconcile() {
 	suite.destroyCluster(cluster)
 }
 
+func (suite *ClusterLoadBalancerSuite) TestSNI() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterMachineStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterEndpointController()))
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterLoadBalancerController(5000, 6000, omnictrl.WithLoadBalancerSNI(6443, "lb.test"))))
+
+	siderolinkConfig := siderolink.NewConfig(resources.DefaultNamespace)
+	siderolinkConfig.TypedSpec().Value.ServerAddress = "fdae:41e4:649b:9303::1"
+	suite.Require().NoError(suite.state.Create(suite.ctx, siderolinkConfig))
+
+	// all clusters share the same port, and get own hostnames
+	for _, clusterName := range []string{"sni-1", "sni-2"} {
+		cluster, _ := suite.createCluster(clusterName, 1, 1)
+
+		md := *omni.NewLoadBalancerConfig(resources.DefaultNamespace, cluster.Metadata().ID()).Metadata()
+
+		// remove the LB config created by the mock setup to let the controller handle it
+		suite.Require().NoError(suite.state.Destroy(suite.ctx, md))
+
+		assertResource(
+			&suite.OmniSuite,
+			md,
+			func(res *omni.LoadBalancerConfig, assertions *assert.Assertions) {
+				spec := res.TypedSpec().Value
+
+				assertions.Equal("6443", spec.BindPort)
+				assertions.Equal(clusterName+".lb.test", spec.SniHostname)
+				assertions.Equal("https://[fdae:41e4:649b:9303::1]:6443", spec.SiderolinkEndpoint)
+			},
+		)
+	}
+
+	// the cluster which already had its own port allocated before the single port mode was enabled keeps it
+	existing := omni.NewLoadBalancerConfig(resources.DefaultNamespace, "existing")
+	existing.TypedSpec().Value.BindPort = "5005"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, existing, state.WithCreateOwner(omnictrl.NewClusterLoadBalancerController(0, 0).Name())))
+
+	suite.createCluster("existing", 1, 1)
+
+	assertResource(
+		&suite.OmniSuite,
+		*existing.Metadata(),
+		func(res *omni.LoadBalancerConfig, assertions *assert.Assertions) {
+			spec := res.TypedSpec().Value
+
+			assertions.Equal("5005", spec.BindPort)
+			assertions.Empty(spec.SniHostname)
+			assertions.Equal("https://[fdae:41e4:649b:9303::1]:5005", spec.SiderolinkEndpoint)
+		},
+	)
+}
+
+func (suite *ClusterLoadBalancerSuite) TestSNIDisabled() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterMachineStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterEndpointController()))
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterLoadBalancerController(6442, 6444)))
+
+	siderolinkConfig := siderolink.NewConfig(resources.DefaultNamespace)
+	siderolinkConfig.TypedSpec().Value.ServerAddress = "fdae:41e4:649b:9303::1"
+	suite.Require().NoError(suite.state.Create(suite.ctx, siderolinkConfig))
+
+	// the cluster which was moved to the shared port while the single port mode was enabled keeps it
+	existing := omni.NewLoadBalancerConfig(resources.DefaultNamespace, "sni-existing")
+	existing.TypedSpec().Value.BindPort = "6443"
+	existing.TypedSpec().Value.SniHostname = "sni-existing.lb.test"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, existing, state.WithCreateOwner(omnictrl.NewClusterLoadBalancerController(0, 0).Name())))
+
+	suite.createCluster("sni-existing", 1, 1)
+
+	assertResource(
+		&suite.OmniSuite,
+		*existing.Metadata(),
+		func(res *omni.LoadBalancerConfig, assertions *assert.Assertions) {
+			spec := res.TypedSpec().Value
+
+			assertions.Equal("6443", spec.BindPort)
+			assertions.Equal("sni-existing.lb.test", spec.SniHostname)
+			assertions.Equal("https://[fdae:41e4:649b:9303::1]:6443", spec.SiderolinkEndpoint)
+		},
+	)
+
+	// the new clusters get their own ports, the shared port is never allocated to them
+	for _, expectedPort := range []string{"6442", "6444"} {
+		cluster, _ := suite.createCluster("own-port-"+expectedPort, 1, 1)
+
+		md := *omni.NewLoadBalancerConfig(resources.DefaultNamespace, cluster.Metadata().ID()).Metadata()
+
+		// remove the LB config created by the mock setup to let the controller handle it
+		suite.Require().NoError(suite.state.Destroy(suite.ctx, md))
+
+		assertResource(
+			&suite.OmniSuite,
+			md,
+			func(res *omni.LoadBalancerConfig, assertions *assert.Assertions) {
+				spec := res.TypedSpec().Value
+
+				assertions.Equal(expectedPort, spec.BindPort)
+				assertions.Empty(spec.SniHostname)
+			},
+		)
+	}
+}
+
 func TestClusterLoadbalancerSuite(t *testing.T) {
 	suite.Run(t, new(ClusterLoadBalancerSuite))
 }
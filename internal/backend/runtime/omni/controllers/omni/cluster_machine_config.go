@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"strings"
 	"time"
@@ -328,9 +329,16 @@ func (clusterMachineConfigControllerHelper) generateConfig(
 
 	genOptions = append(genOptions, generate.WithSecretsBundle(secretBundle))
 
+	endpoint, endpointGenOptions, err := loadBalancerEndpoint(loadbalancer)
+	if err != nil {
+		return nil, err
+	}
+
+	genOptions = append(genOptions, endpointGenOptions...)
+
 	input, err := generate.NewInput(
 		clusterName,
-		loadbalancer.TypedSpec().Value.SiderolinkEndpoint,
+		endpoint,
 		kubernetesVersion,
 		genOptions...,
 	)
@@ -473,3 +481,35 @@ func buildInstallImage(clusterMachineTalosVersion *omni.ClusterMachineTalosVersi
 
 	return appconfig.Config.TalosRegistry + ":" + talosVersion, nil
 }
+
+// loadBalancerEndpoint returns the control plane endpoint of the cluster and the config generation options it requires.
+//
+// In the single port load balancer mode the endpoint uses the SNI hostname of the cluster,
+// so the machines get a host entry which resolves it to the SideroLink address of the load balancer.
+func loadBalancerEndpoint(loadbalancer *omni.LoadBalancerConfig) (string, []generate.Option, error) {
+	spec := loadbalancer.TypedSpec().Value
+
+	if spec.SniHostname == "" {
+		return spec.SiderolinkEndpoint, nil, nil
+	}
+
+	endpoint, err := url.Parse(spec.SiderolinkEndpoint)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse the load balancer endpoint %q: %w", spec.SiderolinkEndpoint, err)
+	}
+
+	address := endpoint.Hostname()
+	endpoint.Host = net.JoinHostPort(spec.SniHostname, endpoint.Port())
+
+	return endpoint.String(), []generate.Option{
+		generate.WithAdditionalSubjectAltNames([]string{spec.SniHostname}),
+		generate.WithNetworkOptions(func(_ machineapi.Type, cfg *v1alpha1.NetworkConfig) error {
+			cfg.ExtraHostEntries = append(cfg.ExtraHostEntries, &v1alpha1.ExtraHost{
+				HostIP:      address,
+				HostAliases: []string{spec.SniHostname},
+			})
+
+			return nil
+		}),
+	}, nil
+}
@@ -0,0 +1,437 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/configpatch"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/kubernetes"
+)
+
+// InvalidConfigChangesTag tags the errors caused by the config changes which can't be previewed.
+type InvalidConfigChangesTag struct{}
+
+// ConfigChanges are the proposed changes of the cluster which affect the machine configs.
+type ConfigChanges struct {
+	// ConfigPatches are the config patches to be created or updated.
+	ConfigPatches []*omni.ConfigPatch
+	// DeletedConfigPatches are the IDs of the config patches to be deleted.
+	DeletedConfigPatches []resource.ID
+	// MachineSets are the machine sets to be updated.
+	MachineSets []*omni.MachineSet
+	// TalosVersion is the new Talos version of the cluster, empty if it is not changed.
+	TalosVersion string
+	// KubernetesVersion is the new Kubernetes version of the cluster, empty if it is not changed.
+	KubernetesVersion string
+}
+
+// MachineConfigPreview is the machine config of the cluster machine which results from the proposed changes.
+type MachineConfigPreview struct {
+	ID         resource.ID
+	MachineSet resource.ID
+
+	// NodeAddress is the address of the node in the cluster, empty if it is not known yet.
+	NodeAddress string
+
+	// CurrentConfig and ProposedConfig are the redacted machine configs.
+	CurrentConfig  string
+	ProposedConfig string
+
+	// Config is the complete proposed machine config.
+	Config []byte
+
+	// Error is set if the proposed machine config can't be generated.
+	Error string
+
+	// TalosUpgrade is set if the machine is going to be upgraded to the other Talos version.
+	TalosUpgrade bool
+}
+
+// PreviewMachineConfigs generates the machine configs of the cluster machines as if the changes were applied.
+//
+// The changes are applied to the in-memory copy of the cluster resources, so the state is never modified.
+// Only the machines which are affected by the changes are returned.
+//
+//nolint:gocognit,gocyclo,cyclop
+func PreviewMachineConfigs(ctx context.Context, st state.State, clusterName string, changes ConfigChanges, defaultGenOptions []generate.Option) ([]MachineConfigPreview, error) {
+	previewResources, err := collectPreviewResources(ctx, st, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterMachines := previewResources.clusterMachines()
+
+	if err = previewResources.applyChanges(ctx, st, clusterName, clusterMachines, changes); err != nil {
+		return nil, err
+	}
+
+	overlay := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for _, res := range previewResources {
+		if err = overlay.Create(ctx, res, state.WithCreateOwner(res.Metadata().Owner())); err != nil {
+			return nil, err
+		}
+	}
+
+	configPatchHelper, err := configpatch.NewHelper(ctx, overlay)
+	if err != nil {
+		return nil, err
+	}
+
+	previews := make([]MachineConfigPreview, 0, len(clusterMachines))
+
+	for _, clusterMachine := range clusterMachines {
+		machineSetName, _ := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+
+		preview := MachineConfigPreview{
+			ID:         clusterMachine.Metadata().ID(),
+			MachineSet: machineSetName,
+		}
+
+		currentConfig, err := safe.StateGetByID[*omni.RedactedClusterMachineConfig](ctx, st, clusterMachine.Metadata().ID())
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if currentConfig != nil {
+			preview.CurrentConfig = currentConfig.TypedSpec().Value.Data
+		}
+
+		currentTalosVersion, err := safe.StateGetByID[*omni.ClusterMachineTalosVersion](ctx, st, clusterMachine.Metadata().ID())
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if currentTalosVersion != nil && changes.TalosVersion != "" {
+			preview.TalosUpgrade = currentTalosVersion.TypedSpec().Value.TalosVersion != changes.TalosVersion
+		}
+
+		identity, err := safe.StateGetByID[*omni.ClusterMachineIdentity](ctx, overlay, clusterMachine.Metadata().ID())
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if identity != nil && len(identity.TypedSpec().Value.NodeIps) > 0 {
+			preview.NodeAddress = identity.TypedSpec().Value.NodeIps[0]
+		}
+
+		preview.Config, err = generatePreviewConfig(ctx, overlay, configPatchHelper, clusterMachine, defaultGenOptions)
+		if err == nil {
+			preview.ProposedConfig, err = redactMachineConfig(preview.Config)
+		}
+
+		if err != nil {
+			preview.Error = err.Error()
+			preview.Config = nil
+		}
+
+		if preview.Error == "" && preview.CurrentConfig == preview.ProposedConfig && !preview.TalosUpgrade {
+			continue
+		}
+
+		previews = append(previews, preview)
+	}
+
+	return previews, nil
+}
+
+// generatePreviewConfig generates the machine config the same way the ClusterMachineConfigController does,
+// but the config patches of the machine are collected directly instead of being read from ClusterMachineConfigPatches.
+func generatePreviewConfig(ctx context.Context, r state.State, configPatchHelper *configpatch.Helper, clusterMachine *omni.ClusterMachine,
+	defaultGenOptions []generate.Option,
+) ([]byte, error) {
+	clusterName, _ := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+	machineSetName, _ := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, r, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSet, err := safe.StateGetByID[*omni.MachineSet](ctx, r, machineSetName)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := safe.StateGetByID[*omni.ClusterSecrets](ctx, r, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancerConfig, err := safe.StateGetByID[*omni.LoadBalancerConfig](ctx, r, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterConfigVersion, err := safe.StateGetByID[*omni.ClusterConfigVersion](ctx, r, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	machineConfigGenOptions, err := safe.StateGetByID[*omni.MachineConfigGenOptions](ctx, r, clusterMachine.Metadata().ID())
+	if err != nil {
+		return nil, err
+	}
+
+	clusterMachineTalosVersion, err := safe.StateGetByID[*omni.ClusterMachineTalosVersion](ctx, r, clusterMachine.Metadata().ID())
+	if err != nil {
+		return nil, err
+	}
+
+	machineStatus, err := safe.StateGetByID[*omni.MachineStatus](ctx, r, clusterMachine.Metadata().ID())
+	if err != nil {
+		return nil, err
+	}
+
+	patches, err := configPatchHelper.Get(clusterMachine, machineSet)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterMachineConfigPatches := omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, clusterMachine.Metadata().ID())
+
+	for _, patch := range patches {
+		if data := patch.TypedSpec().Value.Data; strings.TrimSpace(data) != "" {
+			clusterMachineConfigPatches.TypedSpec().Value.Patches = append(clusterMachineConfigPatches.TypedSpec().Value.Patches, data)
+		}
+	}
+
+	var helper clusterMachineConfigControllerHelper
+
+	return helper.generateConfig(
+		clusterMachine,
+		clusterMachineConfigPatches,
+		secrets,
+		loadBalancerConfig,
+		cluster,
+		clusterConfigVersion,
+		machineConfigGenOptions,
+		clusterMachineTalosVersion,
+		defaultGenOptions,
+		machineStatus,
+	)
+}
+
+// previewResources is the copy of the cluster resources the machine configs are generated from.
+type previewResources map[string]resource.Resource
+
+func previewResourceKey(md resource.Pointer) string {
+	return md.Type() + "/" + md.ID()
+}
+
+func collectPreviewResources(ctx context.Context, st state.State, clusterName string) (previewResources, error) {
+	previewResources := previewResources{}
+
+	for _, md := range []resource.Pointer{
+		omni.NewCluster(resources.DefaultNamespace, clusterName).Metadata(),
+		omni.NewClusterSecrets(resources.DefaultNamespace, clusterName).Metadata(),
+		omni.NewLoadBalancerConfig(resources.DefaultNamespace, clusterName).Metadata(),
+		omni.NewClusterConfigVersion(resources.DefaultNamespace, clusterName).Metadata(),
+	} {
+		if err := previewResources.get(ctx, st, md); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := previewResources[previewResourceKey(omni.NewCluster(resources.DefaultNamespace, clusterName).Metadata())]; !ok {
+		return nil, xerrors.NewTaggedf[InvalidConfigChangesTag]("cluster %q doesn't exist", clusterName)
+	}
+
+	clusterQuery := state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterName))
+
+	for _, kind := range []resource.Kind{
+		omni.NewMachineSet(resources.DefaultNamespace, "").Metadata(),
+		omni.NewClusterMachine(resources.DefaultNamespace, "").Metadata(),
+		omni.NewClusterMachineIdentity(resources.DefaultNamespace, "").Metadata(),
+		omni.NewConfigPatch(resources.DefaultNamespace, "").Metadata(),
+	} {
+		if err := previewResources.list(ctx, st, kind, clusterQuery); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, kind := range []resource.Kind{
+		omni.NewConfigPatchLibrary(resources.DefaultNamespace, "").Metadata(),
+		omni.NewConfigPatchLibraryVersion(resources.DefaultNamespace, "").Metadata(),
+	} {
+		if err := previewResources.list(ctx, st, kind); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, clusterMachine := range previewResources.clusterMachines() {
+		id := clusterMachine.Metadata().ID()
+
+		for _, md := range []resource.Pointer{
+			omni.NewMachineConfigGenOptions(resources.DefaultNamespace, id).Metadata(),
+			omni.NewClusterMachineTalosVersion(resources.DefaultNamespace, id).Metadata(),
+			omni.NewMachineStatus(resources.DefaultNamespace, id).Metadata(),
+		} {
+			if err := previewResources.get(ctx, st, md); err != nil {
+				return nil, err
+			}
+		}
+
+		// the patches which target the machine directly don't have the cluster label
+		if err := previewResources.list(ctx, st, omni.NewConfigPatch(resources.DefaultNamespace, "").Metadata(),
+			state.WithLabelQuery(resource.LabelEqual(omni.LabelMachine, id)),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return previewResources, nil
+}
+
+func (previewResources previewResources) get(ctx context.Context, st state.State, md resource.Pointer) error {
+	res, err := st.Get(ctx, md)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	previewResources[previewResourceKey(res.Metadata())] = res.DeepCopy()
+
+	return nil
+}
+
+func (previewResources previewResources) list(ctx context.Context, st state.State, kind resource.Kind, opts ...state.ListOption) error {
+	list, err := st.List(ctx, kind, opts...)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range list.Items {
+		previewResources[previewResourceKey(res.Metadata())] = res.DeepCopy()
+	}
+
+	return nil
+}
+
+// clusterMachines returns the running cluster machines sorted by ID.
+func (previewResources previewResources) clusterMachines() []*omni.ClusterMachine {
+	var clusterMachines []*omni.ClusterMachine
+
+	for _, res := range previewResources {
+		if clusterMachine, ok := res.(*omni.ClusterMachine); ok && clusterMachine.Metadata().Phase() == resource.PhaseRunning {
+			clusterMachines = append(clusterMachines, clusterMachine)
+		}
+	}
+
+	slices.SortFunc(clusterMachines, func(a, b *omni.ClusterMachine) int {
+		return strings.Compare(a.Metadata().ID(), b.Metadata().ID())
+	})
+
+	return clusterMachines
+}
+
+//nolint:gocognit,gocyclo,cyclop
+func (previewResources previewResources) applyChanges(ctx context.Context, st state.State, clusterName string, clusterMachines []*omni.ClusterMachine, changes ConfigChanges) error {
+	clusterMachineIDs := make(map[resource.ID]struct{}, len(clusterMachines))
+
+	for _, clusterMachine := range clusterMachines {
+		clusterMachineIDs[clusterMachine.Metadata().ID()] = struct{}{}
+	}
+
+	for _, configPatch := range changes.ConfigPatches {
+		patchCluster, clusterOk := configPatch.Metadata().Labels().Get(omni.LabelCluster)
+		patchMachine, machineOk := configPatch.Metadata().Labels().Get(omni.LabelMachine)
+		_, machineInCluster := clusterMachineIDs[patchMachine]
+
+		if !(clusterOk && patchCluster == clusterName) && !(machineOk && machineInCluster) {
+			return xerrors.NewTaggedf[InvalidConfigChangesTag]("config patch %q doesn't belong to the cluster %q", configPatch.Metadata().ID(), clusterName)
+		}
+
+		previewResources[previewResourceKey(configPatch.Metadata())] = configPatch
+	}
+
+	for _, id := range changes.DeletedConfigPatches {
+		delete(previewResources, previewResourceKey(omni.NewConfigPatch(resources.DefaultNamespace, id).Metadata()))
+	}
+
+	for _, machineSet := range changes.MachineSets {
+		key := previewResourceKey(machineSet.Metadata())
+
+		if _, ok := previewResources[key]; !ok {
+			return xerrors.NewTaggedf[InvalidConfigChangesTag]("machine set %q doesn't exist in the cluster %q", machineSet.Metadata().ID(), clusterName)
+		}
+
+		previewResources[key] = machineSet
+	}
+
+	cluster, ok := previewResources[previewResourceKey(omni.NewCluster(resources.DefaultNamespace, clusterName).Metadata())].(*omni.Cluster)
+	if !ok {
+		return xerrors.NewTaggedf[InvalidConfigChangesTag]("cluster %q doesn't exist", clusterName)
+	}
+
+	if changes.TalosVersion != "" {
+		cluster.TypedSpec().Value.TalosVersion = changes.TalosVersion
+
+		for _, clusterMachine := range clusterMachines {
+			if talosVersion, ok := previewResources[previewResourceKey(
+				omni.NewClusterMachineTalosVersion(resources.DefaultNamespace, clusterMachine.Metadata().ID()).Metadata(),
+			)].(*omni.ClusterMachineTalosVersion); ok {
+				talosVersion.TypedSpec().Value.TalosVersion = changes.TalosVersion
+			}
+		}
+	}
+
+	if changes.KubernetesVersion == "" || changes.KubernetesVersion == cluster.TypedSpec().Value.KubernetesVersion {
+		return nil
+	}
+
+	cluster.TypedSpec().Value.KubernetesVersion = changes.KubernetesVersion
+
+	// Kubernetes is upgraded by the config patches which set the component versions
+	kubernetesStatus, err := safe.StateGetByID[*omni.KubernetesStatus](ctx, st, clusterName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return xerrors.NewTaggedf[InvalidConfigChangesTag]("the Kubernetes status of the cluster %q is not known yet", clusterName)
+		}
+
+		return err
+	}
+
+	nodenameToMachineMap, err := kubernetes.NewMachineMap(ctx, st, cluster)
+	if err != nil {
+		return err
+	}
+
+	upgradePath := kubernetes.CalculateUpgradePath(nodenameToMachineMap, kubernetesStatus, changes.KubernetesVersion)
+
+	for _, step := range upgradePath.Steps {
+		configPatch, ok := previewResources[previewResourceKey(
+			omni.NewConfigPatch(resources.DefaultNamespace, kubernetesUpgradePatchID(step.MachineID)).Metadata(),
+		)].(*omni.ConfigPatch)
+		if !ok {
+			configPatch = omni.NewConfigPatch(resources.DefaultNamespace, kubernetesUpgradePatchID(step.MachineID))
+
+			previewResources[previewResourceKey(configPatch.Metadata())] = configPatch
+		}
+
+		if _, err = applyUpgradePatch(configPatch, cluster, step); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
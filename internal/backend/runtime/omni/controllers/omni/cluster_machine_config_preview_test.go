@@ -0,0 +1,106 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ClusterMachineConfigPreviewSuite struct {
+	OmniSuite
+}
+
+func (suite *ClusterMachineConfigPreviewSuite) TestPreview() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterController()))
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewMachineSetController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterMachineConfigController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewRedactedClusterMachineConfigController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterConfigVersionController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineConfigGenOptionsController()))
+
+	clusterName := "preview"
+
+	_, machines := suite.createCluster(clusterName, 1, 1)
+
+	// the test cluster sets the install disk through the ClusterMachineConfigPatches, the preview reads it from the config patches
+	installDiskPatch := omni.NewConfigPatch(resources.DefaultNamespace, "000-install-disk")
+	installDiskPatch.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	installDiskPatch.TypedSpec().Value.Data = `machine:
+  install:
+    disk: ` + testInstallDisk
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, installDiskPatch))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{machines[0].Metadata().ID(), machines[1].Metadata().ID()},
+		func(*omni.RedactedClusterMachineConfig, *assert.Assertions) {},
+	)
+
+	previews, err := omnictrl.PreviewMachineConfigs(suite.ctx, suite.state, clusterName, omnictrl.ConfigChanges{}, nil)
+	suite.Require().NoError(err)
+	suite.Assert().Empty(previews)
+
+	hostnamePatch := omni.NewConfigPatch(resources.DefaultNamespace, "400-hostname")
+	hostnamePatch.Metadata().Labels().Set(omni.LabelMachine, machines[0].Metadata().ID())
+	hostnamePatch.TypedSpec().Value.Data = `machine:
+  network:
+    hostname: patched-node`
+
+	previews, err = omnictrl.PreviewMachineConfigs(suite.ctx, suite.state, clusterName, omnictrl.ConfigChanges{
+		ConfigPatches: []*omni.ConfigPatch{hostnamePatch},
+	}, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(previews, 1)
+
+	suite.Assert().Equal(machines[0].Metadata().ID(), previews[0].ID)
+	suite.Assert().Equal(omni.ControlPlanesResourceID(clusterName), previews[0].MachineSet)
+	suite.Assert().Empty(previews[0].Error)
+	suite.Assert().False(previews[0].TalosUpgrade)
+	suite.Assert().NotContains(previews[0].CurrentConfig, "patched-node")
+	suite.Assert().Contains(previews[0].ProposedConfig, "patched-node")
+
+	// the state is not modified by the preview
+	rtestutils.AssertNoResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, hostnamePatch.Metadata().ID())
+
+	// the Talos version change affects all machines
+	previews, err = omnictrl.PreviewMachineConfigs(suite.ctx, suite.state, clusterName, omnictrl.ConfigChanges{
+		TalosVersion: "1.7.0",
+	}, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(previews, 2)
+
+	for _, preview := range previews {
+		suite.Assert().True(preview.TalosUpgrade, preview.ID)
+	}
+
+	// the patches of the other clusters can't be previewed
+	otherPatch := omni.NewConfigPatch(resources.DefaultNamespace, "400-other")
+	otherPatch.Metadata().Labels().Set(omni.LabelCluster, "other")
+
+	_, err = omnictrl.PreviewMachineConfigs(suite.ctx, suite.state, clusterName, omnictrl.ConfigChanges{
+		ConfigPatches: []*omni.ConfigPatch{otherPatch},
+	}, nil)
+	suite.Require().Error(err)
+	suite.Assert().True(xerrors.TagIs[omnictrl.InvalidConfigChangesTag](err))
+}
+
+func TestClusterMachineConfigPreviewSuite(t *testing.T) {
+	suite.Run(t, new(ClusterMachineConfigPreviewSuite))
+}
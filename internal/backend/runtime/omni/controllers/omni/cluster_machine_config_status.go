@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/meta"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
@@ -44,6 +45,9 @@ const (
 	etcdLeaveAttemptsLimit    = 2
 )
 
+// errDrainPending is returned when the disruptive operation waits for the Kubernetes node to be drained.
+var errDrainPending = errors.New("waiting for the node to be drained")
+
 // ClusterMachineConfigStatusController manages ClusterMachineStatus resource lifecycle.
 //
 // ClusterMachineConfigStatusController applies the generated machine config  on each corresponding machine.
@@ -131,6 +135,14 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 				// don't run the upgrade check if the running version and expected versions match
 				if versionMismatch && talosVersion.TypedSpec().Value.TalosVersion != "" {
 					inSync, err := handler.syncTalosVersionAndSchematic(ctx, configStatus, machineStatus, machineConfig, statusSnapshot, talosVersion)
+					if errors.Is(err, errDrainPending) {
+						logger.Info("the machine upgrade is waiting for the node to be drained", zap.String("machine", machineConfig.Metadata().ID()))
+
+						configStatus.TypedSpec().Value.DrainRequested = true
+
+						return nil
+					}
+
 					if err != nil {
 						return err
 					}
@@ -144,6 +156,9 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 					}
 				}
 
+				// the Talos version is in sync, so the node is no longer drained for the upgrade
+				configStatus.TypedSpec().Value.DrainRequested = false
+
 				shaSum := sha256.Sum256(machineConfig.TypedSpec().Value.Data)
 				shaSumString := hex.EncodeToString(shaSum[:])
 
@@ -155,6 +170,14 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 
 				// perform config apply
 				if err := handler.applyConfig(ctx, machineStatus, machineConfig, statusSnapshot); err != nil {
+					if errors.Is(err, errDrainPending) {
+						logger.Info("the config apply with reboot is waiting for the node to be drained", zap.String("machine", machineConfig.Metadata().ID()))
+
+						configStatus.TypedSpec().Value.DrainRequested = true
+
+						return nil
+					}
+
 					grpcSt := client.Status(err)
 					if grpcSt != nil && grpcSt.Code() == codes.InvalidArgument {
 						configStatus.TypedSpec().Value.LastConfigError = grpcSt.Message()
@@ -221,6 +244,12 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperNone[*omni.MachineSet](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperNone[*omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.ClusterMachineDrainStatus, *omni.ClusterMachineConfig](),
+		),
 	)
 }
 
@@ -359,6 +388,32 @@ func (h *clusterMachineConfigStatusControllerHandler) syncTalosVersionAndSchemat
 		return false, err
 	}
 
+	// the machines in maintenance mode are not serving any workloads, so they are upgraded right away
+	if !maintenance {
+		open, next, err := h.maintenanceWindowOpen(ctx, machineConfig)
+		if err != nil {
+			return false, err
+		}
+
+		if !open {
+			h.logger.Info("the machine upgrade is postponed until the maintenance window opens",
+				zap.String("machine", machineConfig.Metadata().ID()),
+				zap.Time("window_opens", next),
+			)
+
+			return false, maintenanceWindowRequeue(next)
+		}
+
+		drained, err := h.nodeDrained(ctx, machineConfig, (*specs.MachineSetSpec).GetUpdateStrategyConfig)
+		if err != nil {
+			return false, err
+		}
+
+		if !drained {
+			return false, errDrainPending
+		}
+	}
+
 	h.logger.Info("upgrading the machine",
 		zap.String("from_version", actualVersion),
 		zap.String("to_version", expectedVersion),
@@ -426,6 +481,12 @@ func (h *clusterMachineConfigStatusControllerHandler) applyConfig(inputCtx conte
 	ctx, applyCancel := context.WithTimeout(inputCtx, time.Minute)
 	defer applyCancel()
 
+	if !applyMaintenance {
+		if err = h.checkApplyReboot(ctx, c, machineConfig); err != nil {
+			return err
+		}
+	}
+
 	resp, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
 		Data: machineConfig.TypedSpec().Value.Data,
 		Mode: machineapi.ApplyConfigurationRequest_AUTO,
@@ -458,6 +519,134 @@ func (h *clusterMachineConfigStatusControllerHandler) applyConfig(inputCtx conte
 	return nil
 }
 
+// checkApplyReboot postpones the config apply which requires a reboot until the maintenance window opens and the node is drained.
+func (h *clusterMachineConfigStatusControllerHandler) checkApplyReboot(ctx context.Context, c *client.Client, machineConfig *omni.ClusterMachineConfig) error {
+	open, next, err := h.maintenanceWindowOpen(ctx, machineConfig)
+	if err != nil {
+		return err
+	}
+
+	drained, err := h.nodeDrained(ctx, machineConfig, (*specs.MachineSetSpec).GetUpdateStrategyConfig)
+	if err != nil {
+		return err
+	}
+
+	if open && drained {
+		return nil
+	}
+
+	resp, err := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
+		Data:   machineConfig.TypedSpec().Value.Data,
+		Mode:   machineapi.ApplyConfigurationRequest_AUTO,
+		DryRun: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check the config apply mode for machine '%s': %w", machineConfig.Metadata().ID(), err)
+	}
+
+	if len(resp.Messages) != 1 {
+		return fmt.Errorf("unexpected number of responses: %d", len(resp.Messages))
+	}
+
+	if resp.Messages[0].GetMode() == machineapi.ApplyConfigurationRequest_NO_REBOOT {
+		return nil
+	}
+
+	if !open {
+		h.logger.Info("the config apply requires a reboot, it is postponed until the maintenance window opens",
+			zap.String("machine", machineConfig.Metadata().ID()),
+			zap.Time("window_opens", next),
+		)
+
+		return maintenanceWindowRequeue(next)
+	}
+
+	return errDrainPending
+}
+
+// nodeDrained checks if the Kubernetes node of the machine is drained, when the drain is enabled in the machine set strategy.
+func (h *clusterMachineConfigStatusControllerHandler) nodeDrained(ctx context.Context, machineConfig *omni.ClusterMachineConfig,
+	strategy func(*specs.MachineSetSpec) *specs.MachineSetSpec_UpdateStrategyConfig,
+) (bool, error) {
+	machineSetName, ok := machineConfig.Metadata().Labels().Get(omni.LabelMachineSet)
+	if !ok {
+		return true, nil
+	}
+
+	machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, h.r, machineSetName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	if strategy(machineSet.TypedSpec().Value).GetDrain() == nil {
+		return true, nil
+	}
+
+	drainStatus, err := safe.ReaderGetByID[*omni.ClusterMachineDrainStatus](ctx, h.r, machineConfig.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return drainStatus.TypedSpec().Value.Phase == specs.ClusterMachineDrainStatusSpec_DRAINED, nil
+}
+
+// maintenanceWindowOpen checks if the disruptive operations are allowed for the machine.
+//
+// If the window is closed, the time when it opens next is returned.
+func (h *clusterMachineConfigStatusControllerHandler) maintenanceWindowOpen(ctx context.Context, machineConfig *omni.ClusterMachineConfig) (bool, time.Time, error) {
+	var (
+		cluster    *omni.Cluster
+		machineSet *omni.MachineSet
+		err        error
+	)
+
+	if clusterName, ok := machineConfig.Metadata().Labels().Get(omni.LabelCluster); ok {
+		cluster, err = safe.ReaderGetByID[*omni.Cluster](ctx, h.r, clusterName)
+		if err != nil && !state.IsNotFoundError(err) {
+			return false, time.Time{}, err
+		}
+	}
+
+	if machineSetName, ok := machineConfig.Metadata().Labels().Get(omni.LabelMachineSet); ok {
+		machineSet, err = safe.ReaderGetByID[*omni.MachineSet](ctx, h.r, machineSetName)
+		if err != nil && !state.IsNotFoundError(err) {
+			return false, time.Time{}, err
+		}
+	}
+
+	schedule, err := omni.ParseMaintenanceWindow(omni.GetMaintenanceWindow(cluster, machineSet))
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	if schedule == nil {
+		return true, time.Time{}, nil
+	}
+
+	open, next := schedule.Open(time.Now())
+	if open {
+		return true, time.Time{}, nil
+	}
+
+	return false, next, nil
+}
+
+func maintenanceWindowRequeue(next time.Time) error {
+	if next.IsZero() {
+		return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("the maintenance window never opens")
+	}
+
+	return controller.NewRequeueInterval(time.Until(next))
+}
+
 func logClose(c io.Closer, logger *zap.Logger, additional string) {
 	if err := c.Close(); err != nil {
 		logger.Error(additional+": failed to close client", zap.Error(err))
@@ -584,6 +773,20 @@ func (h *clusterMachineConfigStatusControllerHandler) reset(
 		return xerrors.NewTagged[qtransform.SkipReconcileTag](fmt.Errorf("machine '%s' is in %s stage", machineConfig.Metadata().ID(), machineStage))
 	}
 
+	// the node is drained only when the machine leaves the running machine set
+	if machineSet.Metadata().Phase() == resource.PhaseRunning {
+		drained, drainErr := h.nodeDrained(ctx, machineConfig, (*specs.MachineSetSpec).GetDeleteStrategyConfig)
+		if drainErr != nil {
+			return drainErr
+		}
+
+		if !drained {
+			logger.Info("the reset is waiting for the node to be drained")
+
+			return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("machine '%s': %w", machineConfig.Metadata().ID(), errDrainPending)
+		}
+	}
+
 	c, err = h.getClient(ctx, false, machineStatus, machineConfig)
 	if err != nil {
 		return fmt.Errorf("failed to get client for machine '%s': %w", machineConfig.Metadata().ID(), err)
@@ -133,6 +133,54 @@ func (suite *ClusterMachineConfigSuite) TestReconcile() {
 	}
 }
 
+func (suite *ClusterMachineConfigSuite) TestSNILoadBalancer() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterController()))
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewMachineSetController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterMachineConfigController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterConfigVersionController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineConfigGenOptionsController()))
+
+	clusterName := "sni"
+	sniHostname := clusterName + ".lb.test"
+
+	_, machines := suite.createCluster(clusterName, 1, 1)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewLoadBalancerConfig(resources.DefaultNamespace, clusterName).Metadata(),
+		func(config *omni.LoadBalancerConfig) error {
+			config.TypedSpec().Value.SiderolinkEndpoint = "https://[fdae:41e4:649b:9303::1]:6443"
+			config.TypedSpec().Value.SniHostname = sniHostname
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	for _, m := range machines {
+		assertResource(
+			&suite.OmniSuite,
+			*omni.NewClusterMachineConfig(resources.DefaultNamespace, m.Metadata().ID()).Metadata(),
+			func(cfg *omni.ClusterMachineConfig, assertions *assert.Assertions) {
+				machineconfig, loadErr := configloader.NewFromBytes(cfg.TypedSpec().Value.Data)
+				suite.Require().NoError(loadErr)
+
+				// the machines reach the load balancer by the SNI hostname, which resolves to its SideroLink address
+				assertions.Equal("https://"+sniHostname+":6443", machineconfig.Cluster().Endpoint().String())
+
+				extraHosts := machineconfig.Machine().Network().ExtraHosts()
+				if assertions.Len(extraHosts, 1) {
+					assertions.Equal("fdae:41e4:649b:9303::1", extraHosts[0].IP())
+					assertions.Equal([]string{sniHostname}, extraHosts[0].Aliases())
+				}
+			},
+		)
+	}
+}
+
 func (suite *ClusterMachineConfigSuite) TestGenerationError() {
 	suite.startRuntime()
 
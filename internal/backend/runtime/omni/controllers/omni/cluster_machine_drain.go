@@ -0,0 +1,536 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// ClusterMachineDrainControllerName is the name of the ClusterMachineDrainController.
+const ClusterMachineDrainControllerName = "ClusterMachineDrainController"
+
+const (
+	drainReasonTeardown = "teardown"
+	drainReasonUpdate   = "update"
+
+	defaultDrainRequeueInterval = 5 * time.Second
+)
+
+// KubernetesDrainClient is an interface that defines the methods to cordon and drain the Kubernetes nodes.
+type KubernetesDrainClient interface {
+	// CordonNode marks the node unschedulable, returns false if the node was already unschedulable.
+	CordonNode(ctx context.Context, node string) (bool, error)
+	UncordonNode(ctx context.Context, node string) error
+	ListNodePods(ctx context.Context, node string) ([]corev1.Pod, error)
+	EvictPod(ctx context.Context, pod *corev1.Pod) error
+	DeletePod(ctx context.Context, pod *corev1.Pod) error
+}
+
+// GetKubernetesDrainClientFunc is a function that returns a KubernetesDrainClient for the given cluster.
+type GetKubernetesDrainClientFunc func(ctx context.Context, cluster string) (KubernetesDrainClient, error)
+
+// ClusterMachineDrainController cordons and drains the Kubernetes node of the cluster machine before it is upgraded, rebooted or torn down.
+//
+// The drain is requested by the ClusterMachineConfigStatusController for the upgrades and the config applies with reboot,
+// and by the cluster machine teardown, the controller waits for that operation to finish and uncordons the node back.
+//
+// When the drain timeout expires, the remaining pods are deleted if the drain is forced, otherwise the operation proceeds with them still running.
+type ClusterMachineDrainController struct {
+	getKubernetesClient GetKubernetesDrainClientFunc
+	generic.NamedController
+
+	requeueInterval time.Duration
+}
+
+// ClusterMachineDrainControllerOption is a functional option for ClusterMachineDrainController.
+type ClusterMachineDrainControllerOption func(*ClusterMachineDrainController)
+
+// WithDrainRequeueInterval sets how often the controller checks the pods which are still running on the node being drained.
+func WithDrainRequeueInterval(interval time.Duration) ClusterMachineDrainControllerOption {
+	return func(ctrl *ClusterMachineDrainController) {
+		ctrl.requeueInterval = interval
+	}
+}
+
+// NewClusterMachineDrainController initializes ClusterMachineDrainController.
+func NewClusterMachineDrainController(getKubernetesClientFunc GetKubernetesDrainClientFunc, opts ...ClusterMachineDrainControllerOption) *ClusterMachineDrainController {
+	if getKubernetesClientFunc == nil {
+		getKubernetesClientFunc = func(ctx context.Context, cluster string) (KubernetesDrainClient, error) {
+			return newKubernetesClient(ctx, cluster)
+		}
+	}
+
+	ctrl := &ClusterMachineDrainController{
+		getKubernetesClient: getKubernetesClientFunc,
+		requeueInterval:     defaultDrainRequeueInterval,
+
+		NamedController: generic.NamedController{
+			ControllerName: ClusterMachineDrainControllerName,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(ctrl)
+	}
+
+	return ctrl
+}
+
+// Settings implements controller.QController interface.
+func (ctrl *ClusterMachineDrainController) Settings() controller.QSettings {
+	return controller.QSettings{
+		Inputs: []controller.Input{
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ClusterMachineType,
+				Kind:      controller.InputQPrimary,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ClusterMachineConfigStatusType,
+				Kind:      controller.InputQMapped,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ClusterMachineIdentityType,
+				Kind:      controller.InputQMapped,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.MachineSetType,
+				Kind:      controller.InputQMapped,
+			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ClusterType,
+				Kind:      controller.InputQMapped,
+			},
+		},
+		Outputs: []controller.Output{
+			{
+				Kind: controller.OutputExclusive,
+				Type: omni.ClusterMachineDrainStatusType,
+			},
+		},
+		Concurrency: optional.Some[uint](4),
+	}
+}
+
+// MapInput implements controller.QController interface.
+func (ctrl *ClusterMachineDrainController) MapInput(ctx context.Context, logger *zap.Logger, r controller.QRuntime, ptr resource.Pointer) ([]resource.Pointer, error) {
+	switch ptr.Type() {
+	case omni.ClusterMachineConfigStatusType, omni.ClusterMachineIdentityType:
+		return []resource.Pointer{omni.NewClusterMachine(resources.DefaultNamespace, ptr.ID()).Metadata()}, nil
+	case omni.ClusterType:
+		mapper := mappers.MapByClusterLabel[*omni.Cluster, *omni.ClusterMachine]()
+
+		return mapper(ctx, logger, r, omni.NewCluster(ptr.Namespace(), ptr.ID()))
+	case omni.MachineSetType:
+		machines, err := safe.ReaderListAll[*omni.ClusterMachine](ctx, r, state.WithLabelQuery(
+			resource.LabelEqual(omni.LabelMachineSet, ptr.ID()),
+		))
+		if err != nil {
+			return nil, err
+		}
+
+		return safe.Map(machines, func(r *omni.ClusterMachine) (resource.Pointer, error) {
+			return r.Metadata(), nil
+		})
+	}
+
+	return nil, fmt.Errorf("unexpected resource type %q", ptr.Type())
+}
+
+// Reconcile implements controller.QController interface.
+func (ctrl *ClusterMachineDrainController) Reconcile(ctx context.Context, logger *zap.Logger, r controller.QRuntime, ptr resource.Pointer) error {
+	drainStatus, err := safe.ReaderGetByID[*omni.ClusterMachineDrainStatus](ctx, r, ptr.ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	clusterMachine, err := safe.ReaderGetByID[*omni.ClusterMachine](ctx, r, ptr.ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			// the machine has left the cluster, there is no node to uncordon
+			return ctrl.finish(ctx, r, logger, drainStatus, false)
+		}
+
+		return err
+	}
+
+	logger = logger.With(zap.String("machine", clusterMachine.Metadata().ID()))
+
+	reason, drainConfig, err := ctrl.drainRequest(ctx, r, clusterMachine)
+	if err != nil {
+		return err
+	}
+
+	if drainConfig == nil {
+		return ctrl.finish(ctx, r, logger, drainStatus, true)
+	}
+
+	if drainStatus != nil && drainStatus.TypedSpec().Value.Phase == specs.ClusterMachineDrainStatusSpec_DRAINED {
+		return nil
+	}
+
+	return ctrl.drain(ctx, r, logger, clusterMachine, drainStatus, reason, drainConfig)
+}
+
+// drainRequest returns the drain settings if the node of the cluster machine should be drained now.
+func (ctrl *ClusterMachineDrainController) drainRequest(ctx context.Context, r controller.Reader, clusterMachine *omni.ClusterMachine) (string, *specs.MachineSetSpec_DrainConfig, error) {
+	clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+	if !ok {
+		return "", nil, nil
+	}
+
+	cluster, err := safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterName)
+	if err != nil && !state.IsNotFoundError(err) {
+		return "", nil, err
+	}
+
+	// the workloads are not preserved when the whole cluster is destroyed
+	if cluster == nil || cluster.Metadata().Phase() == resource.PhaseTearingDown {
+		return "", nil, nil
+	}
+
+	machineSetName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+	if !ok {
+		return "", nil, nil
+	}
+
+	machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetName)
+	if err != nil && !state.IsNotFoundError(err) {
+		return "", nil, err
+	}
+
+	if machineSet == nil {
+		return "", nil, nil
+	}
+
+	if clusterMachine.Metadata().Phase() == resource.PhaseTearingDown {
+		// the machines are reset without the graceful shutdown when the whole machine set is destroyed, so they are not drained either
+		if machineSet.Metadata().Phase() == resource.PhaseTearingDown {
+			return "", nil, nil
+		}
+
+		return drainReasonTeardown, machineSet.TypedSpec().Value.GetDeleteStrategyConfig().GetDrain(), nil
+	}
+
+	configStatus, err := safe.ReaderGetByID[*omni.ClusterMachineConfigStatus](ctx, r, clusterMachine.Metadata().ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return "", nil, err
+	}
+
+	if configStatus == nil || !configStatus.TypedSpec().Value.DrainRequested {
+		return "", nil, nil
+	}
+
+	return drainReasonUpdate, machineSet.TypedSpec().Value.GetUpdateStrategyConfig().GetDrain(), nil
+}
+
+// nodeDrainResult is the outcome of a single drain attempt.
+type nodeDrainResult struct {
+	err         error
+	remaining   int
+	podsBlocked int
+	cordoned    bool
+	drained     bool
+	forced      bool
+	timedOut    bool
+}
+
+func (ctrl *ClusterMachineDrainController) drain(ctx context.Context, r controller.QRuntime, logger *zap.Logger,
+	clusterMachine *omni.ClusterMachine, drainStatus *omni.ClusterMachineDrainStatus, reason string, drainConfig *specs.MachineSetSpec_DrainConfig,
+) error {
+	startedAt := time.Now()
+	result := nodeDrainResult{}
+
+	if drainStatus != nil {
+		startedAt = drainStatus.Metadata().Created()
+		result.cordoned = drainStatus.TypedSpec().Value.Cordoned
+	}
+
+	timeout := drainConfig.GetTimeout().AsDuration()
+	timedOut := timeout > 0 && time.Since(startedAt) > timeout
+	force := drainConfig.GetForce() && timedOut
+
+	identity, err := safe.ReaderGetByID[*omni.ClusterMachineIdentity](ctx, r, clusterMachine.Metadata().ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	var nodename string
+
+	if identity != nil {
+		nodename = identity.TypedSpec().Value.Nodename
+	}
+
+	clusterName, _ := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+
+	switch {
+	// the machine has never joined the Kubernetes cluster
+	case nodename == "":
+		result.drained = true
+	default:
+		ctrl.drainNode(ctx, logger, clusterName, nodename, force, &result)
+	}
+
+	// the pods blocked by the pod disruption budgets or stuck in the termination don't hold the operation after the timeout
+	if !result.drained && timedOut {
+		logger.Warn("the drain timeout has expired, proceeding with the pods still running on the node",
+			zap.String("node", nodename), zap.Int("pods_remaining", result.remaining), zap.Error(result.err))
+
+		result.drained = true
+		result.timedOut = true
+	}
+
+	if err = safe.WriterModify(ctx, r, omni.NewClusterMachineDrainStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID()),
+		func(res *omni.ClusterMachineDrainStatus) error {
+			helpers.CopyLabels(clusterMachine, res, omni.LabelCluster, omni.LabelMachineSet)
+
+			spec := res.TypedSpec().Value
+
+			spec.Reason = reason
+			spec.Nodename = nodename
+			spec.Cordoned = result.cordoned
+			spec.PodsRemaining = uint32(result.remaining)
+			spec.PodsBlocked = uint32(result.podsBlocked)
+			spec.Forced = spec.Forced || result.forced
+			spec.TimedOut = result.timedOut
+			spec.Error = ""
+			spec.Phase = specs.ClusterMachineDrainStatusSpec_DRAINING
+
+			if result.err != nil {
+				spec.Error = result.err.Error()
+			}
+
+			if result.drained {
+				spec.Phase = specs.ClusterMachineDrainStatusSpec_DRAINED
+			}
+
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	if result.drained {
+		logger.Info("drained the node", zap.String("node", nodename), zap.String("reason", reason), zap.Bool("forced", result.forced), zap.Bool("timed_out", result.timedOut))
+
+		return nil
+	}
+
+	return controller.NewRequeueInterval(ctrl.requeueInterval)
+}
+
+// drainNode cordons the node and evicts the pods running on it, the pods are deleted instead if force is set.
+func (ctrl *ClusterMachineDrainController) drainNode(ctx context.Context, logger *zap.Logger, cluster, nodename string, force bool, result *nodeDrainResult) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	client, err := ctrl.getKubernetesClient(ctx, cluster)
+	if err != nil {
+		result.err = fmt.Errorf("error getting kubernetes client: %w", err)
+
+		return
+	}
+
+	if !result.cordoned {
+		result.cordoned, err = client.CordonNode(ctx, nodename)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				result.drained = true
+
+				return
+			}
+
+			result.err = fmt.Errorf("error cordoning node %q: %w", nodename, err)
+
+			return
+		}
+
+		if result.cordoned {
+			logger.Info("cordoned the node", zap.String("node", nodename))
+		}
+	}
+
+	pods, err := client.ListNodePods(ctx, nodename)
+	if err != nil {
+		result.err = fmt.Errorf("error listing pods on node %q: %w", nodename, err)
+
+		return
+	}
+
+	for i := range pods {
+		pod := &pods[i]
+
+		if !podDrainable(pod) {
+			continue
+		}
+
+		result.remaining++
+
+		// the pod is already being terminated
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if force {
+			if err = client.DeletePod(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+				result.err = fmt.Errorf("error deleting pod %s/%s: %w", pod.Namespace, pod.Name, err)
+
+				return
+			}
+
+			result.forced = true
+
+			continue
+		}
+
+		err = client.EvictPod(ctx, pod)
+
+		switch {
+		case err == nil, apierrors.IsNotFound(err):
+		case apierrors.IsTooManyRequests(err):
+			// the eviction would violate the pod disruption budget, try again later
+			result.podsBlocked++
+		default:
+			result.err = fmt.Errorf("error evicting pod %s/%s: %w", pod.Namespace, pod.Name, err)
+
+			return
+		}
+	}
+
+	result.drained = result.remaining == 0
+}
+
+// finish uncordons the node after the operation it was drained for is complete and removes the drain status.
+func (ctrl *ClusterMachineDrainController) finish(ctx context.Context, r controller.QRuntime, logger *zap.Logger, drainStatus *omni.ClusterMachineDrainStatus, uncordon bool) error {
+	if drainStatus == nil {
+		return nil
+	}
+
+	spec := drainStatus.TypedSpec().Value
+
+	if uncordon && spec.Cordoned && spec.Nodename != "" {
+		clusterName, _ := drainStatus.Metadata().Labels().Get(omni.LabelCluster)
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		client, err := ctrl.getKubernetesClient(ctx, clusterName)
+		if err != nil {
+			return fmt.Errorf("error getting kubernetes client: %w", err)
+		}
+
+		if err = client.UncordonNode(ctx, spec.Nodename); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error uncordoning node %q: %w", spec.Nodename, err)
+		}
+
+		logger.Info("uncordoned the node", zap.String("node", spec.Nodename))
+	}
+
+	if err := r.Destroy(ctx, drainStatus.Metadata()); err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// podDrainable returns true if the pod should be evicted from the node, the same way kubectl drain does.
+func podDrainable(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	// static pods can't be evicted
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+
+	for _, owner := range pod.OwnerReferences {
+		if owner.Controller != nil && *owner.Controller && owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (cli *kubernetesClient) CordonNode(ctx context.Context, node string) (bool, error) {
+	res, err := cli.client.Clientset().CoreV1().Nodes().Get(ctx, node, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	if res.Spec.Unschedulable {
+		return false, nil
+	}
+
+	if err = cli.setUnschedulable(ctx, node, true); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (cli *kubernetesClient) UncordonNode(ctx context.Context, node string) error {
+	return cli.setUnschedulable(ctx, node, false)
+}
+
+func (cli *kubernetesClient) setUnschedulable(ctx context.Context, node string, unschedulable bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+
+	_, err := cli.client.Clientset().CoreV1().Nodes().Patch(ctx, node, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+
+	return err
+}
+
+func (cli *kubernetesClient) ListNodePods(ctx context.Context, node string) ([]corev1.Pod, error) {
+	pods, err := cli.client.Clientset().CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pods.Items, nil
+}
+
+func (cli *kubernetesClient) EvictPod(ctx context.Context, pod *corev1.Pod) error {
+	return cli.client.Clientset().CoreV1().Pods(pod.Namespace).EvictV1(ctx, &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	})
+}
+
+func (cli *kubernetesClient) DeletePod(ctx context.Context, pod *corev1.Pod) error {
+	return cli.client.Clientset().CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+}
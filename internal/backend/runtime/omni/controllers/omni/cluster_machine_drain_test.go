@@ -0,0 +1,422 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ClusterMachineDrainSuite struct {
+	OmniSuite
+}
+
+func (suite *ClusterMachineDrainSuite) TestReconcile() {
+	suite.startRuntime()
+
+	kubernetesClient := &kubernetesDrainClientMock{
+		pods: []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "cni",
+					Namespace:       "kube-system",
+					OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Controller: pointer.To(true)}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "kube-apiserver",
+					Namespace:   "kube-system",
+					Annotations: map[string]string{corev1.MirrorPodAnnotationKey: "mirror"},
+				},
+			},
+		},
+	}
+
+	suite.registerController(kubernetesClient)
+
+	clusterMachine := suite.createClusterMachine(func(spec *specs.MachineSetSpec) {
+		spec.UpdateStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
+			Drain: &specs.MachineSetSpec_DrainConfig{
+				Timeout: durationpb.New(time.Minute),
+			},
+		}
+	})
+
+	configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID())
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+
+	// no drain requested
+	assertNoResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, omni.NewClusterMachineDrainStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID()))
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, configStatus.Metadata(), func(res *omni.ClusterMachineConfigStatus) error {
+		res.TypedSpec().Value.DrainRequested = true
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	assertResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, clusterMachine.Metadata(), func(res *omni.ClusterMachineDrainStatus, assertion *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		assertion.Equal(specs.ClusterMachineDrainStatusSpec_DRAINED, spec.Phase)
+		assertion.Equal("update", spec.Reason)
+		assertion.Equal("node1", spec.Nodename)
+		assertion.True(spec.Cordoned)
+		assertion.False(spec.Forced)
+	})
+
+	cordoned, evicted := kubernetesClient.get()
+
+	suite.Assert().Equal([]string{"node1"}, cordoned)
+	suite.Assert().Equal([]string{"default/app"}, evicted)
+
+	// the operation is complete, the node should be uncordoned
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, configStatus.Metadata(), func(res *omni.ClusterMachineConfigStatus) error {
+		res.TypedSpec().Value.DrainRequested = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	assertNoResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, omni.NewClusterMachineDrainStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID()))
+
+	cordoned, _ = kubernetesClient.get()
+
+	suite.Assert().Empty(cordoned)
+}
+
+func (suite *ClusterMachineDrainSuite) TestBlockedByDisruptionBudget() {
+	suite.startRuntime()
+
+	kubernetesClient := &kubernetesDrainClientMock{
+		pods:    []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}},
+		blocked: map[string]struct{}{"default/app": {}},
+	}
+
+	suite.registerController(kubernetesClient)
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterMachineStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.OngoingTaskController{}))
+
+	clusterMachine := suite.createClusterMachine(func(spec *specs.MachineSetSpec) {
+		spec.UpdateStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
+			Drain: &specs.MachineSetSpec_DrainConfig{},
+		}
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID())))
+	suite.Require().NoError(suite.state.Create(suite.ctx, omni.NewMachineStatusSnapshot(resources.DefaultNamespace, clusterMachine.Metadata().ID())))
+
+	configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID())
+	configStatus.TypedSpec().Value.DrainRequested = true
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+
+	// the eviction is retried while the pod disruption budget doesn't allow it
+	assertResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, clusterMachine.Metadata(), func(res *omni.ClusterMachineDrainStatus, assertion *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		assertion.Equal(specs.ClusterMachineDrainStatusSpec_DRAINING, spec.Phase)
+		assertion.EqualValues(1, spec.PodsRemaining)
+		assertion.EqualValues(1, spec.PodsBlocked)
+		assertion.Empty(spec.Error)
+	})
+
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		if attempts := kubernetesClient.getEvictionAttempts(); attempts < 2 {
+			return retry.ExpectedErrorf("expected the eviction to be retried, attempts: %d", attempts)
+		}
+
+		return nil
+	}))
+
+	assertResource[*omni.ClusterMachineStatus](&suite.OmniSuite, clusterMachine.Metadata(), func(res *omni.ClusterMachineStatus, assertion *assert.Assertions) {
+		if assertion.NotNil(res.TypedSpec().Value.Drain) {
+			assertion.Equal("node1", res.TypedSpec().Value.Drain.Nodename)
+			assertion.EqualValues(1, res.TypedSpec().Value.Drain.PodsBlocked)
+		}
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{clusterMachine.Metadata().ID() + "-drain"},
+		func(res *omni.OngoingTask, assertion *assert.Assertions) {
+			assertion.Equal("Draining Node node1", res.TypedSpec().Value.Title)
+			assertion.EqualValues(1, res.TypedSpec().Value.GetDrain().GetPodsBlocked())
+		},
+		rtestutils.WithNamespace(resources.EphemeralNamespace),
+	)
+
+	kubernetesClient.unblock()
+
+	assertResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, clusterMachine.Metadata(), func(res *omni.ClusterMachineDrainStatus, assertion *assert.Assertions) {
+		assertion.Equal(specs.ClusterMachineDrainStatusSpec_DRAINED, res.TypedSpec().Value.Phase)
+		assertion.False(res.TypedSpec().Value.TimedOut)
+	})
+
+	_, evicted := kubernetesClient.get()
+
+	suite.Assert().Equal([]string{"default/app"}, evicted)
+
+	assertResource[*omni.ClusterMachineStatus](&suite.OmniSuite, clusterMachine.Metadata(), func(res *omni.ClusterMachineStatus, assertion *assert.Assertions) {
+		assertion.Nil(res.TypedSpec().Value.Drain)
+	})
+
+	rtestutils.AssertNoResource[*omni.OngoingTask](suite.ctx, suite.T(), suite.state, clusterMachine.Metadata().ID()+"-drain",
+		rtestutils.WithNamespace(resources.EphemeralNamespace),
+	)
+}
+
+func (suite *ClusterMachineDrainSuite) TestTimeout() {
+	suite.testTimeout(false)
+}
+
+func (suite *ClusterMachineDrainSuite) TestForcedTimeout() {
+	suite.testTimeout(true)
+}
+
+func (suite *ClusterMachineDrainSuite) testTimeout(force bool) {
+	suite.startRuntime()
+
+	kubernetesClient := &kubernetesDrainClientMock{
+		pods:    []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}},
+		blocked: map[string]struct{}{"default/app": {}},
+	}
+
+	suite.registerController(kubernetesClient)
+
+	clusterMachine := suite.createClusterMachine(func(spec *specs.MachineSetSpec) {
+		spec.UpdateStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
+			Drain: &specs.MachineSetSpec_DrainConfig{
+				Timeout: durationpb.New(time.Second),
+				Force:   force,
+			},
+		}
+	})
+
+	configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID())
+	configStatus.TypedSpec().Value.DrainRequested = true
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+
+	// the pod disruption budget doesn't hold the operation after the timeout
+	assertResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, clusterMachine.Metadata(), func(res *omni.ClusterMachineDrainStatus, assertion *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		assertion.Equal(specs.ClusterMachineDrainStatusSpec_DRAINED, spec.Phase)
+		assertion.True(spec.TimedOut)
+		assertion.Equal(force, spec.Forced)
+		assertion.EqualValues(1, spec.PodsRemaining)
+	})
+
+	_, evicted := kubernetesClient.get()
+
+	suite.Assert().Empty(evicted)
+
+	if force {
+		suite.Assert().Equal([]string{"default/app"}, kubernetesClient.getDeleted())
+	} else {
+		suite.Assert().Empty(kubernetesClient.getDeleted())
+	}
+}
+
+func (suite *ClusterMachineDrainSuite) TestTeardown() {
+	suite.startRuntime()
+
+	kubernetesClient := &kubernetesDrainClientMock{
+		pods: []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}},
+	}
+
+	suite.registerController(kubernetesClient)
+
+	clusterMachine := suite.createClusterMachine(func(spec *specs.MachineSetSpec) {
+		spec.DeleteStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
+			Drain: &specs.MachineSetSpec_DrainConfig{
+				Timeout: durationpb.New(time.Minute),
+			},
+		}
+	})
+
+	// hold the teardown the same way the controllers which reset the machine do
+	suite.Require().NoError(suite.state.AddFinalizer(suite.ctx, clusterMachine.Metadata(), "test"))
+
+	assertNoResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, omni.NewClusterMachineDrainStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID()))
+
+	_, err := suite.state.Teardown(suite.ctx, clusterMachine.Metadata())
+	suite.Require().NoError(err)
+
+	assertResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, clusterMachine.Metadata(), func(res *omni.ClusterMachineDrainStatus, assertion *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		assertion.Equal(specs.ClusterMachineDrainStatusSpec_DRAINED, spec.Phase)
+		assertion.Equal("teardown", spec.Reason)
+		assertion.True(spec.Cordoned)
+	})
+
+	cordoned, evicted := kubernetesClient.get()
+
+	suite.Assert().Equal([]string{"node1"}, cordoned)
+	suite.Assert().Equal([]string{"default/app"}, evicted)
+
+	suite.Require().NoError(suite.state.RemoveFinalizer(suite.ctx, clusterMachine.Metadata(), "test"))
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, clusterMachine.Metadata()))
+
+	// the node has left the cluster, so it is not uncordoned
+	assertNoResource[*omni.ClusterMachineDrainStatus](&suite.OmniSuite, omni.NewClusterMachineDrainStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID()))
+
+	cordoned, _ = kubernetesClient.get()
+
+	suite.Assert().Equal([]string{"node1"}, cordoned)
+}
+
+func (suite *ClusterMachineDrainSuite) registerController(kubernetesClient *kubernetesDrainClientMock) {
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterMachineDrainController(
+		func(context.Context, string) (omnictrl.KubernetesDrainClient, error) {
+			return kubernetesClient, nil
+		},
+		omnictrl.WithDrainRequeueInterval(100*time.Millisecond),
+	)))
+}
+
+// createClusterMachine creates the cluster machine "m1" with the node "node1" in the workers machine set of the cluster "drain".
+func (suite *ClusterMachineDrainSuite) createClusterMachine(machineSetSpec func(spec *specs.MachineSetSpec)) *omni.ClusterMachine {
+	cluster := omni.NewCluster(resources.DefaultNamespace, "drain")
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, omni.WorkersResourceID(cluster.Metadata().ID()))
+	machineSetSpec(machineSet.TypedSpec().Value)
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineSet))
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "m1")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+	identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, clusterMachine.Metadata().ID())
+	identity.TypedSpec().Value.Nodename = "node1"
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+
+	return clusterMachine
+}
+
+type kubernetesDrainClientMock struct {
+	// blocked are the pods which can't be evicted because of the pod disruption budgets
+	blocked          map[string]struct{}
+	cordoned         []string
+	evicted          []string
+	deleted          []string
+	pods             []corev1.Pod
+	evictionAttempts int
+	mu               sync.Mutex
+}
+
+func (k *kubernetesDrainClientMock) get() (cordoned, evicted []string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return slices.Clone(k.cordoned), slices.Clone(k.evicted)
+}
+
+func (k *kubernetesDrainClientMock) CordonNode(_ context.Context, node string) (bool, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if slices.Contains(k.cordoned, node) {
+		return false, nil
+	}
+
+	k.cordoned = append(k.cordoned, node)
+
+	return true, nil
+}
+
+func (k *kubernetesDrainClientMock) UncordonNode(_ context.Context, node string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.cordoned = slices.DeleteFunc(k.cordoned, func(n string) bool { return n == node })
+
+	return nil
+}
+
+func (k *kubernetesDrainClientMock) ListNodePods(context.Context, string) ([]corev1.Pod, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return slices.Clone(k.pods), nil
+}
+
+func (k *kubernetesDrainClientMock) getDeleted() []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return slices.Clone(k.deleted)
+}
+
+func (k *kubernetesDrainClientMock) getEvictionAttempts() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.evictionAttempts
+}
+
+func (k *kubernetesDrainClientMock) unblock() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.blocked = nil
+}
+
+func (k *kubernetesDrainClientMock) EvictPod(_ context.Context, pod *corev1.Pod) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.evictionAttempts++
+
+	if _, ok := k.blocked[pod.Namespace+"/"+pod.Name]; ok {
+		return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+	}
+
+	k.evicted = append(k.evicted, pod.Namespace+"/"+pod.Name)
+	k.removePod(pod)
+
+	return nil
+}
+
+func (k *kubernetesDrainClientMock) DeletePod(_ context.Context, pod *corev1.Pod) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.deleted = append(k.deleted, pod.Namespace+"/"+pod.Name)
+	k.removePod(pod)
+
+	return nil
+}
+
+func (k *kubernetesDrainClientMock) removePod(pod *corev1.Pod) {
+	k.pods = slices.DeleteFunc(k.pods, func(p corev1.Pod) bool { return p.Namespace == pod.Namespace && p.Name == pod.Name })
+}
+
+func TestClusterMachineDrainSuite(t *testing.T) {
+	suite.Run(t, new(ClusterMachineDrainSuite))
+}
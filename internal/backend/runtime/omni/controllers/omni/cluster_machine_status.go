@@ -134,6 +134,17 @@ func NewClusterMachineStatusController() *ClusterMachineStatusController {
 
 				cmsVal.ConfigApplyStatus = configApplyStatus(cmsVal)
 
+				drainStatus, err := safe.ReaderGetByID[*omni.ClusterMachineDrainStatus](ctx, r, clusterMachine.Metadata().ID())
+				if err != nil && !state.IsNotFoundError(err) {
+					return err
+				}
+
+				if drainStatus != nil && drainStatus.TypedSpec().Value.Phase == specs.ClusterMachineDrainStatusSpec_DRAINING {
+					cmsVal.Drain = drainStatus.TypedSpec().Value.CloneVT()
+				} else {
+					cmsVal.Drain = nil
+				}
+
 				status := statusSnapshot.TypedSpec().Value.GetMachineStatus()
 
 				cmsVal.ApidAvailable = false
@@ -214,6 +225,9 @@ func NewClusterMachineStatusController() *ClusterMachineStatusController {
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.MachineSetNode, *omni.ClusterMachine](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.ClusterMachineDrainStatus, *omni.ClusterMachine](),
+		),
 		qtransform.WithIgnoreTeardownUntil(ClusterMachineEncryptionKeyControllerName), // destroy the ClusterMachineStatus after the ClusterMachineEncryptionKey is destroyed
 		qtransform.WithConcurrency(2),
 	)
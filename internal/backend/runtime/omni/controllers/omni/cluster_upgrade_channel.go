@@ -0,0 +1,328 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
+
+	"github.com/siderolabs/omni/client/api/common"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/audit"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+)
+
+// ClusterUpgradeChannelControllerName is the name of the ClusterUpgradeChannelController.
+const ClusterUpgradeChannelControllerName = "ClusterUpgradeChannelController"
+
+// upgradeChannelRetryInterval is the interval between the upgrade attempts if the Kubernetes upgrade pre-checks fail or the upgrade is rejected.
+const upgradeChannelRetryInterval = 10 * time.Minute
+
+// KubernetesUpgradePreChecksFunc runs the Kubernetes upgrade pre-checks for the cluster, returns the pre-checks log.
+type KubernetesUpgradePreChecksFunc func(ctx context.Context, r controller.Reader, cluster string, path *upgrade.Path) (string, error)
+
+// ClusterUpgradeChannelController manages ClusterUpgradeChannelStatus.
+//
+// ClusterUpgradeChannelController upgrades the Talos and Kubernetes versions of the clusters subscribed to the upgrade channel.
+type ClusterUpgradeChannelController = qtransform.QController[*omni.Cluster, *omni.ClusterUpgradeChannelStatus]
+
+// NewClusterUpgradeChannelController initializes ClusterUpgradeChannelController.
+//
+// The Cluster resource is owned by the user, so the controller updates its versions using the given state,
+// which should be the validated state, so that the upgrades are checked the same way as the changes made through the API.
+// The updates are recorded to the audit log if it is set.
+// The upgrades are applied one at a time: Talos first, then Kubernetes, each one waits for the previous upgrade to finish.
+//
+//nolint:gocognit,gocyclo,cyclop
+func NewClusterUpgradeChannelController(st state.State, preChecks KubernetesUpgradePreChecksFunc, auditLog *audit.Log) *ClusterUpgradeChannelController {
+	if preChecks == nil {
+		preChecks = runKubernetesUpgradePreChecks
+	}
+
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.Cluster, *omni.ClusterUpgradeChannelStatus]{
+			Name: ClusterUpgradeChannelControllerName,
+			MapMetadataFunc: func(cluster *omni.Cluster) *omni.ClusterUpgradeChannelStatus {
+				return omni.NewClusterUpgradeChannelStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(status *omni.ClusterUpgradeChannelStatus) *omni.Cluster {
+				return omni.NewCluster(resources.DefaultNamespace, status.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, cluster *omni.Cluster, status *omni.ClusterUpgradeChannelStatus) error {
+				status.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+
+				upgradeChannel := cluster.TypedSpec().Value.UpgradeChannel
+
+				talosChannel, err := omni.ParseVersionChannel(upgradeChannel.GetTalos())
+				if err != nil {
+					return err
+				}
+
+				kubernetesChannel, err := omni.ParseVersionChannel(upgradeChannel.GetKubernetes())
+				if err != nil {
+					return err
+				}
+
+				talosUpgradeStatus, err := safe.ReaderGetByID[*omni.TalosUpgradeStatus](ctx, r, cluster.Metadata().ID())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
+					}
+
+					return err
+				}
+
+				kubernetesUpgradeStatus, err := safe.ReaderGetByID[*omni.KubernetesUpgradeStatus](ctx, r, cluster.Metadata().ID())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
+					}
+
+					return err
+				}
+
+				spec := status.TypedSpec().Value
+
+				spec.TalosVersion = nextChannelVersion(talosChannel, cluster.TypedSpec().Value.TalosVersion, talosUpgradeStatus.TypedSpec().Value.UpgradeVersions)
+				spec.KubernetesVersion = nextChannelVersion(kubernetesChannel, cluster.TypedSpec().Value.KubernetesVersion, kubernetesUpgradeStatus.TypedSpec().Value.UpgradeVersions)
+				spec.Error = ""
+
+				// the upgrade statuses should reflect the current cluster versions, otherwise the upgrade versions are not calculated yet
+				upgradesDone := talosUpgradeStatus.TypedSpec().Value.Phase == specs.TalosUpgradeStatusSpec_Done &&
+					talosUpgradeStatus.TypedSpec().Value.LastUpgradeVersion == cluster.TypedSpec().Value.TalosVersion &&
+					kubernetesUpgradeStatus.TypedSpec().Value.Phase == specs.KubernetesUpgradeStatusSpec_Done &&
+					kubernetesUpgradeStatus.TypedSpec().Value.LastUpgradeVersion == cluster.TypedSpec().Value.KubernetesVersion
+
+				switch {
+				case talosChannel == nil && kubernetesChannel == nil:
+					spec.Status = ""
+				case upgradeChannel.GetPaused():
+					spec.Status = "automatic upgrades are paused"
+				case talosUpgradeStatus.TypedSpec().Value.FailedUpgradeVersion != "":
+					// the failed upgrade should be resolved by the user, otherwise the channel would keep retrying it
+					spec.Status = fmt.Sprintf("the Talos upgrade to %s has failed, waiting for the cluster Talos version to be changed",
+						talosUpgradeStatus.TypedSpec().Value.FailedUpgradeVersion)
+				case !upgradesDone:
+					spec.Status = "waiting for the running upgrade to finish"
+				case spec.TalosVersion != "":
+					logger.Info("upgrading Talos from the upgrade channel", zap.String("from", cluster.TypedSpec().Value.TalosVersion), zap.String("to", spec.TalosVersion))
+
+					if err = updateClusterVersions(ctx, st, auditLog, logger, cluster, func(clusterSpec *specs.ClusterSpec) {
+						clusterSpec.TalosVersion = spec.TalosVersion
+					}); err != nil {
+						if validated.IsValidationError(err) {
+							spec.Status = fmt.Sprintf("Talos upgrade to %s is rejected", spec.TalosVersion)
+							spec.Error = err.Error()
+
+							return controller.NewRequeueInterval(upgradeChannelRetryInterval)
+						}
+
+						return err
+					}
+
+					spec.Status = fmt.Sprintf("upgrading Talos to %s", spec.TalosVersion)
+				case spec.KubernetesVersion != "":
+					path, err := upgrade.NewPath(cluster.TypedSpec().Value.KubernetesVersion, spec.KubernetesVersion)
+					if err != nil {
+						return fmt.Errorf("invalid upgrade path: %w", err)
+					}
+
+					if preChecksLog, err := preChecks(ctx, r, cluster.Metadata().ID(), path); err != nil {
+						logger.Warn("kubernetes upgrade pre-checks failed", zap.String("log", preChecksLog), zap.Error(err))
+
+						spec.Status = fmt.Sprintf("Kubernetes upgrade to %s is blocked by the pre-checks", spec.KubernetesVersion)
+						spec.Error = fmt.Sprintf("%spre-checks failed: %v", preChecksLog, err)
+
+						return controller.NewRequeueInterval(upgradeChannelRetryInterval)
+					}
+
+					logger.Info("upgrading Kubernetes from the upgrade channel", zap.String("from", cluster.TypedSpec().Value.KubernetesVersion), zap.String("to", spec.KubernetesVersion))
+
+					if err = updateClusterVersions(ctx, st, auditLog, logger, cluster, func(clusterSpec *specs.ClusterSpec) {
+						clusterSpec.KubernetesVersion = spec.KubernetesVersion
+					}); err != nil {
+						if validated.IsValidationError(err) {
+							spec.Status = fmt.Sprintf("Kubernetes upgrade to %s is rejected", spec.KubernetesVersion)
+							spec.Error = err.Error()
+
+							return controller.NewRequeueInterval(upgradeChannelRetryInterval)
+						}
+
+						return err
+					}
+
+					spec.Status = fmt.Sprintf("upgrading Kubernetes to %s", spec.KubernetesVersion)
+				default:
+					spec.Status = "up to date"
+				}
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.TalosUpgradeStatus, *omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.KubernetesUpgradeStatus, *omni.Cluster](),
+		),
+		// the Kubernetes upgrade pre-checks read the control plane nodes
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabelOnlyControlplane[*omni.ClusterMachineIdentity, *omni.Cluster](),
+		),
+	)
+}
+
+// nextChannelVersion picks the latest upgrade version allowed by the channel, returns an empty string if there is nothing to upgrade to.
+//
+// The minor versions are never skipped: if the channel is ahead by more than one minor version, the latest patch of the next minor version is picked.
+func nextChannelVersion(channel *omni.VersionChannel, currentVersion string, upgradeVersions []string) string {
+	if channel == nil {
+		return ""
+	}
+
+	current, err := semver.ParseTolerant(currentVersion)
+	if err != nil {
+		return ""
+	}
+
+	var (
+		next    semver.Version
+		nextStr string
+	)
+
+	for _, upgradeVersion := range upgradeVersions {
+		version, err := semver.ParseTolerant(upgradeVersion)
+		if err != nil {
+			continue
+		}
+
+		if !version.GT(current) || !channel.Allows(version) {
+			continue
+		}
+
+		if version.Major != current.Major || version.Minor > current.Minor+1 {
+			continue
+		}
+
+		if nextStr == "" || version.GT(next) {
+			next = version
+			nextStr = upgradeVersion
+		}
+	}
+
+	return nextStr
+}
+
+func updateClusterVersions(ctx context.Context, st state.State, auditLog *audit.Log, logger *zap.Logger, cluster *omni.Cluster, update func(*specs.ClusterSpec)) error {
+	// the update is based on the version of the cluster the decision was made for, so it fails with the conflict if the cluster was changed meanwhile
+	updated := cluster.DeepCopy().(*omni.Cluster) //nolint:forcetypeassert,errcheck
+
+	update(updated.TypedSpec().Value)
+
+	if err := st.Update(ctx, updated); err != nil {
+		if state.IsConflictError(err) {
+			return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
+		}
+
+		return fmt.Errorf("failed to update the cluster versions: %w", err)
+	}
+
+	if auditLog != nil {
+		if err := auditLog.WriteUpdate(ClusterUpgradeChannelControllerName, cluster, updated); err != nil {
+			logger.Error("failed to write audit log entry", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func runKubernetesUpgradePreChecks(ctx context.Context, r controller.Reader, cluster string, path *upgrade.Path) (string, error) {
+	var logBuffer strings.Builder
+
+	preChecks, err := NewKubernetesUpgradePreChecks(ctx, r, cluster, path, func(format string, args ...any) {
+		fmt.Fprintf(&logBuffer, format, args...)
+		fmt.Fprintln(&logBuffer)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = preChecks.Run(ctx)
+
+	return logBuffer.String(), err
+}
+
+// NewKubernetesUpgradePreChecks prepares the Kubernetes upgrade pre-checks for the cluster.
+func NewKubernetesUpgradePreChecks(ctx context.Context, r controller.Reader, cluster string, path *upgrade.Path, logFunc func(format string, args ...any)) (*upgrade.Checks, error) {
+	cmis, err := safe.ReaderListAll[*omni.ClusterMachineIdentity](
+		ctx,
+		r,
+		state.WithLabelQuery(
+			resource.LabelEqual(omni.LabelCluster, cluster),
+			resource.LabelExists(omni.LabelControlPlaneRole),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var controlplaneNodes []string
+
+	for iter := cmis.Iterator(); iter.Next(); {
+		if len(iter.Value().TypedSpec().Value.NodeIps) > 0 {
+			controlplaneNodes = append(controlplaneNodes, iter.Value().TypedSpec().Value.NodeIps[0])
+		}
+	}
+
+	type kubeconfigGetter interface {
+		GetKubeconfig(ctx context.Context, cluster *common.Context) (*rest.Config, error)
+	}
+
+	k8sRuntime, err := runtime.LookupInterface[kubeconfigGetter](kubernetes.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	restConfig, err := k8sRuntime.GetKubeconfig(ctx, &common.Context{Name: cluster})
+	if err != nil {
+		return nil, fmt.Errorf("error getting kubeconfig: %w", err)
+	}
+
+	type talosClientGetter interface {
+		GetClient(ctx context.Context, clusterName string) (*talos.Client, error)
+	}
+
+	talosRuntime, err := runtime.LookupInterface[talosClientGetter](talos.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	talosClient, err := talosRuntime.GetClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("error getting talos client: %w", err)
+	}
+
+	return upgrade.NewChecks(path, talosClient.COSI, restConfig, controlplaneNodes, nil, logFunc)
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/audit"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+type ClusterUpgradeChannelSuite struct {
+	OmniSuite
+}
+
+func (suite *ClusterUpgradeChannelSuite) TestReconcile() {
+	suite.startRuntime()
+
+	var preChecksPassing atomic.Bool
+
+	auditLog, err := audit.NewLog(&config.AuditLogParams{Path: suite.T().TempDir()})
+	suite.Require().NoError(err)
+
+	defer auditLog.Close() //nolint:errcheck
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterUpgradeChannelController(suite.state,
+		func(context.Context, controller.Reader, string, *upgrade.Path) (string, error) {
+			if !preChecksPassing.Load() {
+				return "checking deprecated APIs\n", errors.New("deprecated APIs are in use")
+			}
+
+			return "", nil
+		},
+		auditLog,
+	)))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "channel")
+	cluster.TypedSpec().Value.TalosVersion = "1.6.0"
+	cluster.TypedSpec().Value.KubernetesVersion = "1.28.0"
+	cluster.TypedSpec().Value.UpgradeChannel = &specs.UpgradeChannel{
+		Talos:      "1.7",
+		Kubernetes: omni.UpgradeChannelStable,
+	}
+
+	talosUpgradeStatus := omni.NewTalosUpgradeStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	talosUpgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Done
+	talosUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.6.0"
+	talosUpgradeStatus.TypedSpec().Value.UpgradeVersions = []string{"1.5.5", "1.6.1", "1.7.2", "1.7.3", "1.8.0", "1.7.4-beta.0"}
+
+	kubernetesUpgradeStatus := omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	kubernetesUpgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Done
+	kubernetesUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.28.0"
+	kubernetesUpgradeStatus.TypedSpec().Value.UpgradeVersions = []string{"1.28.5", "1.29.1"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, talosUpgradeStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, kubernetesUpgradeStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	// Talos is upgraded first, to the latest patch of the channel minor version
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.Cluster, assertion *assert.Assertions) {
+		assertion.Equal("1.7.3", res.TypedSpec().Value.TalosVersion)
+		assertion.Equal("1.28.0", res.TypedSpec().Value.KubernetesVersion)
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.ClusterUpgradeChannelStatus, assertion *assert.Assertions) {
+		assertion.Equal("waiting for the running upgrade to finish", res.TypedSpec().Value.Status)
+	})
+
+	// the upgrade is recorded to the audit log
+	var entries []audit.Entry
+
+	suite.Require().NoError(auditLog.Read(audit.Filter{}, func(line []byte) error {
+		var entry audit.Entry
+
+		if err = json.Unmarshal(line, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+
+		return nil
+	}))
+
+	suite.Require().Len(entries, 1)
+	suite.Assert().Equal(omnictrl.ClusterUpgradeChannelControllerName, entries[0].Method)
+	suite.Assert().Equal(audit.OperationUpdate, entries[0].Operation)
+	suite.Assert().Equal(cluster.Metadata().ID(), entries[0].ResourceID)
+	suite.Assert().Contains(entries[0].Diff, "+    talosversion: 1.7.3")
+
+	// the Talos upgrade is done, Kubernetes upgrade is blocked by the pre-checks
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, talosUpgradeStatus.Metadata(), func(res *omni.TalosUpgradeStatus) error {
+		res.TypedSpec().Value.LastUpgradeVersion = "1.7.3"
+		res.TypedSpec().Value.UpgradeVersions = []string{"1.8.0"}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.ClusterUpgradeChannelStatus, assertion *assert.Assertions) {
+		assertion.Equal("1.29.1", res.TypedSpec().Value.KubernetesVersion)
+		assertion.Empty(res.TypedSpec().Value.TalosVersion)
+		assertion.Contains(res.TypedSpec().Value.Error, "deprecated APIs are in use")
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.Cluster, assertion *assert.Assertions) {
+		assertion.Equal("1.28.0", res.TypedSpec().Value.KubernetesVersion)
+	})
+
+	// paused channel doesn't upgrade
+	preChecksPassing.Store(true)
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.UpgradeChannel.Paused = true
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.ClusterUpgradeChannelStatus, assertion *assert.Assertions) {
+		assertion.Equal("automatic upgrades are paused", res.TypedSpec().Value.Status)
+		assertion.Empty(res.TypedSpec().Value.Error)
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.Cluster, assertion *assert.Assertions) {
+		assertion.Equal("1.28.0", res.TypedSpec().Value.KubernetesVersion)
+	})
+
+	// resumed channel upgrades Kubernetes once the pre-checks pass
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.UpgradeChannel.Paused = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.Cluster, assertion *assert.Assertions) {
+		assertion.Equal("1.7.3", res.TypedSpec().Value.TalosVersion)
+		assertion.Equal("1.29.1", res.TypedSpec().Value.KubernetesVersion)
+	})
+}
+
+func (suite *ClusterUpgradeChannelSuite) TestDefaultPreChecks() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterUpgradeChannelController(suite.state, nil, nil)))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "channel")
+	cluster.TypedSpec().Value.TalosVersion = "1.7.3"
+	cluster.TypedSpec().Value.KubernetesVersion = "1.28.0"
+	cluster.TypedSpec().Value.UpgradeChannel = &specs.UpgradeChannel{
+		Kubernetes: omni.UpgradeChannelStable,
+	}
+
+	talosUpgradeStatus := omni.NewTalosUpgradeStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	talosUpgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Done
+	talosUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.7.3"
+
+	kubernetesUpgradeStatus := omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	kubernetesUpgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Done
+	kubernetesUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.28.0"
+	kubernetesUpgradeStatus.TypedSpec().Value.UpgradeVersions = []string{"1.29.1"}
+
+	identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, "cp1")
+	identity.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	identity.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+	identity.TypedSpec().Value.NodeIps = []string{"10.5.0.2"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+	suite.Require().NoError(suite.state.Create(suite.ctx, talosUpgradeStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, kubernetesUpgradeStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	// the pre-checks read the control plane nodes, and then fail as there is no Kubernetes cluster to check
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.ClusterUpgradeChannelStatus, assertion *assert.Assertions) {
+		assertion.Equal("Kubernetes upgrade to 1.29.1 is blocked by the pre-checks", res.TypedSpec().Value.Status)
+		assertion.Contains(res.TypedSpec().Value.Error, "error getting kubeconfig")
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.Cluster, assertion *assert.Assertions) {
+		assertion.Equal("1.28.0", res.TypedSpec().Value.KubernetesVersion)
+	})
+}
+
+func (suite *ClusterUpgradeChannelSuite) TestRejectedUpgrade() {
+	suite.startRuntime()
+
+	// the upgrades go through the same validations as the changes made through the API
+	validatedState := state.WrapCore(validated.NewState(suite.state,
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _, newRes *omni.Cluster, _ ...state.UpdateOption) error {
+			if newRes.TypedSpec().Value.TalosVersion == "1.7.3" {
+				return errors.New("invalid kubernetes version \"1.28.0\": is not compatible with talos version \"1.7.3\"")
+			}
+
+			return nil
+		})),
+	))
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterUpgradeChannelController(validatedState, nil, nil)))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "channel")
+	cluster.TypedSpec().Value.TalosVersion = "1.6.0"
+	cluster.TypedSpec().Value.KubernetesVersion = "1.28.0"
+	cluster.TypedSpec().Value.UpgradeChannel = &specs.UpgradeChannel{
+		Talos: "1.7",
+	}
+
+	talosUpgradeStatus := omni.NewTalosUpgradeStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	talosUpgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Done
+	talosUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.6.0"
+	talosUpgradeStatus.TypedSpec().Value.UpgradeVersions = []string{"1.7.3"}
+
+	kubernetesUpgradeStatus := omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	kubernetesUpgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Done
+	kubernetesUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.28.0"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, talosUpgradeStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, kubernetesUpgradeStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.ClusterUpgradeChannelStatus, assertion *assert.Assertions) {
+		assertion.Equal("Talos upgrade to 1.7.3 is rejected", res.TypedSpec().Value.Status)
+		assertion.Contains(res.TypedSpec().Value.Error, "is not compatible with talos version")
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{cluster.Metadata().ID()}, func(res *omni.Cluster, assertion *assert.Assertions) {
+		assertion.Equal("1.6.0", res.TypedSpec().Value.TalosVersion)
+	})
+}
+
+func TestClusterUpgradeChannelSuite(t *testing.T) {
+	suite.Run(t, new(ClusterUpgradeChannelSuite))
+}
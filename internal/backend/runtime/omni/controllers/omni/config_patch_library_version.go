@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// ConfigPatchLibraryVersionController keeps the versions of the config patch libraries.
+//
+// Each change of the library data creates a new ConfigPatchLibraryVersion, the versions are never changed after they are created,
+// and they are removed only together with the library.
+type ConfigPatchLibraryVersionController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *ConfigPatchLibraryVersionController) Name() string {
+	return "ConfigPatchLibraryVersionController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ConfigPatchLibraryVersionController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Type:      omni.ConfigPatchLibraryType,
+			Namespace: resources.DefaultNamespace,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ConfigPatchLibraryVersionController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.ConfigPatchLibraryVersionType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ConfigPatchLibraryVersionController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.run(ctx, r, logger); err != nil {
+			return fmt.Errorf("error running config patch library version controller: %w", err)
+		}
+	}
+}
+
+func (ctrl *ConfigPatchLibraryVersionController) run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	libraries, err := safe.ReaderListAll[*omni.ConfigPatchLibrary](ctx, r)
+	if err != nil {
+		return err
+	}
+
+	versions, err := safe.ReaderListAll[*omni.ConfigPatchLibraryVersion](ctx, r)
+	if err != nil {
+		return err
+	}
+
+	existingLibraries := make(map[resource.ID]struct{}, libraries.Len())
+
+	libraries.ForEach(func(library *omni.ConfigPatchLibrary) {
+		existingLibraries[library.Metadata().ID()] = struct{}{}
+	})
+
+	tracker := trackResource(r, resources.DefaultNamespace, omni.ConfigPatchLibraryVersionType)
+	latestVersions := map[resource.ID]*omni.ConfigPatchLibraryVersion{}
+
+	versions.ForEach(func(version *omni.ConfigPatchLibraryVersion) {
+		libraryID, _ := version.Metadata().Labels().Get(omni.LabelConfigPatchLibrary)
+
+		// the versions are kept while the library exists, even if it is being removed, as they might still be in use
+		if _, ok := existingLibraries[libraryID]; ok {
+			tracker.keep(version)
+		}
+
+		if latest, ok := latestVersions[libraryID]; !ok || latest.TypedSpec().Value.Version < version.TypedSpec().Value.Version {
+			latestVersions[libraryID] = version
+		}
+	})
+
+	for iter := libraries.Iterator(); iter.Next(); {
+		library := iter.Value()
+
+		if library.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		var number uint32
+
+		if latest, ok := latestVersions[library.Metadata().ID()]; ok {
+			if latest.TypedSpec().Value.Data == library.TypedSpec().Value.Data {
+				continue
+			}
+
+			number = latest.TypedSpec().Value.Version
+		}
+
+		number++
+
+		version := omni.NewConfigPatchLibraryVersion(resources.DefaultNamespace, omni.ConfigPatchLibraryVersionID(library.Metadata().ID(), number))
+
+		if err = safe.WriterModify(ctx, r, version, func(res *omni.ConfigPatchLibraryVersion) error {
+			res.Metadata().Labels().Set(omni.LabelConfigPatchLibrary, library.Metadata().ID())
+
+			res.TypedSpec().Value.Data = library.TypedSpec().Value.Data
+			res.TypedSpec().Value.Version = number
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error creating version %d of the config patch library %q: %w", number, library.Metadata().ID(), err)
+		}
+
+		tracker.keep(version)
+
+		logger.Info("created config patch library version", zap.String("library", library.Metadata().ID()), zap.Uint32("version", number))
+	}
+
+	// the versions of the removed libraries
+	return tracker.cleanup(ctx)
+}
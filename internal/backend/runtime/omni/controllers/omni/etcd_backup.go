@@ -167,6 +167,7 @@ func (ctrl *EtcdBackupController) run(ctx context.Context, r controller.Runtime,
 	type result struct {
 		time      time.Time
 		err       error
+		prune     *pruneResult
 		clusterID string
 	}
 
@@ -180,11 +181,19 @@ func (ctrl *EtcdBackupController) run(ctx context.Context, r controller.Runtime,
 			defer cancel()
 
 			backupErr := ctrl.doBackup(localCtx, backupData, clusterLogger)
+			backupTime := ctrl.settings.Clock.Now()
+
+			var prune *pruneResult
+
+			if backupErr == nil {
+				prune = ctrl.pruneBackups(localCtx, backupData, clusterLogger)
+			}
 
 			backupResultCh <- result{
 				clusterID: backupData.Metadata().ID(),
-				time:      ctrl.settings.Clock.Now(),
+				time:      backupTime,
 				err:       backupErr,
+				prune:     prune,
 			}
 
 			return nil
@@ -200,6 +209,10 @@ func (ctrl *EtcdBackupController) run(ctx context.Context, r controller.Runtime,
 
 	for backupResultData := range backupResultCh {
 		ctrl.updateBackupStatus(ctx, r, backupResultData.clusterID, backupResultData.time, backupResultData.err, logger)
+
+		if backupResultData.prune != nil {
+			ctrl.updatePruneStatus(ctx, r, backupResultData.clusterID, backupResultData.prune, logger)
+		}
 	}
 
 	return nil
@@ -402,6 +415,125 @@ func (ctrl *EtcdBackupController) doBackup(
 	return nil
 }
 
+type pruneResult struct {
+	time   time.Time
+	err    error
+	pruned int
+}
+
+// pruneBackups removes the backups which are not kept by the cluster retention policy.
+//
+// It returns nil if the cluster has no retention policy.
+func (ctrl *EtcdBackupController) pruneBackups(ctx context.Context, backupData *omni.BackupData, logger *zap.Logger) *pruneResult {
+	retention := toRetention(backupData.TypedSpec().Value.GetRetention())
+	if retention.IsZero() {
+		return nil
+	}
+
+	clusterUUID := backupData.TypedSpec().Value.ClusterUuid
+
+	pruned, err := ctrl.doPrune(ctx, clusterUUID, retention, logger)
+
+	return &pruneResult{
+		time:   ctrl.settings.Clock.Now(),
+		err:    err,
+		pruned: pruned,
+	}
+}
+
+func (ctrl *EtcdBackupController) doPrune(ctx context.Context, clusterUUID string, retention etcdbackup.Retention, logger *zap.Logger) (int, error) {
+	st, err := ctrl.settings.StoreFactory.GetStore()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get store: %w", err)
+	}
+
+	iter, err := st.ListBackups(ctx, clusterUUID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	var backups []etcdbackup.Info
+
+	for {
+		info, ok, iterErr := iter()
+		if iterErr != nil {
+			return 0, fmt.Errorf("failed to list backups: %w", iterErr)
+		}
+
+		if !ok {
+			break
+		}
+
+		backups = append(backups, info)
+	}
+
+	var pruned int
+
+	for _, info := range retention.Prune(backups, ctrl.settings.Clock.Now()) {
+		if err = st.Remove(ctx, clusterUUID, info.Snapshot); err != nil {
+			return pruned, fmt.Errorf("failed to remove backup %q: %w", info.Snapshot, err)
+		}
+
+		pruned++
+
+		logger.Info(
+			"pruned etcd snapshot",
+			zap.String("cluster_uuid", clusterUUID),
+			zap.String("snapshot_name", info.Snapshot),
+			zap.Time("ts", info.Timestamp),
+		)
+	}
+
+	return pruned, nil
+}
+
+func toRetention(retention *specs.EtcdBackupRetention) etcdbackup.Retention {
+	return etcdbackup.Retention{
+		KeepLast:   int(retention.GetKeepLast()),
+		KeepHourly: int(retention.GetKeepHourly()),
+		KeepDaily:  int(retention.GetKeepDaily()),
+		KeepWeekly: int(retention.GetKeepWeekly()),
+		MaxAge:     retention.GetMaxAge().AsDuration(),
+	}
+}
+
+func (ctrl *EtcdBackupController) updatePruneStatus(
+	ctx context.Context,
+	r controller.Runtime,
+	clusterID string,
+	result *pruneResult,
+	logger *zap.Logger,
+) {
+	if result.err != nil {
+		logger.Warn(
+			"failed to prune cluster backups",
+			zap.String("cluster", clusterID),
+			zap.Error(result.err),
+		)
+	}
+
+	err := safe.WriterModify(
+		ctx,
+		r,
+		omni.NewEtcdBackupStatus(clusterID),
+		func(status *omni.EtcdBackupStatus) error {
+			status.TypedSpec().Value.LastPruneTime = timestamppb.New(result.time)
+			status.TypedSpec().Value.LastPrunedCount = uint32(result.pruned)
+
+			if result.err != nil {
+				status.TypedSpec().Value.PruneError = result.err.Error()
+			} else {
+				status.TypedSpec().Value.PruneError = ""
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		logger.Warn("failed to update etcd backup prune status", zap.Error(err))
+	}
+}
+
 func (ctrl *EtcdBackupController) updateBackupStatus(
 	ctx context.Context,
 	r controller.Runtime,
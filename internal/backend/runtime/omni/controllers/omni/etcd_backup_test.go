@@ -150,6 +150,73 @@ func (suite *EtcdBackupControllerSuite) TestEtcdBackup() {
 	suite.destroyClusterByID(clustersData[1].Metadata().ID())
 }
 
+func (suite *EtcdBackupControllerSuite) TestEtcdBackupRetention() {
+	fakeclock := fakeClock()
+	sf := suite.fileStoreFactory()
+	clientMock := &talosClientMock{}
+
+	defer clientMock.AssertExpectations(suite.T())
+
+	clientMock.
+		On(xmocks.Name((*talosClientMock).EtcdSnapshot), mock.Anything, mock.Anything, mock.Anything).
+		Return(func() (io.ReadCloser, error) { return io.NopCloser(strings.NewReader("Hello World")), nil })
+
+	suite.register2(omnictrl.NewEtcdBackupController(omnictrl.EtcdBackupControllerSettings{
+		ClientMaker: func(context.Context, string) (omnictrl.TalosClient, error) {
+			return clientMock, nil
+		},
+		StoreFactory: sf,
+		Clock:        fakeclock,
+		TickInterval: 10 * time.Minute,
+	}))
+
+	clusterData := createClusters(suite, []string{"talos-default-1"}, time.Hour)[0]
+
+	must.Value(safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewCluster(resources.DefaultNamespace, clusterData.Metadata().ID()).Metadata(), func(cl *omni.Cluster) error {
+		cl.TypedSpec().Value.BackupConfiguration.Retention = &specs.EtcdBackupRetention{KeepLast: 2}
+
+		return nil
+	}))(suite.T())
+
+	assertResource(
+		&suite.OmniSuite,
+		clusterData.Metadata(),
+		func(r *omni.BackupData, assertion *assert.Assertions) {
+			assertion.EqualValues(2, r.TypedSpec().Value.GetRetention().GetKeepLast())
+		},
+	)
+
+	blockAndAdvance(fakeclock, 11*time.Minute)
+
+	suite.eventuallyFindBackups(sf, clusterData.Metadata().ID(), 1)
+
+	blockAndAdvance(fakeclock, time.Hour)
+
+	suite.eventuallyFindBackups(sf, clusterData.Metadata().ID(), 2)
+
+	blockAndAdvance(fakeclock, time.Hour)
+
+	// the third backup should trigger pruning of the oldest one
+	assertResource(
+		&suite.OmniSuite,
+		clusterData.Metadata(),
+		func(r *omni.EtcdBackupStatus, assertion *assert.Assertions) {
+			value := r.TypedSpec().Value
+
+			assertion.Equal(specs.EtcdBackupStatusSpec_Ok, value.Status)
+			assertion.EqualValues(1, value.LastPrunedCount)
+			assertion.Empty(value.PruneError)
+			assertion.Equal(fakeclock.Now().Unix(), value.GetLastPruneTime().AsTime().Unix())
+		},
+	)
+
+	backups := suite.eventuallyFindBackups(sf, clusterData.Metadata().ID(), 2)
+
+	suite.Require().Equal(etcdbackup.CreateSnapshotName(fakeclock.Now()), backups[0].Snapshot)
+
+	suite.destroyClusterByID(clusterData.Metadata().ID())
+}
+
 func (suite *EtcdBackupControllerSuite) TestEtcdBackupFactoryFails() {
 	fakeclock := fakeClock()
 	sf := suite.fileStoreFactory()
@@ -726,6 +793,12 @@ func (s *storeMock) Upload(ctx context.Context, descr etcdbackup.Description, r
 	return xmocks.GetAs[error](args, 0)
 }
 
+func (s *storeMock) Remove(ctx context.Context, clusterUUID, snapshotName string) error {
+	args := s.Called(ctx, clusterUUID, snapshotName)
+
+	return xmocks.GetAs[error](args, 0)
+}
+
 func (s *storeMock) Download(ctx context.Context, clusterUUID []byte, clusterName, snapshotName string) (etcdbackup.BackupData, io.ReadCloser, error) {
 	args := s.Called(ctx, clusterUUID, clusterName, snapshotName)
 
@@ -0,0 +1,236 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// etcdRestoreRetryInterval is the interval between the attempts to reach the control plane machines during the restore.
+const etcdRestoreRetryInterval = 15 * time.Second
+
+// EtcdRestoreStatusController manages EtcdRestoreStatus resource lifecycle.
+//
+// EtcdRestoreStatusController restores etcd of an existing cluster from a backup.
+// It resets all control plane machines wiping their EPHEMERAL partition, and then
+// bootstraps etcd on one of them from the snapshot, the others join it as usual.
+type EtcdRestoreStatusController = qtransform.QController[*omni.EtcdRestore, *omni.EtcdRestoreStatus]
+
+// EtcdRestoreStatusControllerName is the name of the EtcdRestoreStatusController.
+const EtcdRestoreStatusControllerName = "EtcdRestoreStatusController"
+
+// NewEtcdRestoreStatusController initializes EtcdRestoreStatusController.
+func NewEtcdRestoreStatusController(etcdBackupStoreFactory store.Factory) *EtcdRestoreStatusController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.EtcdRestore, *omni.EtcdRestoreStatus]{
+			Name: EtcdRestoreStatusControllerName,
+			MapMetadataFunc: func(restore *omni.EtcdRestore) *omni.EtcdRestoreStatus {
+				return omni.NewEtcdRestoreStatus(restore.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(restoreStatus *omni.EtcdRestoreStatus) *omni.EtcdRestore {
+				return omni.NewEtcdRestore(restoreStatus.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, restore *omni.EtcdRestore, restoreStatus *omni.EtcdRestoreStatus) error {
+				status := restoreStatus.TypedSpec().Value
+
+				logger = logger.With(zap.String("cluster_id", restore.Metadata().ID()))
+
+				switch status.Phase {
+				case specs.EtcdRestoreStatusSpec_Done, specs.EtcdRestoreStatusSpec_Failed:
+					return nil
+				case specs.EtcdRestoreStatusSpec_Unknown:
+					status.ClusterUuid = restore.TypedSpec().Value.ClusterUuid
+					status.Snapshot = restore.TypedSpec().Value.Snapshot
+					status.Phase = specs.EtcdRestoreStatusSpec_Resetting
+
+					logger.Info(
+						"restoring etcd from backup",
+						zap.String("cluster_uuid", status.ClusterUuid),
+						zap.String("snapshot", status.Snapshot),
+					)
+				case specs.EtcdRestoreStatusSpec_Resetting, specs.EtcdRestoreStatusSpec_Recovering:
+				}
+
+				if status.Phase == specs.EtcdRestoreStatusSpec_Resetting {
+					done, err := resetControlPlanesForRestore(ctx, r, logger, restore.Metadata().ID(), status)
+					if err != nil {
+						return err
+					}
+
+					if status.Phase == specs.EtcdRestoreStatusSpec_Failed {
+						return nil
+					}
+
+					if !done {
+						return controller.NewRequeueInterval(etcdRestoreRetryInterval)
+					}
+
+					status.Phase = specs.EtcdRestoreStatusSpec_Recovering
+					status.Error = ""
+				}
+
+				status.Step = "recovering etcd from the snapshot"
+
+				if err := recoverEtcdInPlace(ctx, r, etcdBackupStoreFactory, restore.Metadata().ID(), status); err != nil {
+					// the machines might be still rebooting after the reset, keep retrying
+					logger.Warn("failed to recover etcd, will retry", zap.Error(err))
+
+					status.Error = err.Error()
+
+					return controller.NewRequeueInterval(etcdRestoreRetryInterval)
+				}
+
+				logger.Info("etcd restored from backup", zap.String("snapshot", status.Snapshot))
+
+				status.Phase = specs.EtcdRestoreStatusSpec_Done
+				status.Step = ""
+				status.Error = ""
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabelOnlyControlplane[*omni.ClusterMachine, *omni.EtcdRestore](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperNone[*omni.MachineStatus](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperNone[*omni.TalosConfig](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperNone[*omni.ClusterEndpoint](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperNone[*omni.ClusterUUID](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperNone[*omni.BackupData](),
+		),
+	)
+}
+
+// resetControlPlanesForRestore resets all control plane machines of the cluster wiping the etcd data.
+//
+// It returns true when all machines were reset.
+func resetControlPlanesForRestore(ctx context.Context, r controller.Reader, logger *zap.Logger, clusterName string, status *specs.EtcdRestoreStatusSpec) (bool, error) {
+	clusterMachines, err := safe.ReaderListAll[*omni.ClusterMachine](ctx, r, state.WithLabelQuery(
+		resource.LabelEqual(omni.LabelCluster, clusterName),
+		resource.LabelExists(omni.LabelControlPlaneRole),
+	))
+	if err != nil {
+		return false, fmt.Errorf("failed to list control plane machines of the cluster %q: %w", clusterName, err)
+	}
+
+	if clusterMachines.Len() == 0 {
+		status.Phase = specs.EtcdRestoreStatusSpec_Failed
+		status.Error = "the cluster has no control plane machines"
+
+		return false, nil
+	}
+
+	status.TotalMachines = uint32(clusterMachines.Len())
+
+	for iter := clusterMachines.Iterator(); iter.Next(); {
+		id := iter.Value().Metadata().ID()
+
+		if slices.Contains(status.ResetMachines, id) {
+			continue
+		}
+
+		status.Step = fmt.Sprintf("resetting machine %s", id)
+
+		if err = resetMachineForRestore(ctx, r, clusterName, id); err != nil {
+			status.Error = err.Error()
+
+			return false, nil
+		}
+
+		logger.Info("reset control plane machine for etcd restore", zap.String("machine", id))
+
+		status.ResetMachines = append(status.ResetMachines, id)
+	}
+
+	return true, nil
+}
+
+func resetMachineForRestore(ctx context.Context, r controller.Reader, clusterName, id string) error {
+	machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return fmt.Errorf("machine %q status is not found", id)
+		}
+
+		return err
+	}
+
+	if !machineStatus.TypedSpec().Value.Connected {
+		return fmt.Errorf("machine %q is not connected", id)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	c, err := getTalosClientForAddress(ctx, r, clusterName, machineStatus.TypedSpec().Value.ManagementAddress)
+	if err != nil {
+		return err
+	}
+
+	defer c.Close() //nolint:errcheck
+
+	if err = c.ResetGeneric(ctx, &machineapi.ResetRequest{
+		Graceful: false,
+		Reboot:   true,
+		SystemPartitionsToWipe: []*machineapi.ResetPartitionSpec{
+			{
+				Label: constants.EphemeralPartitionLabel,
+				Wipe:  true,
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to reset machine %q: %w", id, err)
+	}
+
+	return nil
+}
+
+func recoverEtcdInPlace(ctx context.Context, r controller.Reader, etcdBackupStoreFactory store.Factory, clusterName string, status *specs.EtcdRestoreStatusSpec) error {
+	talosCli, err := getTalosClientForBootstrap(ctx, r, clusterName)
+	if err != nil {
+		return err
+	}
+
+	defer talosCli.Close() //nolint:errcheck
+
+	if err = recoverEtcdFromBackup(ctx, r, talosCli, etcdBackupStoreFactory, status.ClusterUuid, status.Snapshot); err != nil {
+		return err
+	}
+
+	if err = talosCli.Bootstrap(ctx, &machineapi.BootstrapRequest{
+		RecoverEtcd: true,
+	}); err != nil {
+		return fmt.Errorf("error bootstrapping cluster %q: %w", clusterName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+)
+
+type EtcdRestoreStatusSuite struct {
+	OmniSuite
+}
+
+func (suite *EtcdRestoreStatusSuite) TestReconcile() {
+	suite.startRuntime()
+
+	etcdBackupDataMock := etcdbackup.BackupData{
+		AESCBCEncryptionSecret:    "test-aes-secret",
+		SecretboxEncryptionSecret: "test-secretbox-secret",
+	}
+
+	storeFactory := &mockStoreFactory{etcdBackupDataMock}
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(storeFactory)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosConfigController(constants.CertificateValidityTime)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterEndpointController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewEtcdRestoreStatusController(storeFactory)))
+	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.OngoingTaskController{}))
+
+	clusterName := "etcd-restore"
+
+	cluster, machines := suite.createCluster(clusterName, 3, 1)
+
+	for _, m := range machines {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewMachineStatus(resources.DefaultNamespace, m.Metadata().ID()).Metadata(),
+			func(res *omni.MachineStatus) error {
+				res.TypedSpec().Value.Connected = true
+
+				return nil
+			},
+		)
+		suite.Require().NoError(err)
+
+		clusterMachineStatus := omni.NewClusterMachineStatus(resources.DefaultNamespace, m.Metadata().ID())
+
+		clusterMachineStatus.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+		if _, ok := m.Metadata().Labels().Get(omni.LabelControlPlaneRole); ok {
+			clusterMachineStatus.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+		}
+
+		clusterMachineStatus.TypedSpec().Value.ManagementAddress = suite.socketConnectionString
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachineStatus))
+	}
+
+	backupData := omni.NewBackupData(clusterName)
+	backupData.TypedSpec().Value.EncryptionKey = []byte("test-key")
+	backupData.TypedSpec().Value.AesCbcEncryptionSecret = etcdBackupDataMock.AESCBCEncryptionSecret
+	backupData.TypedSpec().Value.SecretboxEncryptionSecret = etcdBackupDataMock.SecretboxEncryptionSecret
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, backupData))
+
+	clusterUUID := "4a54ec0b-2b1c-4a8d-a0b1-6b1d1ef3e9d2"
+	clusterUUIDRes := omni.NewClusterUUID(clusterName)
+	clusterUUIDRes.TypedSpec().Value.Uuid = clusterUUID
+
+	clusterUUIDRes.Metadata().Labels().Set(omni.LabelClusterUUID, clusterUUID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterUUIDRes))
+
+	snapshot := etcdbackup.CreateSnapshotName(time.Now())
+
+	restore := omni.NewEtcdRestore(clusterName)
+	restore.TypedSpec().Value.ClusterUuid = clusterUUID
+	restore.TypedSpec().Value.Snapshot = snapshot
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, restore))
+
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewEtcdRestoreStatus(clusterName).Metadata(),
+		func(res *omni.EtcdRestoreStatus, assertions *assert.Assertions) {
+			spec := res.TypedSpec().Value
+
+			assertions.Equal(specs.EtcdRestoreStatusSpec_Done, spec.Phase)
+			assertions.Empty(spec.Error)
+			assertions.Equal(snapshot, spec.Snapshot)
+			assertions.EqualValues(3, spec.TotalMachines)
+			assertions.Len(spec.ResetMachines, 3)
+		},
+	)
+
+	// only the control plane machines are reset, only the etcd data is wiped
+	resetRequests := suite.machineService.getResetRequests()
+
+	suite.Require().Len(resetRequests, 3)
+
+	for _, req := range resetRequests {
+		suite.Assert().False(req.Graceful)
+		suite.Assert().True(req.Reboot)
+		suite.Assert().Len(req.SystemPartitionsToWipe, 1)
+	}
+
+	suite.Assert().Equal(uint64(1), suite.machineService.etcdRecoverRequestCount.Load())
+
+	bootstrapRequests := suite.machineService.getBootstrapRequests()
+
+	suite.Require().Len(bootstrapRequests, 1)
+	suite.Assert().True(bootstrapRequests[0].RecoverEtcd)
+
+	// the task is not ongoing anymore
+	rtestutils.AssertNoResource[*omni.OngoingTask](suite.ctx, suite.T(), suite.state, clusterName+"-etcd-restore")
+
+	rtestutils.Destroy[*omni.EtcdRestore](suite.ctx, suite.T(), suite.state, []resource.ID{clusterName})
+	rtestutils.AssertNoResource[*omni.EtcdRestoreStatus](suite.ctx, suite.T(), suite.state, clusterName)
+
+	rtestutils.Destroy[*omni.BackupData](suite.ctx, suite.T(), suite.state, []resource.ID{clusterName})
+
+	suite.destroyCluster(cluster)
+}
+
+func TestEtcdRestoreStatusSuite(t *testing.T) {
+	suite.Run(t, new(EtcdRestoreStatusSuite))
+}
@@ -23,9 +23,15 @@ type Lister interface {
 	ListBackups(ctx context.Context, clusterUUID string) (InfoIterator, error)
 }
 
+// Remover is an interface that is used to remove etcd backups.
+type Remover interface {
+	Remove(ctx context.Context, clusterUUID, snapshotName string) error
+}
+
 // Store is an interface that is used to upload etcd backups.
 type Store interface {
 	Lister
+	Remover
 	Upload(ctx context.Context, descr Description, r io.Reader) error
 	Download(ctx context.Context, encryptionKey []byte, clusterUUID, snapshotName string) (BackupData, io.ReadCloser, error)
 }
@@ -54,3 +54,85 @@ func TestParseSnapshotName(t *testing.T) {
 		})
 	}
 }
+
+func TestRetentionPrune(t *testing.T) {
+	now := time.Date(2024, 5, 15, 12, 30, 0, 0, time.UTC)
+
+	// backups every 30 minutes for the last 10 days
+	var backups []etcdbackup.Info
+
+	for ts := now; ts.After(now.Add(-10 * 24 * time.Hour)); ts = ts.Add(-30 * time.Minute) {
+		backups = append(backups, etcdbackup.Info{Snapshot: etcdbackup.CreateSnapshotName(ts), Timestamp: ts})
+	}
+
+	//nolint:govet
+	tests := map[string]struct {
+		retention etcdbackup.Retention
+		kept      int
+		oldest    time.Time
+	}{
+		"empty": {
+			kept:   len(backups),
+			oldest: backups[len(backups)-1].Timestamp,
+		},
+		"keep last": {
+			retention: etcdbackup.Retention{KeepLast: 3},
+			kept:      3,
+			oldest:    now.Add(-time.Hour),
+		},
+		"keep hourly": {
+			retention: etcdbackup.Retention{KeepHourly: 4},
+			kept:      4,
+			oldest:    now.Add(-3 * time.Hour),
+		},
+		"keep last and daily": {
+			retention: etcdbackup.Retention{KeepLast: 2, KeepDaily: 3},
+			kept:      4,
+			oldest:    time.Date(2024, 5, 13, 23, 30, 0, 0, time.UTC),
+		},
+		"keep weekly": {
+			retention: etcdbackup.Retention{KeepWeekly: 2},
+			kept:      2,
+			oldest:    time.Date(2024, 5, 12, 23, 30, 0, 0, time.UTC),
+		},
+		"max age": {
+			retention: etcdbackup.Retention{MaxAge: 2 * time.Hour},
+			kept:      5,
+			oldest:    now.Add(-2 * time.Hour),
+		},
+		"max age overrides keep rules": {
+			retention: etcdbackup.Retention{KeepDaily: 7, MaxAge: 36 * time.Hour},
+			kept:      2,
+			oldest:    time.Date(2024, 5, 14, 23, 30, 0, 0, time.UTC),
+		},
+		"latest is always kept": {
+			retention: etcdbackup.Retention{MaxAge: time.Minute},
+			kept:      1,
+			oldest:    now,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			pruned := tt.retention.Prune(backups, now)
+
+			require.Len(t, pruned, len(backups)-tt.kept)
+
+			prunedNames := map[string]struct{}{}
+
+			for _, info := range pruned {
+				prunedNames[info.Snapshot] = struct{}{}
+			}
+
+			oldest := now
+
+			for _, info := range backups {
+				if _, ok := prunedNames[info.Snapshot]; !ok && info.Timestamp.Before(oldest) {
+					oldest = info.Timestamp
+				}
+			}
+
+			require.Equal(t, tt.oldest, oldest)
+		})
+	}
+}
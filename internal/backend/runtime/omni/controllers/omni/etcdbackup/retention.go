@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package etcdbackup
+
+import (
+	"slices"
+	"time"
+)
+
+// Retention describes which backups are kept when old backups are pruned.
+//
+// A backup is kept if it matches any of the keep rules and is not older than MaxAge.
+// The most recent backup is always kept.
+type Retention struct {
+	KeepLast   int
+	KeepHourly int
+	KeepDaily  int
+	KeepWeekly int
+	MaxAge     time.Duration
+}
+
+// IsZero returns true if the retention policy doesn't prune anything.
+func (r Retention) IsZero() bool {
+	return r.KeepLast == 0 && r.KeepHourly == 0 && r.KeepDaily == 0 && r.KeepWeekly == 0 && r.MaxAge == 0
+}
+
+// Prune returns the backups which should be removed according to the retention policy.
+func (r Retention) Prune(backups []Info, now time.Time) []Info {
+	if r.IsZero() || len(backups) == 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(backups)
+
+	slices.SortStableFunc(sorted, func(a, b Info) int { return b.Timestamp.Compare(a.Timestamp) })
+
+	hasKeepRules := r.KeepLast > 0 || r.KeepHourly > 0 || r.KeepDaily > 0 || r.KeepWeekly > 0
+
+	keep := make([]bool, len(sorted))
+
+	if hasKeepRules {
+		for i := range min(r.KeepLast, len(sorted)) {
+			keep[i] = true
+		}
+
+		keepBuckets(sorted, keep, r.KeepHourly, func(t time.Time) int64 { return t.Unix() / int64(time.Hour/time.Second) })
+		keepBuckets(sorted, keep, r.KeepDaily, func(t time.Time) int64 {
+			year, month, day := t.Date()
+
+			return int64(year)*10000 + int64(month)*100 + int64(day)
+		})
+		keepBuckets(sorted, keep, r.KeepWeekly, func(t time.Time) int64 {
+			year, week := t.ISOWeek()
+
+			return int64(year)*100 + int64(week)
+		})
+	} else {
+		for i := range keep {
+			keep[i] = true
+		}
+	}
+
+	if r.MaxAge > 0 {
+		for i, backup := range sorted {
+			if now.Sub(backup.Timestamp) > r.MaxAge {
+				keep[i] = false
+			}
+		}
+	}
+
+	keep[0] = true
+
+	var result []Info
+
+	for i, backup := range sorted {
+		if !keep[i] {
+			result = append(result, backup)
+		}
+	}
+
+	return result
+}
+
+// keepBuckets marks the most recent backup in each of the first count buckets as kept.
+//
+// Backups must be sorted from the newest to the oldest.
+func keepBuckets(sorted []Info, keep []bool, count int, bucket func(time.Time) int64) {
+	if count == 0 {
+		return
+	}
+
+	var (
+		last  int64
+		found int
+	)
+
+	for i, backup := range sorted {
+		current := bucket(backup.Timestamp.UTC())
+
+		if found > 0 && current == last {
+			continue
+		}
+
+		keep[i] = true
+		last = current
+		found++
+
+		if found == count {
+			return
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/crypt"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/execstore"
+)
+
+type execStoreFactory struct {
+	store   *crypt.Store
+	command string
+}
+
+// NewExecStoreFactory returns a new factory for the store which delegates storing backups to an external command.
+func NewExecStoreFactory(command string) Factory {
+	return &execStoreFactory{
+		store:   crypt.NewStore(execstore.NewStore(command)),
+		command: command,
+	}
+}
+
+func (f *execStoreFactory) GetStore() (etcdbackup.Store, error) { return f.store, nil }
+
+func (f *execStoreFactory) Start(ctx context.Context, state state.State, logger *zap.Logger) error {
+	var errString string
+
+	if _, err := exec.LookPath(f.command); err != nil {
+		logger.Error("etcd backup command is not available", zap.String("command", f.command), zap.Error(err))
+
+		errString = err.Error()
+	}
+
+	if err := setStatus(ctx, state, "exec", errString); err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+
+	return nil
+}
+
+func (f *execStoreFactory) Description() string { return fmt.Sprintf("exec store: %s", f.command) }
@@ -26,6 +26,7 @@ type factoryWithMetrics struct {
 	factory       Factory
 	uploads       *prometheus.CounterVec
 	downloads     *prometheus.CounterVec
+	removals      *prometheus.CounterVec
 	uploadBytes   prometheus.Counter
 	downloadBytes prometheus.Counter
 }
@@ -41,6 +42,10 @@ func newFactoryWithMetrics(factory Factory) FactoryWithMetrics {
 			Name: "omni_etcdbackup_store_downloads_total",
 			Help: "Number of etcd backup downloads",
 		}, []string{"status"}),
+		removals: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_etcdbackup_store_removals_total",
+			Help: "Number of etcd backup removals",
+		}, []string{"status"}),
 		uploadBytes: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "omni_etcdbackup_store_transferred_bytes_total",
 			Help: "Number of bytes transferred during etcd backup uploads",
@@ -59,6 +64,7 @@ func (s *factoryWithMetrics) Describe(ch chan<- *prometheus.Desc) {
 func (s *factoryWithMetrics) Collect(ch chan<- prometheus.Metric) {
 	s.uploads.Collect(ch)
 	s.downloads.Collect(ch)
+	s.removals.Collect(ch)
 	s.uploadBytes.Collect(ch)
 	s.downloadBytes.Collect(ch)
 }
@@ -126,6 +132,18 @@ func (s *storeWithMetrics) Download(ctx context.Context, encryptionKey []byte, c
 	return backupData, &metricsWrapper, err
 }
 
+func (s *storeWithMetrics) Remove(ctx context.Context, clusterUUID, snapshotName string) error {
+	if err := s.store.Remove(ctx, clusterUUID, snapshotName); err != nil {
+		s.metrics.removals.WithLabelValues("error").Inc()
+
+		return err
+	}
+
+	s.metrics.removals.WithLabelValues("success").Inc()
+
+	return nil
+}
+
 type readCloserWithMetrics struct {
 	readCloser io.ReadCloser
 	counter    prometheus.Counter
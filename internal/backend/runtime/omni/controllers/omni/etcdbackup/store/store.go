@@ -45,6 +45,8 @@ var newEtcdBackupStoreFactory = sync.OnceValues(func() (FactoryWithMetrics, erro
 		result = NewS3StoreFactory()
 	case config.EtcdBackupTypeFS:
 		result = NewFileStoreStoreFactory(config.Config.EtcdBackup.LocalPath)
+	case config.EtcdBackupTypeExec:
+		result = NewExecStoreFactory(config.Config.EtcdBackup.Command)
 	case config.EtcdBackupTypeNone:
 		result = DisabledStoreFactory
 	default:
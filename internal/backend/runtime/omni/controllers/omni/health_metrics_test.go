@@ -0,0 +1,151 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type HealthMetricsSuite struct {
+	OmniSuite
+}
+
+func (suite *HealthMetricsSuite) TestClusterMetrics() {
+	suite.startRuntime()
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, "prod")
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+	clusterStatus.TypedSpec().Value.Available = true
+	clusterStatus.TypedSpec().Value.KubernetesAPIReady = true
+
+	controlPlaneStatus := omni.NewControlPlaneStatus(resources.DefaultNamespace, "prod-control-planes")
+	controlPlaneStatus.Metadata().Labels().Set(omni.LabelCluster, "prod")
+	controlPlaneStatus.TypedSpec().Value.Conditions = []*specs.ControlPlaneStatusSpec_Condition{
+		{
+			Type:   specs.ConditionType_Etcd,
+			Status: specs.ControlPlaneStatusSpec_Condition_NotReady,
+		},
+	}
+
+	kubernetesUpgradeStatus := omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, "prod")
+	kubernetesUpgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, controlPlaneStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, kubernetesUpgradeStatus))
+
+	ctrl := &omnictrl.ClusterHealthMetricsController{}
+
+	suite.Require().NoError(suite.runtime.RegisterController(ctrl))
+
+	suite.EventuallyWithT(func(collect *assert.CollectT) {
+		assert.NoError(collect, testutil.CollectAndCompare(ctrl, strings.NewReader(`
+# HELP omni_cluster_control_plane_condition Status of the control plane condition, 1 for the current status and 0 for the others.
+# TYPE omni_cluster_control_plane_condition gauge
+omni_cluster_control_plane_condition{cluster="prod",condition="Etcd",machine_set="prod-control-planes",status="NotReady"} 1
+omni_cluster_control_plane_condition{cluster="prod",condition="Etcd",machine_set="prod-control-planes",status="Ready"} 0
+omni_cluster_control_plane_condition{cluster="prod",condition="Etcd",machine_set="prod-control-planes",status="Unknown"} 0
+# HELP omni_cluster_kubernetes_upgrade_phase Phase of the Kubernetes upgrade of the cluster, 1 for the current phase and 0 for the others.
+# TYPE omni_cluster_kubernetes_upgrade_phase gauge
+omni_cluster_kubernetes_upgrade_phase{cluster="prod",phase="Done"} 0
+omni_cluster_kubernetes_upgrade_phase{cluster="prod",phase="Failed"} 0
+omni_cluster_kubernetes_upgrade_phase{cluster="prod",phase="Reverting"} 0
+omni_cluster_kubernetes_upgrade_phase{cluster="prod",phase="Unknown"} 0
+omni_cluster_kubernetes_upgrade_phase{cluster="prod",phase="Upgrading"} 1
+# HELP omni_cluster_phase Phase of the cluster, 1 for the current phase and 0 for the others.
+# TYPE omni_cluster_phase gauge
+omni_cluster_phase{cluster="prod",phase="DESTROYING"} 0
+omni_cluster_phase{cluster="prod",phase="RUNNING"} 1
+omni_cluster_phase{cluster="prod",phase="SCALING_DOWN"} 0
+omni_cluster_phase{cluster="prod",phase="SCALING_UP"} 0
+omni_cluster_phase{cluster="prod",phase="UNKNOWN"} 0
+# HELP omni_cluster_ready Whether the cluster is ready.
+# TYPE omni_cluster_ready gauge
+omni_cluster_ready{cluster="prod"} 0
+# HELP omni_cluster_kubernetes_api_ready Whether the Kubernetes API of the cluster is ready.
+# TYPE omni_cluster_kubernetes_api_ready gauge
+omni_cluster_kubernetes_api_ready{cluster="prod"} 1
+`),
+			"omni_cluster_control_plane_condition",
+			"omni_cluster_kubernetes_upgrade_phase",
+			"omni_cluster_phase",
+			"omni_cluster_ready",
+			"omni_cluster_kubernetes_api_ready",
+		))
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func (suite *HealthMetricsSuite) TestMachineMetrics() {
+	suite.startRuntime()
+
+	clusterMachineStatus := omni.NewClusterMachineStatus(resources.DefaultNamespace, "machine-1")
+	clusterMachineStatus.Metadata().Labels().Set(omni.LabelCluster, "prod")
+	clusterMachineStatus.Metadata().Labels().Set(omni.LabelMachineSet, "prod-workers")
+	clusterMachineStatus.TypedSpec().Value.Stage = specs.ClusterMachineStatusSpec_RUNNING
+
+	machineStatusLink := omni.NewMachineStatusLink(resources.MetricsNamespace, "machine-1")
+	machineStatusLink.TypedSpec().Value.MessageStatus = &specs.MachineStatusSpec{Connected: true}
+	machineStatusLink.TypedSpec().Value.SiderolinkCounter = &specs.SiderolinkCounterSpec{
+		BytesReceived: 2048,
+		BytesSent:     1024,
+		LastAlive:     timestamppb.New(time.Unix(1257894000, 0)),
+	}
+
+	// machine which is not a part of a cluster
+	freeMachineStatusLink := omni.NewMachineStatusLink(resources.MetricsNamespace, "machine-2")
+	freeMachineStatusLink.TypedSpec().Value.MessageStatus = &specs.MachineStatusSpec{}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachineStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatusLink))
+	suite.Require().NoError(suite.state.Create(suite.ctx, freeMachineStatusLink))
+
+	ctrl := &omnictrl.MachineHealthMetricsController{}
+
+	suite.Require().NoError(suite.runtime.RegisterController(ctrl))
+
+	suite.EventuallyWithT(func(collect *assert.CollectT) {
+		assert.NoError(collect, testutil.CollectAndCompare(ctrl, strings.NewReader(`
+# HELP omni_machine_config_up_to_date Whether the configuration of the cluster machine is up to date.
+# TYPE omni_machine_config_up_to_date gauge
+omni_machine_config_up_to_date{cluster="prod",machine="machine-1",machine_set="prod-workers"} 0
+# HELP omni_machine_connected Whether the machine is connected.
+# TYPE omni_machine_connected gauge
+omni_machine_connected{cluster="prod",machine="machine-1",machine_set="prod-workers"} 1
+omni_machine_connected{cluster="",machine="machine-2",machine_set=""} 0
+# HELP omni_machine_siderolink_received_bytes_total Number of bytes received from the machine over SideroLink.
+# TYPE omni_machine_siderolink_received_bytes_total counter
+omni_machine_siderolink_received_bytes_total{cluster="prod",machine="machine-1",machine_set="prod-workers"} 2048
+# HELP omni_machine_siderolink_last_alive_timestamp_seconds Time of the last SideroLink handshake of the machine as a Unix timestamp.
+# TYPE omni_machine_siderolink_last_alive_timestamp_seconds gauge
+omni_machine_siderolink_last_alive_timestamp_seconds{cluster="prod",machine="machine-1",machine_set="prod-workers"} 1.257894e+09
+`),
+			"omni_machine_config_up_to_date",
+			"omni_machine_connected",
+			"omni_machine_siderolink_received_bytes_total",
+			"omni_machine_siderolink_last_alive_timestamp_seconds",
+		))
+
+		assert.Equal(collect, 10, testutil.CollectAndCount(ctrl, "omni_machine_stage"))
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestHealthMetricsSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(HealthMetricsSuite))
+}
@@ -9,18 +9,29 @@ package configpatch
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/siderolabs/gen/xslices"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 )
 
+// LibraryPatchPrefix is the prefix of the IDs of the config patches resolved from the config patch libraries.
+const LibraryPatchPrefix = "library-"
+
 // Helper provides a way to lookup config patches by machine/machine-set.
 type Helper struct {
 	allConfigPatches safe.List[*omni.ConfigPatch]
+	allLibraries     safe.List[*omni.ConfigPatchLibrary]
+	libraryVersions  map[resource.ID]*omni.ConfigPatchLibraryVersion
+	clusters         map[resource.ID]*omni.Cluster
 }
 
 // NewHelper creates a new config patch helper.
@@ -30,8 +41,38 @@ func NewHelper(ctx context.Context, r controller.Reader) (*Helper, error) {
 		return nil, err
 	}
 
+	allLibraries, err := safe.ReaderListAll[*omni.ConfigPatchLibrary](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	libraryVersions, err := safe.ReaderListAll[*omni.ConfigPatchLibraryVersion](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	libraryVersionsMap := make(map[resource.ID]*omni.ConfigPatchLibraryVersion, libraryVersions.Len())
+
+	libraryVersions.ForEach(func(version *omni.ConfigPatchLibraryVersion) {
+		libraryVersionsMap[version.Metadata().ID()] = version
+	})
+
+	clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	clustersMap := make(map[resource.ID]*omni.Cluster, clusters.Len())
+
+	clusters.ForEach(func(cluster *omni.Cluster) {
+		clustersMap[cluster.Metadata().ID()] = cluster
+	})
+
 	return &Helper{
 		allConfigPatches: allConfigPatches,
+		allLibraries:     allLibraries,
+		libraryVersions:  libraryVersionsMap,
+		clusters:         clustersMap,
 	}, nil
 }
 
@@ -69,9 +110,28 @@ func (h *Helper) Get(machine *omni.ClusterMachine, machineSet *omni.MachineSet)
 		}
 	}
 
-	patches := make([]*omni.ConfigPatch, 0, clusterPatchList.Len()+machinePatchList.Len())
+	var clusterLibraryRefs []*specs.ConfigPatchLibraryRef
+
+	if cluster, ok := h.clusters[clusterName]; ok {
+		clusterLibraryRefs = cluster.TypedSpec().Value.ConfigPatchLibraries
+	}
+
+	clusterLibraryPatches, err := h.resolveLibraries(clusterLibraryRefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve config patch libraries of the cluster %q: %w", clusterName, err)
+	}
+
+	machineSetLibraryPatches, err := h.resolveLibraries(machineSet.TypedSpec().Value.ConfigPatchLibraries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve config patch libraries of the machine set %q: %w", machineSet.Metadata().ID(), err)
+	}
 
+	patches := make([]*omni.ConfigPatch, 0, len(clusterLibraryPatches)+len(machineSetLibraryPatches)+clusterPatchList.Len()+machinePatchList.Len())
+
+	// the libraries are applied before the patches of the same level, so the patches can override them
+	patches = append(patches, clusterLibraryPatches...)
 	patches = append(patches, clusterPatches...)
+	patches = append(patches, machineSetLibraryPatches...)
 	patches = append(patches, machineSetPatches...)
 	patches = append(patches, clusterMachinePatches...)
 
@@ -85,3 +145,166 @@ func (h *Helper) Get(machine *omni.ClusterMachine, machineSet *omni.MachineSet)
 		return configPatch.Metadata().Phase() == resource.PhaseRunning
 	}), nil
 }
+
+// resolveLibraries converts the config patch libraries matching the refs to the config patches.
+//
+// The libraries are returned in the order of the refs, the libraries matched by the same selector are sorted by ID.
+// Each library is used at most once, the libraries referenced with the pinned version use the config patch of that version.
+func (h *Helper) resolveLibraries(refs []*specs.ConfigPatchLibraryRef) ([]*omni.ConfigPatch, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	var result []*omni.ConfigPatch
+
+	seen := map[resource.ID]struct{}{}
+
+	for _, ref := range refs {
+		if ref.Version != 0 {
+			if _, ok := seen[ref.Name]; ok {
+				continue
+			}
+
+			version, ok := h.libraryVersions[omni.ConfigPatchLibraryVersionID(ref.Name, ref.Version)]
+			if !ok {
+				return nil, fmt.Errorf("version %d of the config patch library %q doesn't exist", ref.Version, ref.Name)
+			}
+
+			seen[ref.Name] = struct{}{}
+
+			result = append(result, LibraryVersionPatch(ref.Name, version))
+
+			continue
+		}
+
+		libraries, err := h.matchLibraries(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, library := range libraries {
+			if _, ok := seen[library.Metadata().ID()]; ok {
+				continue
+			}
+
+			seen[library.Metadata().ID()] = struct{}{}
+
+			result = append(result, LibraryPatch(library))
+		}
+	}
+
+	return result, nil
+}
+
+func (h *Helper) matchLibraries(ref *specs.ConfigPatchLibraryRef) ([]*omni.ConfigPatchLibrary, error) {
+	selectors, err := labels.ParseSelectors(ref.MatchLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	var libraries []*omni.ConfigPatchLibrary
+
+	h.allLibraries.ForEach(func(library *omni.ConfigPatchLibrary) {
+		if library.Metadata().Phase() != resource.PhaseRunning {
+			return
+		}
+
+		if LibraryMatches(ref, selectors, library) {
+			libraries = append(libraries, library)
+		}
+	})
+
+	slices.SortFunc(libraries, func(a, b *omni.ConfigPatchLibrary) int {
+		return strings.Compare(a.Metadata().ID(), b.Metadata().ID())
+	})
+
+	return libraries, nil
+}
+
+// LibraryMatches checks if the config patch library is referenced by the ref, the selectors should be parsed from the ref match labels.
+func LibraryMatches(ref *specs.ConfigPatchLibraryRef, selectors []resource.LabelQuery, library *omni.ConfigPatchLibrary) bool {
+	if ref.Name != "" && ref.Name == library.Metadata().ID() {
+		return true
+	}
+
+	for _, selector := range selectors {
+		if selector.Matches(*library.Metadata().Labels()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LibraryPatch converts the config patch library to the config patch.
+//
+// The config patch keeps the version of the library, so the library updates are tracked as the config patch updates.
+func LibraryPatch(library *omni.ConfigPatchLibrary) *omni.ConfigPatch {
+	patch := omni.NewConfigPatch(resources.DefaultNamespace, LibraryPatchPrefix+library.Metadata().ID())
+
+	patch.Metadata().SetVersion(library.Metadata().Version())
+	patch.TypedSpec().Value.Data = library.TypedSpec().Value.Data
+
+	return patch
+}
+
+// LibraryVersionPatch converts the pinned version of the config patch library to the config patch.
+//
+// The config patch ID includes the version, so pinning the library to another version is tracked as the config patch change.
+func LibraryVersionPatch(library resource.ID, version *omni.ConfigPatchLibraryVersion) *omni.ConfigPatch {
+	patch := omni.NewConfigPatch(resources.DefaultNamespace, LibraryPatchPrefix+omni.ConfigPatchLibraryVersionID(library, version.TypedSpec().Value.Version))
+
+	patch.Metadata().SetVersion(version.Metadata().Version())
+	patch.TypedSpec().Value.Data = version.TypedSpec().Value.Data
+
+	return patch
+}
+
+// MapLibraryToMachineSets returns the machine sets referencing the config patch library directly or through their cluster.
+func MapLibraryToMachineSets(ctx context.Context, r controller.Reader, library *omni.ConfigPatchLibrary) ([]resource.Pointer, error) {
+	matches := func(refs []*specs.ConfigPatchLibraryRef) bool {
+		for _, ref := range refs {
+			// the refs are validated on create, so skip the invalid selectors
+			selectors, err := labels.ParseSelectors(ref.MatchLabels)
+			if err != nil {
+				continue
+			}
+
+			if LibraryMatches(ref, selectors, library) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	matchingClusters := map[resource.ID]struct{}{}
+
+	clusters.ForEach(func(cluster *omni.Cluster) {
+		if matches(cluster.TypedSpec().Value.ConfigPatchLibraries) {
+			matchingClusters[cluster.Metadata().ID()] = struct{}{}
+		}
+	})
+
+	machineSets, err := safe.ReaderListAll[*omni.MachineSet](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var pointers []resource.Pointer
+
+	machineSets.ForEach(func(machineSet *omni.MachineSet) {
+		clusterName, _ := machineSet.Metadata().Labels().Get(omni.LabelCluster)
+
+		if _, ok := matchingClusters[clusterName]; ok || matches(machineSet.TypedSpec().Value.ConfigPatchLibraries) {
+			pointers = append(pointers, machineSet.Metadata())
+		}
+	})
+
+	return pointers, nil
+}
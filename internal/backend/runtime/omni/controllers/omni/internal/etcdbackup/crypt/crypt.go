@@ -92,3 +92,8 @@ func (c *Store) Download(ctx context.Context, encryptionKey []byte, clusterUUID,
 func (c *Store) ListBackups(ctx context.Context, uuid string) (etcdbackup.InfoIterator, error) {
 	return c.wrapped.ListBackups(ctx, uuid)
 }
+
+// Remove removes the backup from the wrapped store. Implements [Store].
+func (c *Store) Remove(ctx context.Context, clusterUUID, snapshotName string) error {
+	return c.wrapped.Remove(ctx, clusterUUID, snapshotName)
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package execstore implements [etcdbackup.Store] which delegates storing backups to an external command.
+//
+// The command is called with one of the following sets of arguments:
+//
+//	<command> upload <cluster-uuid> <snapshot-name>   - snapshot data is passed on stdin
+//	<command> download <cluster-uuid> <snapshot-name> - snapshot data is expected on stdout
+//	<command> list <cluster-uuid>                     - one "<snapshot-name> <size>" line per backup is expected on stdout
+//	<command> remove <cluster-uuid> <snapshot-name>
+//
+// Any non-zero exit code is treated as an error, the command stderr is included in the error message.
+package execstore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+)
+
+const maxStderrSize = 4096
+
+// Store stores etcd backups using an external command.
+type Store struct {
+	command string
+}
+
+// NewStore initializes [Store].
+func NewStore(command string) *Store {
+	if command == "" {
+		panic(errors.New("command must be specified"))
+	}
+
+	return &Store{command: command}
+}
+
+// Upload passes the data from [io.Reader] to the command stdin. Implements [etcdbackup.Store].
+func (s *Store) Upload(ctx context.Context, descr etcdbackup.Description, r io.Reader) error {
+	cmd := s.cmd(ctx, "upload", descr.ClusterUUID, etcdbackup.CreateSnapshotName(descr.Timestamp))
+
+	var stderr limitedBuffer
+
+	cmd.Stdin = r
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return commandError("upload", err, &stderr)
+	}
+
+	return nil
+}
+
+// Download returns a reader for the command stdout. Implements [etcdbackup.Store].
+//
+// The returned [io.ReadCloser] waits for the command to exit on Close and returns an error if the command failed.
+func (s *Store) Download(ctx context.Context, _ []byte, clusterUUID, snapshotName string) (etcdbackup.BackupData, io.ReadCloser, error) {
+	readCloser, err := s.download(ctx, clusterUUID, snapshotName)
+
+	return etcdbackup.BackupData{}, readCloser, err
+}
+
+// Remove removes the backup using the command. Implements [etcdbackup.Store].
+func (s *Store) Remove(ctx context.Context, clusterUUID, snapshotName string) error {
+	cmd := s.cmd(ctx, "remove", clusterUUID, snapshotName)
+
+	var stderr limitedBuffer
+
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return commandError("remove", err, &stderr)
+	}
+
+	return nil
+}
+
+// ListBackups returns a list of backups. Implements [etcdbackup.Store].
+func (s *Store) ListBackups(ctx context.Context, clusterUUID string) (etcdbackup.InfoIterator, error) {
+	cmd := s.cmd(ctx, "list", clusterUUID)
+
+	var (
+		stdout bytes.Buffer
+		stderr limitedBuffer
+	)
+
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, commandError("list", err, &stderr)
+	}
+
+	scanner := bufio.NewScanner(&stdout)
+
+	return func() (etcdbackup.Info, bool, error) {
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+
+			snapshotName, sizeRaw, _ := strings.Cut(line, " ")
+
+			timestamp, err := etcdbackup.ParseSnapshotName(snapshotName)
+			if err != nil {
+				return etcdbackup.Info{}, true, err
+			}
+
+			var size int64
+
+			if sizeRaw = strings.TrimSpace(sizeRaw); sizeRaw != "" {
+				size, err = strconv.ParseInt(sizeRaw, 10, 64)
+				if err != nil {
+					return etcdbackup.Info{}, true, fmt.Errorf("failed to parse size of snapshot %q: %w", snapshotName, err)
+				}
+			}
+
+			return etcdbackup.Info{
+				Snapshot:  snapshotName,
+				Timestamp: timestamp,
+				Reader:    func() (io.ReadCloser, error) { return s.download(ctx, clusterUUID, snapshotName) },
+				Size:      size,
+			}, true, nil
+		}
+
+		if err := scanner.Err(); err != nil {
+			return etcdbackup.Info{}, true, fmt.Errorf("failed to read command output: %w", err)
+		}
+
+		return etcdbackup.Info{}, false, nil
+	}, nil
+}
+
+func (s *Store) download(ctx context.Context, clusterUUID, snapshotName string) (io.ReadCloser, error) {
+	cmd := s.cmd(ctx, "download", clusterUUID, snapshotName)
+
+	stderr := &limitedBuffer{}
+
+	cmd.Stderr = stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stdout pipe: %w", err)
+	}
+
+	if err = cmd.Start(); err != nil {
+		return nil, commandError("download", err, stderr)
+	}
+
+	return &commandReader{
+		ReadCloser: stdout,
+		cmd:        cmd,
+		stderr:     stderr,
+	}, nil
+}
+
+func (s *Store) cmd(ctx context.Context, args ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, s.command, args...)
+}
+
+type commandReader struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	stderr *limitedBuffer
+}
+
+// Close closes the stdout pipe and waits for the command to exit.
+func (r *commandReader) Close() error {
+	// drain the rest of the output, so that the command doesn't get SIGPIPE when the reader is closed early
+	io.Copy(io.Discard, r.ReadCloser) //nolint:errcheck
+
+	if err := r.cmd.Wait(); err != nil {
+		return commandError("download", err, r.stderr)
+	}
+
+	return nil
+}
+
+func commandError(op string, err error, stderr *limitedBuffer) error {
+	if output := strings.TrimSpace(stderr.String()); output != "" {
+		return fmt.Errorf("%s command failed: %w: %s", op, err, output)
+	}
+
+	return fmt.Errorf("%s command failed: %w", op, err)
+}
+
+// limitedBuffer keeps only the first maxStderrSize bytes written to it.
+type limitedBuffer struct {
+	bytes.Buffer
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := maxStderrSize - b.Len(); remaining > 0 {
+		b.Buffer.Write(p[:min(len(p), remaining)])
+	}
+
+	return len(p), nil
+}
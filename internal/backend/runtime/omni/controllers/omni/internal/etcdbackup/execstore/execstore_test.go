@@ -0,0 +1,121 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+//go:build unix
+
+package execstore_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/execstore"
+)
+
+const script = `#!/bin/sh
+set -e
+
+dir=%q
+
+case "$1" in
+upload)
+  mkdir -p "$dir/$2"
+  cat > "$dir/$2/$3"
+  ;;
+download)
+  cat "$dir/$2/$3"
+  ;;
+remove)
+  rm "$dir/$2/$3"
+  ;;
+list)
+  [ -d "$dir/$2" ] || exit 0
+  for f in "$dir/$2"/*; do
+    [ -e "$f" ] || continue
+    echo "$(basename "$f") $(wc -c < "$f" | tr -d ' ')"
+  done
+  ;;
+*)
+  echo "unknown command $1" >&2
+  exit 1
+  ;;
+esac
+`
+
+func TestStore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	tempDir := t.TempDir()
+	command := filepath.Join(tempDir, "store.sh")
+
+	require.NoError(t, os.WriteFile(command, []byte(fmt.Sprintf(script, filepath.Join(tempDir, "data"))), 0o755))
+
+	store := execstore.NewStore(command)
+
+	timestamp := time.Unix(1700000000, 0)
+
+	require.NoError(t, store.Upload(ctx, etcdbackup.Description{
+		Timestamp:   timestamp,
+		ClusterUUID: "cluster-uuid",
+	}, strings.NewReader("Hello World")))
+
+	it, err := store.ListBackups(ctx, "cluster-uuid")
+	require.NoError(t, err)
+
+	info, ok, err := it()
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.Equal(t, etcdbackup.CreateSnapshotName(timestamp), info.Snapshot)
+	require.True(t, timestamp.Equal(info.Timestamp))
+	require.EqualValues(t, len("Hello World"), info.Size)
+
+	_, ok, err = it()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, rdr, err := store.Download(ctx, nil, "cluster-uuid", info.Snapshot)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(rdr)
+	require.NoError(t, err)
+	require.NoError(t, rdr.Close())
+
+	require.Equal(t, "Hello World", string(data))
+
+	_, rdr, err = store.Download(ctx, nil, "cluster-uuid", "missing.snapshot")
+	require.NoError(t, err)
+
+	_, err = io.ReadAll(rdr)
+	require.NoError(t, err)
+	require.ErrorContains(t, rdr.Close(), "download command failed")
+
+	require.NoError(t, store.Remove(ctx, "cluster-uuid", info.Snapshot))
+	require.ErrorContains(t, store.Remove(ctx, "cluster-uuid", info.Snapshot), "remove command failed")
+
+	it, err = store.ListBackups(ctx, "cluster-uuid")
+	require.NoError(t, err)
+
+	_, ok, err = it()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	it, err = store.ListBackups(ctx, "other-cluster-uuid")
+	require.NoError(t, err)
+
+	_, ok, err = it()
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -38,6 +38,15 @@ func (store *FileStore) Download(_ context.Context, _ []byte, clusterUUID, snaps
 	return etcdbackup.BackupData{}, readCloser, err
 }
 
+// Remove removes the backup file. Implements [etcdbackup.Store].
+func (store *FileStore) Remove(_ context.Context, clusterUUID, snapshotName string) error {
+	if err := os.Remove(filepath.Join(store.dir, clusterUUID, snapshotName)); err != nil {
+		return fmt.Errorf("failed to remove file: %w", err)
+	}
+
+	return nil
+}
+
 // ListBackups returns a list of backups. Implements [Store].
 func (store *FileStore) ListBackups(_ context.Context, uuid string) (etcdbackup.InfoIterator, error) {
 	storeAbsDir, err := filepath.Abs(store.dir)
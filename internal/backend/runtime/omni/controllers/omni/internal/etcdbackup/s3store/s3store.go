@@ -66,6 +66,19 @@ func (s *Store) Download(ctx context.Context, _ []byte, clusterUUID, snapshotNam
 	return etcdbackup.BackupData{}, result.Body, nil
 }
 
+// Remove removes the backup with the specified name. Implements [Store].
+func (s *Store) Remove(ctx context.Context, clusterUUID, snapshotName string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: pointer.To(s.bucket),
+		Key:    pointer.To(path.Join(clusterUUID, snapshotName)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
 // ListBackups returns a list of backups. Implements [EtcdBackupStore].
 func (s *Store) ListBackups(ctx context.Context, clusterUUID string) (etcdbackup.InfoIterator, error) {
 	result, err := s.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
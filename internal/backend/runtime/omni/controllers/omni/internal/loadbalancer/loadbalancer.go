@@ -7,7 +7,6 @@
 package loadbalancer
 
 import (
-	"github.com/siderolabs/go-loadbalancer/controlplane"
 	"github.com/siderolabs/go-loadbalancer/upstream"
 	"go.uber.org/zap"
 
@@ -19,23 +18,30 @@ type LoadBalancer interface {
 	Start(upstreamCh <-chan []string) error
 	Shutdown() error
 	Healthy() (bool, error)
+	UpstreamStatuses() []UpstreamStatus
 }
 
 // NewFunc is a function type whose implementation should create a new load balancer.
-type NewFunc func(bindAddress string, bindPort int, logger *zap.Logger) (LoadBalancer, error)
+//
+// The sniRouter is set if the load balancer should get the connections from it instead of listening on its own.
+type NewFunc func(spec Spec, sniRouter *SNIRouter, logger *zap.Logger) (LoadBalancer, error)
 
 // DefaultNew returns a new load balancer with default settings.
-func DefaultNew(bindAddress string, bindPort int, logger *zap.Logger) (LoadBalancer, error) { //nolint:ireturn
-	return controlplane.NewLoadBalancer(
-		bindAddress,
-		bindPort,
-		logger.WithOptions(zap.IncreaseLevel(zap.ErrorLevel)), // silence the load balancer logs
-		controlplane.WithDialTimeout(config.Config.LoadBalancer.DialTimeout),
-		controlplane.WithKeepAlivePeriod(config.Config.LoadBalancer.KeepAlivePeriod),
-		controlplane.WithTCPUserTimeout(config.Config.LoadBalancer.TCPUserTimeout),
-		controlplane.WithHealthCheckOptions(
-			upstream.WithHealthcheckInterval(config.Config.LoadBalancer.HealthCheckInterval),
-			upstream.WithHealthcheckTimeout(config.Config.LoadBalancer.HealthCheckTimeout),
-		),
+func DefaultNew(spec Spec, sniRouter *SNIRouter, logger *zap.Logger) (LoadBalancer, error) { //nolint:ireturn
+	return NewTCP(
+		spec.BindAddress,
+		spec.BindPort,
+		logger,
+		TCPOptions{
+			SNIRouter:       sniRouter,
+			SNIHostname:     spec.SNIHostname,
+			DialTimeout:     config.Config.LoadBalancer.DialTimeout,
+			KeepAlivePeriod: config.Config.LoadBalancer.KeepAlivePeriod,
+			TCPUserTimeout:  config.Config.LoadBalancer.TCPUserTimeout,
+			HealthCheckOptions: []upstream.ListOption{
+				upstream.WithHealthcheckInterval(config.Config.LoadBalancer.HealthCheckInterval),
+				upstream.WithHealthcheckTimeout(config.Config.LoadBalancer.HealthCheckTimeout),
+			},
+		},
 	)
 }
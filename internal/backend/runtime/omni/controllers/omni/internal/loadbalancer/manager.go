@@ -8,9 +8,10 @@ package loadbalancer
 import (
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 
 	"github.com/siderolabs/gen/maps"
-	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 )
@@ -20,39 +21,56 @@ type ID = string
 
 // Manager manages running loadbalancers.
 type Manager struct {
-	running map[ID]wrapper
-	logger  *zap.Logger
-	newFunc NewFunc
+	running    map[ID]wrapper
+	sniRouters map[string]*SNIRouter
+	logger     *zap.Logger
+	newFunc    NewFunc
 }
 
 // Spec configures a loadbalancer.
 type Spec struct {
 	BindAddress string
-	BindPort    int
+
+	// SNIHostname is set when the loadbalancer shares the bind port with the other loadbalancers,
+	// the connections are routed to it by the TLS SNI.
+	SNIHostname string
+
+	BindPort int
+}
+
+// Status is the status of a loadbalancer.
+type Status struct {
+	Upstreams []UpstreamStatus
+	Healthy   bool
 }
 
 // wrapper wraps a loadbalancer and its upstream channel.
 type wrapper struct {
-	lb          LoadBalancer
-	upstreamCh  chan []string
-	spec        Spec
-	lastHealthy optional.Optional[bool]
+	lb         LoadBalancer
+	upstreamCh chan []string
+	spec       Spec
 }
 
 // NewManager returns a new loadbalancer manager.
 func NewManager(logger *zap.Logger, newFunc NewFunc) *Manager {
 	return &Manager{
-		running: make(map[ID]wrapper),
-		logger:  logger,
-		newFunc: newFunc,
+		running:    make(map[ID]wrapper),
+		sniRouters: make(map[string]*SNIRouter),
+		logger:     logger,
+		newFunc:    newFunc,
 	}
 }
 
 // Stop stops all running loadbalancers.
 func (m *Manager) Stop() error {
-	return errors.Join(xslices.Map(maps.Values(m.running), func(w wrapper) error {
-		return w.lb.Shutdown()
-	})...)
+	return errors.Join(
+		errors.Join(xslices.Map(maps.Values(m.running), func(w wrapper) error {
+			return w.lb.Shutdown()
+		})...),
+		errors.Join(xslices.Map(maps.Values(m.sniRouters), func(router *SNIRouter) error {
+			return router.Shutdown()
+		})...),
+	)
 }
 
 // Reconcile by starting/stopping/replacing loadbalancer as needed.
@@ -76,6 +94,11 @@ func (m *Manager) Reconcile(specs map[ID]Spec) error {
 		}
 	}
 
+	// stop the SNI routers before starting the loadbalancers, so that their ports are released
+	if err := m.stopIdleSNIRouters(); err != nil {
+		allErrors = append(allErrors, err)
+	}
+
 	// start all loadbalancers that should be running
 	for id, spec := range specs {
 		if _, running := m.running[id]; !running {
@@ -86,7 +109,20 @@ func (m *Manager) Reconcile(specs map[ID]Spec) error {
 				upstreamCh: make(chan []string),
 			}
 
-			lb, err := m.newFunc(spec.BindAddress, spec.BindPort, m.logger)
+			var sniRouter *SNIRouter
+
+			if spec.SNIHostname != "" {
+				var err error
+
+				sniRouter, err = m.getSNIRouter(spec)
+				if err != nil {
+					allErrors = append(allErrors, fmt.Errorf("error starting SNI router for loadbalancer %s: %w", id, err))
+
+					continue
+				}
+			}
+
+			lb, err := m.newFunc(spec, sniRouter, m.logger)
 			if err != nil {
 				allErrors = append(allErrors, fmt.Errorf("error creating loadbalancer %s: %w", id, err))
 
@@ -104,12 +140,71 @@ func (m *Manager) Reconcile(specs map[ID]Spec) error {
 		}
 	}
 
+	// stop the SNI routers left without loadbalancers if the loadbalancers failed to start
+	if err := m.stopIdleSNIRouters(); err != nil {
+		allErrors = append(allErrors, err)
+	}
+
+	return errors.Join(allErrors...)
+}
+
+// getSNIRouter returns the running SNI router for the spec bind address, starting it if needed.
+func (m *Manager) getSNIRouter(spec Spec) (*SNIRouter, error) {
+	endpoint := sniRouterEndpoint(spec)
+
+	if router, ok := m.sniRouters[endpoint]; ok {
+		return router, nil
+	}
+
+	m.logger.Debug("starting SNI router", zap.String("endpoint", endpoint))
+
+	router := NewSNIRouter(spec.BindAddress, spec.BindPort, m.logger)
+
+	if err := router.Start(); err != nil {
+		return nil, err
+	}
+
+	m.sniRouters[endpoint] = router
+
+	return router, nil
+}
+
+// stopIdleSNIRouters stops the SNI routers which are not used by any running loadbalancer.
+func (m *Manager) stopIdleSNIRouters() error {
+	inUse := map[string]struct{}{}
+
+	for _, w := range m.running {
+		if w.spec.SNIHostname != "" {
+			inUse[sniRouterEndpoint(w.spec)] = struct{}{}
+		}
+	}
+
+	var allErrors []error
+
+	for endpoint, router := range m.sniRouters {
+		if _, ok := inUse[endpoint]; ok {
+			continue
+		}
+
+		m.logger.Debug("stopping SNI router", zap.String("endpoint", endpoint))
+
+		if err := router.Shutdown(); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("error stopping SNI router %s: %w", endpoint, err))
+		}
+
+		delete(m.sniRouters, endpoint)
+	}
+
 	return errors.Join(allErrors...)
 }
 
-// GetHealthStatus compiles the health status of all loadbalancers, returning the diff with previous reported status.
-func (m *Manager) GetHealthStatus() map[ID]bool {
-	status := map[ID]bool{}
+func sniRouterEndpoint(spec Spec) string {
+	return net.JoinHostPort(spec.BindAddress, strconv.Itoa(spec.BindPort))
+}
+
+// GetStatus compiles the health status and the upstream stats of all loadbalancers.
+func (m *Manager) GetStatus() map[ID]Status {
+	status := make(map[ID]Status, len(m.running))
 
 	for id, w := range m.running {
 		healthy, err := w.lb.Healthy()
@@ -119,12 +214,10 @@ func (m *Manager) GetHealthStatus() map[ID]bool {
 			healthy = false
 		}
 
-		previousHealthy, exists := w.lastHealthy.Get()
-		if !exists || healthy != previousHealthy {
-			status[id] = healthy
+		status[id] = Status{
+			Healthy:   healthy,
+			Upstreams: w.lb.UpstreamStatuses(),
 		}
-
-		w.lastHealthy = optional.Some(healthy)
 	}
 
 	return status
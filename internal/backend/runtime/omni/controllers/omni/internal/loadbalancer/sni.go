@@ -0,0 +1,112 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/siderolabs/tcpproxy"
+	"go.uber.org/zap"
+)
+
+// SNIRouter serves the load balancers which share a single port, routing the connections to them by the TLS SNI.
+//
+// The connections are passed through as is, TLS is terminated by the upstreams.
+//
+// The clients must send the load balancer hostname as the SNI, the connections without it are closed:
+// the clients which connect by the IP address and the clients connecting through the proxies which rewrite or drop the SNI
+// (e.g. KubePrism, which is dialed as 'localhost') can't be routed.
+// As the listener is shared, a plain TCP check of the port tells nothing about the health of a particular load balancer.
+type SNIRouter struct {
+	proxy  tcpproxy.Proxy
+	logger *zap.Logger
+	routes map[string]tcpproxy.Target
+
+	endpoint string
+
+	routesMu sync.Mutex
+}
+
+// NewSNIRouter initializes the SNI router.
+func NewSNIRouter(bindAddress string, bindPort int, logger *zap.Logger) *SNIRouter {
+	router := &SNIRouter{
+		endpoint: net.JoinHostPort(bindAddress, strconv.Itoa(bindPort)),
+		routes:   map[string]tcpproxy.Target{},
+	}
+
+	router.logger = logger.With(zap.String("endpoint", router.endpoint))
+
+	router.proxy.AddSNIRouteFunc(router.endpoint, router.route)
+
+	// the connections which don't match any route are closed by the router itself
+	router.proxy.AddRoute(router.endpoint, router)
+
+	return router
+}
+
+// Start the router listener.
+func (router *SNIRouter) Start() error {
+	return router.proxy.Start()
+}
+
+// Shutdown the router listener.
+func (router *SNIRouter) Shutdown() error {
+	if err := router.proxy.Close(); err != nil {
+		return err
+	}
+
+	router.proxy.Wait() //nolint:errcheck
+
+	return nil
+}
+
+// AddRoute routes the connections with the given SNI hostname to the target.
+func (router *SNIRouter) AddRoute(hostname string, target tcpproxy.Target) error {
+	router.routesMu.Lock()
+	defer router.routesMu.Unlock()
+
+	hostname = strings.ToLower(hostname)
+
+	if _, ok := router.routes[hostname]; ok {
+		return fmt.Errorf("route for the hostname %q already exists", hostname)
+	}
+
+	router.routes[hostname] = target
+
+	return nil
+}
+
+// RemoveRoute removes the route of the SNI hostname.
+func (router *SNIRouter) RemoveRoute(hostname string) {
+	router.routesMu.Lock()
+	defer router.routesMu.Unlock()
+
+	delete(router.routes, strings.ToLower(hostname))
+}
+
+func (router *SNIRouter) route(_ context.Context, hostname string) (tcpproxy.Target, bool) {
+	router.routesMu.Lock()
+	defer router.routesMu.Unlock()
+
+	target, ok := router.routes[strings.ToLower(hostname)]
+
+	return target, ok
+}
+
+// HandleConn implements tcpproxy.Target interface.
+//
+// It handles the connections which don't match any route: the connections with an unknown SNI hostname,
+// and the ones without the SNI at all, as there is no way to tell which load balancer they are meant for.
+func (router *SNIRouter) HandleConn(conn net.Conn) {
+	router.logger.Debug("no load balancer found for the connection SNI or no SNI sent, closing it", zap.String("remote_addr", conn.RemoteAddr().String()))
+
+	conn.Close() //nolint:errcheck
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer_test
+
+import (
+	"crypto/tls"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/loadbalancer"
+)
+
+// startAcceptServer starts a server which reports its address on each accepted connection which sends any data.
+//
+// The health check connections don't send anything, so they are not reported.
+func startAcceptServer(t *testing.T, acceptedCh chan<- string) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { l.Close() }) //nolint:errcheck
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close() //nolint:errcheck
+
+				if _, err := conn.Read(make([]byte, 1)); err == nil {
+					acceptedCh <- l.Addr().String()
+				}
+			}()
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func TestSNIRouter(t *testing.T) {
+	acceptedCh := make(chan string, 10)
+
+	upstreams := map[string]string{
+		"cluster-a.lb.test": startAcceptServer(t, acceptedCh),
+		"cluster-b.lb.test": startAcceptServer(t, acceptedCh),
+	}
+
+	port := findListenPort(t)
+	logger := zaptest.NewLogger(t)
+
+	router := loadbalancer.NewSNIRouter("127.0.0.1", port, logger)
+	require.NoError(t, router.Start())
+
+	t.Cleanup(func() { require.NoError(t, router.Shutdown()) })
+
+	for hostname, upstream := range upstreams {
+		lb, err := loadbalancer.NewTCP("127.0.0.1", port, logger, loadbalancer.TCPOptions{
+			SNIRouter:   router,
+			SNIHostname: hostname,
+			DialTimeout: time.Second,
+		})
+		require.NoError(t, err)
+
+		upstreamCh := make(chan []string)
+
+		require.NoError(t, lb.Start(upstreamCh))
+
+		t.Cleanup(func() { require.NoError(t, lb.Shutdown()) })
+
+		upstreamCh <- []string{upstream}
+
+		require.EventuallyWithT(t, func(collect *assert.CollectT) {
+			assert.Len(collect, lb.UpstreamStatuses(), 1)
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	dial := func(hostname string) {
+		conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		require.NoError(t, err)
+
+		defer conn.Close() //nolint:errcheck
+
+		require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+		// the handshake fails as the upstream is not a TLS server, but the ClientHello is routed by the SNI
+		tls.Client(conn, &tls.Config{ServerName: hostname}).Handshake() //nolint:errcheck,gosec
+	}
+
+	for hostname, upstream := range upstreams {
+		dial(hostname)
+
+		select {
+		case accepted := <-acceptedCh:
+			assert.Equal(t, upstream, accepted, hostname)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timeout", hostname)
+		}
+	}
+
+	// the connections with the unknown SNI are not routed anywhere
+	dial("unknown.lb.test")
+
+	select {
+	case accepted := <-acceptedCh:
+		require.FailNow(t, "unexpected connection", accepted)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,376 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-loadbalancer/upstream"
+	"github.com/siderolabs/tcpproxy"
+	"go.uber.org/zap"
+)
+
+// UpstreamStatus is the health and the traffic stats of a single load balancer upstream.
+type UpstreamStatus struct {
+	Healthy           optional.Optional[bool]
+	Address           string
+	ActiveConnections uint32
+	BytesSent         uint64
+	BytesReceived     uint64
+	DialErrors        uint64
+}
+
+// TCPOptions configures the TCP load balancer.
+type TCPOptions struct {
+	// SNIRouter is set when the load balancer shares the port with the other load balancers,
+	// the load balancer gets the connections with the SNIHostname from the router instead of listening on its own.
+	SNIRouter   *SNIRouter
+	SNIHostname string
+
+	HealthCheckOptions []upstream.ListOption
+	DialTimeout        time.Duration
+	KeepAlivePeriod    time.Duration
+	TCPUserTimeout     time.Duration
+}
+
+// TCP is a Kubernetes control plane TCP load balancer which keeps track of the health and the traffic of each upstream.
+type TCP struct {
+	proxy  tcpproxy.Proxy
+	list   *upstream.List[*node]
+	logger *zap.Logger
+	done   chan struct{}
+	nodes  map[string]*node
+
+	endpoint string
+	options  TCPOptions
+
+	nodesMu sync.Mutex
+}
+
+// NewTCP initializes the TCP load balancer.
+func NewTCP(bindAddress string, bindPort int, logger *zap.Logger, options TCPOptions) (*TCP, error) {
+	lb := &TCP{
+		endpoint: net.JoinHostPort(bindAddress, strconv.Itoa(bindPort)),
+		options:  options,
+		nodes:    map[string]*node{},
+	}
+
+	lb.logger = logger.With(zap.String("endpoint", lb.endpoint))
+
+	var err error
+
+	// the latency tiers are the same as in the upstream load balancer implementation
+	lb.list, err = upstream.NewListWithCmp(nil, func(a, b *node) bool {
+		return a.address == b.address
+	}, append([]upstream.ListOption{upstream.WithTiers(0, upstream.Tier(len(tierMins)-1), 1)}, options.HealthCheckOptions...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.SNIRouter != nil {
+		lb.logger = logger.With(zap.String("sni", options.SNIHostname))
+
+		return lb, nil
+	}
+
+	lb.proxy.AddRoute(lb.endpoint, lb)
+
+	return lb, nil
+}
+
+// Start the load balancer, the upstreams are updated from the channel.
+func (lb *TCP) Start(upstreamCh <-chan []string) error {
+	if err := lb.listen(); err != nil {
+		lb.list.Shutdown()
+
+		return err
+	}
+
+	lb.done = make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case upstreams := <-upstreamCh:
+				lb.reconcileUpstreams(upstreams)
+			case <-lb.done:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Shutdown the load balancer and stop the health checks.
+func (lb *TCP) Shutdown() error {
+	if lb.options.SNIRouter != nil {
+		lb.options.SNIRouter.RemoveRoute(lb.options.SNIHostname)
+	} else {
+		if err := lb.proxy.Close(); err != nil {
+			return err
+		}
+
+		lb.proxy.Wait() //nolint:errcheck
+	}
+
+	close(lb.done)
+
+	lb.list.Shutdown()
+
+	return nil
+}
+
+func (lb *TCP) listen() error {
+	if lb.options.SNIRouter != nil {
+		return lb.options.SNIRouter.AddRoute(lb.options.SNIHostname, lb)
+	}
+
+	return lb.proxy.Start()
+}
+
+// Healthy returns true if at least one upstream is available.
+func (lb *TCP) Healthy() (bool, error) {
+	if _, err := lb.list.Pick(); err != nil {
+		if errors.Is(err, upstream.ErrNoUpstreams) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UpstreamStatuses returns the status of each upstream sorted by the address.
+func (lb *TCP) UpstreamStatuses() []UpstreamStatus {
+	lb.nodesMu.Lock()
+	statuses := xslices.Map(maps.Values(lb.nodes), func(n *node) UpstreamStatus { return n.status() })
+	lb.nodesMu.Unlock()
+
+	slices.SortFunc(statuses, func(a, b UpstreamStatus) int {
+		return cmp.Compare(a.Address, b.Address)
+	})
+
+	return statuses
+}
+
+func (lb *TCP) reconcileUpstreams(addresses []string) {
+	lb.nodesMu.Lock()
+	defer lb.nodesMu.Unlock()
+
+	nodes := make(map[string]*node, len(addresses))
+
+	for _, address := range addresses {
+		if n, ok := lb.nodes[address]; ok {
+			nodes[address] = n
+
+			continue
+		}
+
+		nodes[address] = &node{
+			address: address,
+			logger:  lb.logger,
+		}
+	}
+
+	lb.nodes = nodes
+
+	// the list keeps the existing nodes, so the stats of the upstreams which are kept are preserved
+	lb.list.Reconcile(xslices.Map(addresses, func(address string) *node { return nodes[address] }))
+}
+
+// HandleConn implements tcpproxy.Target interface.
+func (lb *TCP) HandleConn(conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+
+	n, err := lb.list.Pick()
+	if err != nil {
+		lb.logger.Warn("no upstreams available, closing connection", zap.String("remote_addr", conn.RemoteAddr().String()))
+
+		return
+	}
+
+	dialer := net.Dialer{
+		Timeout:   lb.options.DialTimeout,
+		KeepAlive: lb.options.KeepAlivePeriod,
+	}
+
+	upstreamConn, err := dialer.Dial("tcp", n.address)
+	if err != nil {
+		lb.logger.Warn("error dialing upstream", zap.String("upstream_addr", n.address), zap.Error(err))
+
+		n.dialErrors.Add(1)
+		n.setHealthy(false)
+
+		lb.list.Down(n)
+
+		return
+	}
+
+	defer upstreamConn.Close() //nolint:errcheck
+
+	n.activeConnections.Add(1)
+	defer n.activeConnections.Add(^uint32(0))
+
+	if tcpConn, ok := tcpproxy.UnderlyingConn(conn).(*net.TCPConn); ok && lb.options.KeepAlivePeriod > 0 {
+		tcpConn.SetKeepAlive(true)                             //nolint:errcheck
+		tcpConn.SetKeepAlivePeriod(lb.options.KeepAlivePeriod) //nolint:errcheck
+	}
+
+	if lb.options.TCPUserTimeout > 0 {
+		tcpproxy.SetTCPUserTimeout(tcpproxy.UnderlyingConn(conn), lb.options.TCPUserTimeout) //nolint:errcheck
+		tcpproxy.SetTCPUserTimeout(upstreamConn, lb.options.TCPUserTimeout)                  //nolint:errcheck
+	}
+
+	errCh := make(chan error, 2)
+
+	go proxyCopy(errCh, upstreamConn, conn, &n.bytesSent)
+	go proxyCopy(errCh, conn, upstreamConn, &n.bytesReceived)
+
+	for range 2 {
+		if err = <-errCh; err != nil {
+			return
+		}
+	}
+}
+
+// proxyCopy copies the data from src to dst, counting the bytes copied.
+func proxyCopy(errCh chan<- error, dst, src net.Conn, counter *atomic.Uint64) {
+	_, err := io.Copy(dst, &countingReader{reader: src, counter: counter})
+
+	if tcpConn, ok := tcpproxy.UnderlyingConn(dst).(*net.TCPConn); ok {
+		tcpConn.CloseWrite() //nolint:errcheck
+	}
+
+	if tcpConn, ok := tcpproxy.UnderlyingConn(src).(*net.TCPConn); ok {
+		tcpConn.CloseRead() //nolint:errcheck
+	}
+
+	errCh <- err
+}
+
+type countingReader struct {
+	reader  io.Reader
+	counter *atomic.Uint64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+
+	r.counter.Add(uint64(n))
+
+	return n, err
+}
+
+// node is a load balancer upstream.
+type node struct {
+	logger  *zap.Logger
+	healthy optional.Optional[bool]
+	address string // host:port
+
+	bytesSent         atomic.Uint64
+	bytesReceived     atomic.Uint64
+	dialErrors        atomic.Uint64
+	activeConnections atomic.Uint32
+
+	healthyMu sync.Mutex
+}
+
+// HealthCheck implements upstream.Backend interface.
+func (n *node) HealthCheck(ctx context.Context) (upstream.Tier, error) {
+	start := time.Now()
+	err := n.healthCheck(ctx)
+	elapsed := time.Since(start)
+
+	n.setHealthy(err == nil)
+
+	if err != nil {
+		// preserve the old tier
+		return -1, err
+	}
+
+	for i := len(tierMins) - 1; i >= 0; i-- {
+		if elapsed >= tierMins[i] {
+			return upstream.Tier(i), nil
+		}
+	}
+
+	return upstream.Tier(len(tierMins)), nil
+}
+
+func (n *node) healthCheck(ctx context.Context) error {
+	dialer := net.Dialer{
+		// reduce the TIME-WAIT period of the health check connections to 1 second instead of the OS default of 60 seconds
+		Control: func(_, _ string, c syscall.RawConn) error {
+			return c.Control(func(fd uintptr) {
+				syscall.SetsockoptLinger(int(fd), syscall.SOL_SOCKET, syscall.SO_LINGER, &syscall.Linger{Onoff: 1, Linger: 1}) //nolint:errcheck
+			})
+		},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", n.address)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+func (n *node) setHealthy(healthy bool) {
+	n.healthyMu.Lock()
+	defer n.healthyMu.Unlock()
+
+	if previous, ok := n.healthy.Get(); ok && previous == healthy {
+		return
+	}
+
+	if healthy {
+		n.logger.Info("upstream is healthy", zap.String("upstream_addr", n.address))
+	} else {
+		n.logger.Warn("upstream is unhealthy", zap.String("upstream_addr", n.address))
+	}
+
+	n.healthy = optional.Some(healthy)
+}
+
+func (n *node) status() UpstreamStatus {
+	n.healthyMu.Lock()
+	healthy := n.healthy
+	n.healthyMu.Unlock()
+
+	return UpstreamStatus{
+		Address:           n.address,
+		Healthy:           healthy,
+		ActiveConnections: n.activeConnections.Load(),
+		BytesSent:         n.bytesSent.Load(),
+		BytesReceived:     n.bytesReceived.Load(),
+		DialErrors:        n.dialErrors.Load(),
+	}
+}
+
+// tierMins are the minimum health check latencies of the upstream tiers, lower tiers are preferred.
+var tierMins = []time.Duration{
+	0,
+	time.Millisecond / 10,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	1 * time.Second,
+}
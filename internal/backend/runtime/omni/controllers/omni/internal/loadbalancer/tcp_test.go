@@ -0,0 +1,183 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package loadbalancer_test
+
+import (
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-loadbalancer/upstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/loadbalancer"
+)
+
+func findListenPort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	port := l.Addr().(*net.TCPAddr).Port //nolint:forcetypeassert,errcheck
+
+	require.NoError(t, l.Close())
+
+	return port
+}
+
+func startEchoServer(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { l.Close() }) //nolint:errcheck
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close() //nolint:errcheck
+
+				io.Copy(conn, conn) //nolint:errcheck
+			}()
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func TestTCPUpstreamStatuses(t *testing.T) {
+	echoAddress := startEchoServer(t)
+	deadAddress := net.JoinHostPort("127.0.0.1", "1")
+
+	port := findListenPort(t)
+
+	lb, err := loadbalancer.NewTCP("127.0.0.1", port, zaptest.NewLogger(t), loadbalancer.TCPOptions{
+		DialTimeout: time.Second,
+		HealthCheckOptions: []upstream.ListOption{
+			upstream.WithHealthcheckInterval(100 * time.Millisecond),
+		},
+	})
+	require.NoError(t, err)
+
+	upstreamCh := make(chan []string)
+
+	require.NoError(t, lb.Start(upstreamCh))
+
+	t.Cleanup(func() { require.NoError(t, lb.Shutdown()) })
+
+	upstreamCh <- []string{echoAddress}
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Len(collect, lb.UpstreamStatuses(), 1)
+	}, 5*time.Second, 50*time.Millisecond)
+
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	require.NoError(t, err)
+
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 5)
+
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+
+	statuses := lb.UpstreamStatuses()
+	require.Len(t, statuses, 1)
+
+	assert.Equal(t, echoAddress, statuses[0].Address)
+	assert.EqualValues(t, 1, statuses[0].ActiveConnections)
+	assert.EqualValues(t, 5, statuses[0].BytesSent)
+	assert.EqualValues(t, 5, statuses[0].BytesReceived)
+
+	require.NoError(t, conn.Close())
+
+	// the stats are kept for the upstreams which stay, and the dead upstream is marked unhealthy by the health checks
+	upstreamCh <- []string{echoAddress, deadAddress}
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		statuses := lb.UpstreamStatuses()
+
+		if !assert.Len(collect, statuses, 2) {
+			return
+		}
+
+		for _, status := range statuses {
+			healthy, ok := status.Healthy.Get()
+
+			assert.True(collect, ok)
+			assert.Equal(collect, status.Address == echoAddress, healthy, status.Address)
+
+			if status.Address == echoAddress {
+				assert.EqualValues(collect, 0, status.ActiveConnections)
+				assert.EqualValues(collect, 5, status.BytesSent)
+			}
+		}
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// the removed upstreams are dropped
+	upstreamCh <- []string{echoAddress}
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		statuses := lb.UpstreamStatuses()
+
+		if assert.Len(collect, statuses, 1) {
+			assert.Equal(collect, echoAddress, statuses[0].Address)
+		}
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestTCPDialErrors(t *testing.T) {
+	deadAddress := net.JoinHostPort("127.0.0.1", "1")
+
+	port := findListenPort(t)
+
+	lb, err := loadbalancer.NewTCP("127.0.0.1", port, zaptest.NewLogger(t), loadbalancer.TCPOptions{
+		DialTimeout: time.Second,
+		HealthCheckOptions: []upstream.ListOption{
+			// no health checks during the test, so the upstream is picked
+			upstream.WithHealthcheckInterval(time.Hour),
+		},
+	})
+	require.NoError(t, err)
+
+	upstreamCh := make(chan []string)
+
+	require.NoError(t, lb.Start(upstreamCh))
+
+	t.Cleanup(func() { require.NoError(t, lb.Shutdown()) })
+
+	upstreamCh <- []string{deadAddress}
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Len(collect, lb.UpstreamStatuses(), 1)
+	}, 5*time.Second, 50*time.Millisecond)
+
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	require.NoError(t, err)
+
+	// the load balancer closes the connection as the upstream can't be dialed
+	_, err = conn.Read(make([]byte, 1))
+	require.Error(t, err)
+	require.NoError(t, conn.Close())
+
+	statuses := lb.UpstreamStatuses()
+	require.Len(t, statuses, 1)
+
+	assert.EqualValues(t, 1, statuses[0].DialErrors)
+
+	healthy, ok := statuses[0].Healthy.Get()
+	assert.True(t, ok)
+	assert.False(t, healthy)
+}
@@ -40,8 +40,12 @@ func ReconcileControlPlanes(ctx context.Context, rc *ReconciliationContext, etcd
 		return nil, nil
 	}
 
-	// do a single destroy
+	// do a single destroy, teardowns are only allowed in the maintenance window
 	for _, id := range rc.GetMachinesToTeardown() {
+		if !rc.MaintenanceWindowOpen() {
+			break
+		}
+
 		clusterMachine, ok := rc.GetClusterMachine(id)
 		if !ok {
 			continue
@@ -158,6 +158,27 @@ func (u *Update) Apply(ctx context.Context, r controller.ReaderWriter, logger *z
 	})
 }
 
+// MarkForReplacement marks the outdated cluster machine to be replaced by a fresh machine from the machine class.
+type MarkForReplacement struct {
+	ID string
+}
+
+// Apply implements Operation interface.
+func (m *MarkForReplacement) Apply(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, rc *ReconciliationContext) error {
+	clusterMachine, ok := rc.GetClusterMachine(m.ID)
+	if !ok {
+		return fmt.Errorf("cluster machine with id %q doesn't exist", m.ID)
+	}
+
+	logger.Info("mark cluster machine for replacement", zap.String("machine", m.ID))
+
+	return safe.WriterModify(ctx, r, clusterMachine, func(res *omni.ClusterMachine) error {
+		res.Metadata().Annotations().Set(omni.ClusterMachinePendingReplacement, "")
+
+		return nil
+	})
+}
+
 // Destroy cleans up the cluster machines without finalizers.
 type Destroy struct {
 	ID string
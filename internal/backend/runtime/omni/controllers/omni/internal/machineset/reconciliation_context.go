@@ -9,6 +9,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -88,6 +90,10 @@ type ReconciliationContext struct {
 	idsToUpdate   []string
 	idsToDestroy  []string
 
+	// maintenanceWindowOpens is set when the maintenance window of the machine set is closed
+	maintenanceWindowOpens  time.Time
+	maintenanceWindowClosed bool
+
 	lbHealthy bool
 }
 
@@ -151,7 +157,7 @@ func BuildReconciliationContext(
 		return nil, fmt.Errorf("error creating config patch helper: %w", err)
 	}
 
-	return NewReconciliationContext(
+	rc, err := NewReconciliationContext(
 		cluster,
 		machineSet,
 		loadBalancerStatus,
@@ -162,6 +168,41 @@ func BuildReconciliationContext(
 		toSlice(clusterMachineConfigPatches),
 		toSlice(clusterMachineStatuses),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rc.SetMaintenanceWindow(time.Now()); err != nil {
+		return nil, err
+	}
+
+	spread := NewSpread(machineSet.TypedSpec().Value.GetMachineClass().GetTopologySpread())
+	if spread == nil || len(rc.idsToTeardown) < 2 {
+		return rc, nil
+	}
+
+	machineStatuses := make(map[resource.ID]*omni.MachineStatus, clusterMachines.Len())
+
+	if err = clusterMachines.ForEachErr(func(clusterMachine *omni.ClusterMachine) error {
+		machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, clusterMachine.Metadata().ID())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return fmt.Errorf("failed to get machine status %q: %w", clusterMachine.Metadata().ID(), err)
+		}
+
+		machineStatuses[clusterMachine.Metadata().ID()] = machineStatus
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	rc.OrderTeardownBySpread(spread, machineStatuses)
+
+	return rc, nil
 }
 
 // NewReconciliationContext creates new state for machine set status controller reconciliation flow.
@@ -310,6 +351,79 @@ func NewReconciliationContext(
 	return rc, nil
 }
 
+// SetMaintenanceWindow evaluates the maintenance window of the machine set at the given time.
+//
+// The window is always open for the machine set which is being destroyed, as the destruction is requested by the user.
+func (rc *ReconciliationContext) SetMaintenanceWindow(now time.Time) error {
+	if rc.machineSet.Metadata().Phase() == resource.PhaseTearingDown {
+		rc.maintenanceWindowClosed = false
+
+		return nil
+	}
+
+	schedule, err := omni.ParseMaintenanceWindow(omni.GetMaintenanceWindow(rc.cluster, rc.machineSet))
+	if err != nil {
+		return fmt.Errorf("failed to parse the maintenance window of the machine set %q: %w", rc.machineSet.Metadata().ID(), err)
+	}
+
+	if schedule == nil {
+		rc.maintenanceWindowClosed = false
+
+		return nil
+	}
+
+	open, next := schedule.Open(now)
+
+	rc.maintenanceWindowClosed = !open
+	rc.maintenanceWindowOpens = next
+
+	return nil
+}
+
+// MaintenanceWindowOpen returns true if the disruptive operations are allowed for the machine set.
+func (rc *ReconciliationContext) MaintenanceWindowOpen() bool {
+	return !rc.maintenanceWindowClosed
+}
+
+// MaintenanceWindowOpens returns the time when the closed maintenance window opens next, zero time if it never opens.
+func (rc *ReconciliationContext) MaintenanceWindowOpens() time.Time {
+	return rc.maintenanceWindowOpens
+}
+
+// ReplaceOnUpdate returns true if the machine should be replaced with a fresh machine instead of being updated in place.
+//
+// The machines which were never configured are always updated in place.
+func (rc *ReconciliationContext) ReplaceOnUpdate(id resource.ID) bool {
+	return MaxSurge(rc.machineSet) > 0 && !rc.idsUnconfigured.Contains(id)
+}
+
+// PendingReplacement returns true if the machine is already marked for the replacement.
+func (rc *ReconciliationContext) PendingReplacement(id resource.ID) bool {
+	clusterMachine, ok := rc.clusterMachinesMap[id]
+	if !ok {
+		return false
+	}
+
+	_, ok = clusterMachine.Metadata().Annotations().Get(omni.ClusterMachinePendingReplacement)
+
+	return ok
+}
+
+// OrderTeardownBySpread orders the machines to tear down, so that the remaining machines stay spread evenly across the failure domains.
+func (rc *ReconciliationContext) OrderTeardownBySpread(spread *Spread, machineStatuses map[resource.ID]*omni.MachineStatus) {
+	for id, clusterMachine := range rc.clusterMachinesMap {
+		if clusterMachine.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		spread.Add(machineStatuses[id])
+	}
+
+	slices.Sort(rc.idsToTeardown)
+
+	rc.idsToTeardown = spread.OrderRemovals(rc.idsToTeardown, machineStatuses)
+}
+
 // GetMachinesToTeardown returns all machine IDs which have ClusterMachine but no MachineSetNode.
 func (rc *ReconciliationContext) GetMachinesToTeardown() []string {
 	return rc.idsToTeardown
@@ -472,6 +586,27 @@ func (rc *ReconciliationContext) CalculateQuota() ChangeQuota {
 	return quota
 }
 
+// MaxSurge returns the number of machines which can be allocated on top of the machine count while replacing the outdated machines.
+//
+// Surge is only supported for the worker machine sets with the static machine class allocation, returns 0 otherwise.
+func MaxSurge(machineSet *omni.MachineSet) int {
+	spec := machineSet.TypedSpec().Value
+
+	if spec.UpdateStrategy != specs.MachineSetSpec_Rolling || spec.GetMachineClass().GetAllocationType() != specs.MachineSetSpec_MachineClass_Static {
+		return 0
+	}
+
+	if spec.MachineClass == nil {
+		return 0
+	}
+
+	if _, ok := machineSet.Metadata().Labels().Get(omni.LabelControlPlaneRole); ok {
+		return 0
+	}
+
+	return int(spec.GetUpdateStrategyConfig().GetRolling().GetMaxSurge())
+}
+
 func getParallelismOrDefault(strategyType specs.MachineSetSpec_UpdateStrategy, strategy *specs.MachineSetSpec_UpdateStrategyConfig, def int) int {
 	if strategyType == specs.MachineSetSpec_Rolling {
 		if strategy == nil {
@@ -7,10 +7,12 @@ package machineset_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -363,3 +365,48 @@ func TestReconciliationContext(t *testing.T) {
 		})
 	}
 }
+
+func TestMaintenanceWindowTearingDown(t *testing.T) {
+	t.Parallel()
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "cluster")
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "machine-set")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	machineSet.TypedSpec().Value.MaintenanceWindow = &specs.MaintenanceWindow{
+		Schedule: "0 2 * * *",
+		Duration: durationpb.New(time.Hour),
+	}
+
+	closedAt := time.Date(2024, 3, 6, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name       string
+		phase      resource.Phase
+		expectOpen bool
+	}{
+		{
+			name:  "running",
+			phase: resource.PhaseRunning,
+		},
+		{
+			name:       "tearing down",
+			phase:      resource.PhaseTearingDown,
+			expectOpen: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := machineSet.DeepCopy().(*omni.MachineSet) //nolint:forcetypeassert,errcheck
+			ms.Metadata().SetPhase(tt.phase)
+
+			rc, err := machineset.NewReconciliationContext(cluster, ms, newHealthyLB(cluster.Metadata().ID()), &fakePatchHelper{}, nil, nil, nil, nil, nil)
+			require.NoError(t, err)
+
+			require.NoError(t, rc.SetMaintenanceWindow(closedAt))
+
+			assert.Equal(t, tt.expectOpen, rc.MaintenanceWindowOpen())
+		})
+	}
+}
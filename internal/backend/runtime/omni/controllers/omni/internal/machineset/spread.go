@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machineset
+
+import (
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// Spread keeps track of the number of machines in each failure domain of the machine set.
+//
+// The failure domain of a machine is the value of the topology spread key label on its MachineStatus.
+type Spread struct {
+	counts  map[string]int
+	key     string
+	maxSkew int
+}
+
+// NewSpread creates the spread for the machine class topology spread settings, returns nil if the spread is not configured.
+func NewSpread(policy *specs.MachineSetSpec_MachineClass_TopologySpread) *Spread {
+	if policy.GetKey() == "" {
+		return nil
+	}
+
+	return &Spread{
+		key:     policy.GetKey(),
+		maxSkew: int(policy.GetMaxSkew()),
+		counts:  map[string]int{},
+	}
+}
+
+// Domain returns the failure domain of the machine.
+func (s *Spread) Domain(machineStatus *omni.MachineStatus) (string, bool) {
+	if machineStatus == nil {
+		return "", false
+	}
+
+	return machineStatus.Metadata().Labels().Get(s.key)
+}
+
+// Add accounts the machine in its failure domain.
+func (s *Spread) Add(machineStatus *omni.MachineStatus) {
+	if domain, ok := s.Domain(machineStatus); ok {
+		s.counts[domain]++
+	}
+}
+
+// Pick returns the candidate which should be allocated next to keep the machines spread evenly.
+//
+// The candidates are picked from the least populated failure domain, in the order they are passed.
+// Returns nil if none of the candidates can be allocated without exceeding the max skew,
+// the candidates which are not in any failure domain are never picked.
+func (s *Spread) Pick(candidates []*omni.MachineStatus) *omni.MachineStatus {
+	var (
+		picked      *omni.MachineStatus
+		pickedCount int
+	)
+
+	minCount := -1
+
+	for _, count := range s.counts {
+		if minCount == -1 || count < minCount {
+			minCount = count
+		}
+	}
+
+	for _, candidate := range candidates {
+		domain, ok := s.Domain(candidate)
+		if !ok {
+			continue
+		}
+
+		count := s.counts[domain]
+
+		// the domain without the machines is also known to the spread
+		if minCount == -1 || count < minCount {
+			minCount = count
+		}
+
+		if picked == nil || count < pickedCount {
+			picked = candidate
+			pickedCount = count
+		}
+	}
+
+	if picked == nil {
+		return nil
+	}
+
+	if s.maxSkew > 0 && pickedCount+1-minCount > s.maxSkew {
+		return nil
+	}
+
+	return picked
+}
+
+// OrderRemovals sorts the machines which are going to be removed, so that the remaining machines stay spread evenly.
+//
+// The machines which are not in any failure domain go first, then the machines from the most populated failure domains.
+// The order of the ids is preserved between the machines which are equally good to remove.
+func (s *Spread) OrderRemovals(ids []resource.ID, machineStatuses map[resource.ID]*omni.MachineStatus) []resource.ID {
+	result := make([]resource.ID, 0, len(ids))
+	remaining := make([]resource.ID, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := s.Domain(machineStatuses[id]); !ok {
+			result = append(result, id)
+
+			continue
+		}
+
+		remaining = append(remaining, id)
+	}
+
+	for len(remaining) > 0 {
+		index := 0
+
+		for i, id := range remaining {
+			if s.countOf(machineStatuses[id]) > s.countOf(machineStatuses[remaining[index]]) {
+				index = i
+			}
+		}
+
+		domain, _ := s.Domain(machineStatuses[remaining[index]])
+
+		s.counts[domain]--
+
+		result = append(result, remaining[index])
+		remaining = slices.Delete(remaining, index, index+1)
+	}
+
+	return result
+}
+
+func (s *Spread) countOf(machineStatus *omni.MachineStatus) int {
+	domain, _ := s.Domain(machineStatus)
+
+	return s.counts[domain]
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machineset_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/machineset"
+)
+
+func newZonedMachineStatus(id, zone string) *omni.MachineStatus {
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+
+	if zone != "" {
+		machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelZone, zone)
+	}
+
+	return machineStatus
+}
+
+func TestSpreadPick(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, machineset.NewSpread(nil))
+
+	spread := machineset.NewSpread(&specs.MachineSetSpec_MachineClass_TopologySpread{
+		Key:     omni.MachineStatusLabelZone,
+		MaxSkew: 1,
+	})
+
+	spread.Add(newZonedMachineStatus("existing", "a"))
+
+	candidates := []*omni.MachineStatus{
+		newZonedMachineStatus("1", "a"),
+		newZonedMachineStatus("2", ""),
+		newZonedMachineStatus("3", "b"),
+		newZonedMachineStatus("4", "a"),
+	}
+
+	picked := spread.Pick(candidates)
+	require.NotNil(t, picked)
+	assert.Equal(t, "3", picked.Metadata().ID())
+
+	spread.Add(picked)
+
+	picked = spread.Pick(candidates[:2])
+	require.NotNil(t, picked)
+	assert.Equal(t, "1", picked.Metadata().ID())
+
+	spread.Add(picked)
+
+	// zone a would have 3 machines while zone b has only 1
+	assert.Nil(t, spread.Pick(candidates[3:]))
+
+	// the machines without the zone are never picked
+	assert.Nil(t, spread.Pick(candidates[1:2]))
+}
+
+func TestSpreadOrderRemovals(t *testing.T) {
+	t.Parallel()
+
+	machineStatuses := map[resource.ID]*omni.MachineStatus{}
+
+	spread := machineset.NewSpread(&specs.MachineSetSpec_MachineClass_TopologySpread{
+		Key: omni.MachineStatusLabelZone,
+	})
+
+	for _, machineStatus := range []*omni.MachineStatus{
+		newZonedMachineStatus("a1", "a"),
+		newZonedMachineStatus("b1", "b"),
+		newZonedMachineStatus("a2", "a"),
+		newZonedMachineStatus("none", ""),
+		newZonedMachineStatus("a3", "a"),
+		newZonedMachineStatus("b2", "b"),
+		newZonedMachineStatus("c1", "c"),
+	} {
+		machineStatuses[machineStatus.Metadata().ID()] = machineStatus
+
+		spread.Add(machineStatus)
+	}
+
+	assert.Equal(t,
+		[]resource.ID{"none", "missing", "a1", "b1", "a2", "c1"},
+		spread.OrderRemovals([]resource.ID{"a1", "b1", "a2", "none", "c1", "missing"}, machineStatuses),
+	)
+}
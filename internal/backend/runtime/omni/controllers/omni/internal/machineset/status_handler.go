@@ -46,6 +46,9 @@ func ReconcileStatus(rc *ReconciliationContext, machineSetStatus *omni.MachineSe
 
 	helpers.CopyAllLabels(machineSet, machineSetStatus)
 
+	// lets the clients know that the status reflects the latest machine set spec
+	machineSetStatus.Metadata().Annotations().Set(omni.MachineSetStatusObservedVersion, machineSet.Metadata().Version().String())
+
 	spec.Phase = specs.MachineSetPhase_Running
 	spec.Error = ""
 	spec.Machines = &specs.Machines{}
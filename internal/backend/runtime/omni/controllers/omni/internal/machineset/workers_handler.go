@@ -28,12 +28,25 @@ func ReconcileWorkers(rc *ReconciliationContext) []Operation {
 		return operations
 	}
 
-	for _, id := range toTeardown {
-		operations = append(operations, &Teardown{ID: id, Quota: &quota})
+	// teardowns are only allowed in the maintenance window
+	if rc.MaintenanceWindowOpen() {
+		for _, id := range toTeardown {
+			operations = append(operations, &Teardown{ID: id, Quota: &quota})
+		}
 	}
 
 	for _, id := range toUpdate {
-		operations = append(operations, &Update{ID: id, Quota: &quota})
+		if !rc.ReplaceOnUpdate(id) {
+			operations = append(operations, &Update{ID: id, Quota: &quota})
+
+			continue
+		}
+
+		// the machine is not updated in place, the machine set node controller allocates the replacement
+		// and removes the machine set node of the outdated machine
+		if !rc.PendingReplacement(id) && rc.MaintenanceWindowOpen() {
+			operations = append(operations, &MarkForReplacement{ID: id})
+		}
 	}
 
 	return operations
@@ -7,9 +7,11 @@ package machineset_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -33,6 +35,8 @@ func TestWorkersHandler(t *testing.T) {
 
 		pendingConfigPatches map[resource.ID][]*omni.ConfigPatch
 
+		now time.Time
+
 		expectRequeue    bool
 		expectOperations []machineset.Operation
 	}{
@@ -168,6 +172,87 @@ func TestWorkersHandler(t *testing.T) {
 			},
 			expectOperations: []machineset.Operation{},
 		},
+		{
+			name: "no teardown outside of the maintenance window",
+			machineSet: &specs.MachineSetSpec{
+				MaintenanceWindow: &specs.MaintenanceWindow{
+					Schedule: "0 2 * * *",
+					Duration: durationpb.New(time.Hour),
+				},
+			},
+			machineSetNodes: []*omni.MachineSetNode{
+				omni.NewMachineSetNode(resources.DefaultNamespace, "a", machineSet),
+			},
+			clusterMachines: []*omni.ClusterMachine{
+				withUpdateInputVersions[*omni.ClusterMachine, *omni.ConfigPatch](withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "a"), version)),
+				withUpdateInputVersions[*omni.ClusterMachine, *omni.ConfigPatch](withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "b"), version)),
+			},
+			clusterMachineConfigPatches: []*omni.ClusterMachineConfigPatches{
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "a"),
+			},
+			clusterMachineConfigStatuses: []*omni.ClusterMachineConfigStatus{
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "a"), version),
+			},
+			now:              time.Date(2024, 3, 6, 12, 0, 0, 0, time.UTC),
+			expectOperations: []machineset.Operation{},
+		},
+		{
+			name: "teardown in the maintenance window",
+			machineSet: &specs.MachineSetSpec{
+				MaintenanceWindow: &specs.MaintenanceWindow{
+					Schedule: "0 2 * * *",
+					Duration: durationpb.New(time.Hour),
+				},
+			},
+			machineSetNodes: []*omni.MachineSetNode{
+				omni.NewMachineSetNode(resources.DefaultNamespace, "a", machineSet),
+			},
+			clusterMachines: []*omni.ClusterMachine{
+				withUpdateInputVersions[*omni.ClusterMachine, *omni.ConfigPatch](withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "a"), version)),
+				withUpdateInputVersions[*omni.ClusterMachine, *omni.ConfigPatch](withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "b"), version)),
+			},
+			clusterMachineConfigPatches: []*omni.ClusterMachineConfigPatches{
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "a"),
+			},
+			clusterMachineConfigStatuses: []*omni.ClusterMachineConfigStatus{
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "a"), version),
+			},
+			now: time.Date(2024, 3, 6, 2, 30, 0, 0, time.UTC),
+			expectOperations: []machineset.Operation{
+				&machineset.Teardown{ID: "b"},
+			},
+		},
+		{
+			name: "replace a machine with surge",
+			machineSet: &specs.MachineSetSpec{
+				MachineClass: &specs.MachineSetSpec_MachineClass{
+					Name:         "test",
+					MachineCount: 1,
+				},
+				UpdateStrategy: specs.MachineSetSpec_Rolling,
+				UpdateStrategyConfig: &specs.MachineSetSpec_UpdateStrategyConfig{
+					Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
+						MaxParallelism: 1,
+						MaxSurge:       1,
+					},
+				},
+			},
+			machineSetNodes: []*omni.MachineSetNode{
+				omni.NewMachineSetNode(resources.DefaultNamespace, "a", machineSet),
+				omni.NewMachineSetNode(resources.DefaultNamespace, "b", machineSet),
+			},
+			clusterMachines: []*omni.ClusterMachine{
+				omni.NewClusterMachine(resources.DefaultNamespace, "a"),
+				omni.NewClusterMachine(resources.DefaultNamespace, "b"),
+			},
+			clusterMachineConfigStatuses: []*omni.ClusterMachineConfigStatus{
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "a"), version),
+			},
+			expectOperations: []machineset.Operation{
+				&machineset.MarkForReplacement{ID: "a"},
+				&machineset.Update{ID: "b"},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			require := require.New(t)
@@ -195,6 +280,10 @@ func TestWorkersHandler(t *testing.T) {
 
 			require.NoError(err)
 
+			if !tt.now.IsZero() {
+				require.NoError(rc.SetMaintenanceWindow(tt.now))
+			}
+
 			operations := machineset.ReconcileWorkers(rc)
 
 			require.Equal(len(tt.expectOperations), len(operations), "%#v", operations)
@@ -218,6 +307,11 @@ func TestWorkersHandler(t *testing.T) {
 
 					require.True(ok, "the operation at %d is not destroy", i)
 					require.Equal(destroy.ID, value.ID)
+				case *machineset.MarkForReplacement:
+					replace, ok := expected.(*machineset.MarkForReplacement)
+
+					require.True(ok, "the operation at %d is not mark for replacement", i)
+					require.Equal(replace.ID, value.ID)
 				}
 			}
 		})
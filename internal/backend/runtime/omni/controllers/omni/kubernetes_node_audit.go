@@ -285,6 +285,10 @@ func (auditor *nodeAuditor) unmarkNodesAsInvalid(cluster string, nodes []string)
 }
 
 func getKubernetesClient(ctx context.Context, cluster string) (KubernetesClient, error) {
+	return newKubernetesClient(ctx, cluster)
+}
+
+func newKubernetesClient(ctx context.Context, cluster string) (*kubernetesClient, error) {
 	type kubeRuntime interface {
 		GetClient(ctx context.Context, cluster string) (*kubernetes.Client, error)
 	}
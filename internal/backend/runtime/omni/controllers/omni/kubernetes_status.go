@@ -60,6 +60,14 @@ const (
 	//
 	// tsgen:ServiceIconAnnotationKey
 	ServiceIconAnnotationKey = "omni-kube-service-exposer.sidero.dev/icon"
+
+	// ServiceProtocolAnnotationKey is the annotation to define the protocol of Kubernetes Services to expose them to Omni.
+	//
+	// The supported values are http (default), h2c (or grpc) and tcp.
+	// The WebSocket services are served by the http protocol, websocket is accepted as its alias.
+	//
+	// tsgen:ServiceProtocolAnnotationKey
+	ServiceProtocolAnnotationKey = "omni-kube-service-exposer.sidero.dev/protocol"
 )
 
 // KubernetesStatusController manages KubernetesStatus resource lifecycle.
@@ -206,6 +214,11 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 			svcLogger.Debug("invalid icon on Service", zap.Error(err))
 		}
 
+		protocol, err := ctrl.parseProtocol(service.Annotations[ServiceProtocolAnnotationKey])
+		if err != nil {
+			svcLogger.Warn("invalid protocol on Service, falling back to HTTP", zap.Error(err))
+		}
+
 		var alias string
 
 		if err = safe.WriterModify(ctx, r, exposedService, func(res *omni.ExposedService) error {
@@ -226,6 +239,7 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 			res.TypedSpec().Value.Port = uint32(port)
 			res.TypedSpec().Value.Label = label
 			res.TypedSpec().Value.IconBase64 = icon
+			res.TypedSpec().Value.Protocol = protocol
 
 			return nil
 		}); err != nil {
@@ -240,6 +254,19 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 	return tracker.cleanup(ctx)
 }
 
+func (ctrl *KubernetesStatusController) parseProtocol(protocol string) (specs.ExposedServiceSpec_Protocol, error) {
+	switch strings.ToLower(protocol) {
+	case "", "http", "websocket":
+		return specs.ExposedServiceSpec_HTTP, nil
+	case "h2c", "grpc":
+		return specs.ExposedServiceSpec_H2C, nil
+	case "tcp":
+		return specs.ExposedServiceSpec_TCP, nil
+	default:
+		return specs.ExposedServiceSpec_HTTP, fmt.Errorf("unsupported protocol %q", protocol)
+	}
+}
+
 func (ctrl *KubernetesStatusController) parseIcon(iconBase64 string) (string, error) {
 	if iconBase64 == "" {
 		return "", nil
@@ -740,7 +767,7 @@ func IsExposedServiceEvent(k8sObject, oldK8sObject any, logger *zap.Logger) bool
 	oldAnnotations := oldK8sObject.(*corev1.Service).GetObjectMeta().GetAnnotations() //nolint:forcetypeassert
 	newAnnotations := k8sObject.(*corev1.Service).GetObjectMeta().GetAnnotations()    //nolint:forcetypeassert
 
-	for _, key := range []string{ServiceLabelAnnotationKey, ServicePortAnnotationKey, ServiceIconAnnotationKey} {
+	for _, key := range []string{ServiceLabelAnnotationKey, ServicePortAnnotationKey, ServiceIconAnnotationKey, ServiceProtocolAnnotationKey} {
 		if oldAnnotations[key] != newAnnotations[key] {
 			return true
 		}
@@ -101,6 +101,25 @@ func TestIsExposedServiceEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "update service - change in exposed service protocol",
+			expectChanged: true,
+			obj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						omni.ServicePortAnnotationKey:     "8080",
+						omni.ServiceProtocolAnnotationKey: "grpc",
+					},
+				},
+			},
+			oldObj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						omni.ServicePortAnnotationKey: "8080",
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
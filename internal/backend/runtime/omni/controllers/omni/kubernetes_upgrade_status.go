@@ -330,37 +330,14 @@ func applyUpgradePatches(ctx context.Context, r controller.Writer, cluster *omni
 
 	for _, patch := range patches {
 		if err := safe.WriterModify(ctx, r,
-			omni.NewConfigPatch(resources.DefaultNamespace, fmt.Sprintf("900-cm-%s-kubernetes-upgrade", patch.MachineID)),
+			omni.NewConfigPatch(resources.DefaultNamespace, kubernetesUpgradePatchID(patch.MachineID)),
 			func(configPatch *omni.ConfigPatch) error {
-				var cfg v1alpha1.Config
-
-				if configPatch.TypedSpec().Value.Data != "" {
-					if err := yaml.Unmarshal([]byte(configPatch.TypedSpec().Value.Data), &cfg); err != nil {
-						return err
-					}
-				}
-
-				oldCfg := cfg.DeepCopy()
-
-				patch.Patch.Apply(&cfg)
-
-				// patch is already applied, skip it
-				if reflect.DeepEqual(oldCfg, cfg) {
-					return nil
-				}
-
-				configPatch.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
-				configPatch.Metadata().Labels().Set(omni.LabelClusterMachine, patch.MachineID)
-				configPatch.Metadata().Labels().Set(omni.LabelSystemPatch, "")
-
-				data, err := yaml.Marshal(cfg)
+				applied, err := applyUpgradePatch(configPatch, cluster, patch)
 				if err != nil {
 					return err
 				}
 
-				configPatch.TypedSpec().Value.Data = string(data)
-
-				anyPatchApplied = true
+				anyPatchApplied = anyPatchApplied || applied
 
 				return nil
 			}); err != nil {
@@ -371,6 +348,44 @@ func applyUpgradePatches(ctx context.Context, r controller.Writer, cluster *omni
 	return anyPatchApplied, nil
 }
 
+// kubernetesUpgradePatchID returns the ID of the config patch which keeps the Kubernetes component versions of the machine.
+func kubernetesUpgradePatchID(machineID resource.ID) resource.ID {
+	return fmt.Sprintf("900-cm-%s-kubernetes-upgrade", machineID)
+}
+
+// applyUpgradePatch applies the upgrade step to the Kubernetes upgrade config patch of the machine, returns false if the patch is already applied.
+func applyUpgradePatch(configPatch *omni.ConfigPatch, cluster *omni.Cluster, patch kubernetes.UpgradeStep) (bool, error) {
+	var cfg v1alpha1.Config
+
+	if configPatch.TypedSpec().Value.Data != "" {
+		if err := yaml.Unmarshal([]byte(configPatch.TypedSpec().Value.Data), &cfg); err != nil {
+			return false, err
+		}
+	}
+
+	oldCfg := cfg.DeepCopy()
+
+	patch.Patch.Apply(&cfg)
+
+	// patch is already applied, skip it
+	if reflect.DeepEqual(oldCfg, cfg) {
+		return false, nil
+	}
+
+	configPatch.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	configPatch.Metadata().Labels().Set(omni.LabelClusterMachine, patch.MachineID)
+	configPatch.Metadata().Labels().Set(omni.LabelSystemPatch, "")
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return false, err
+	}
+
+	configPatch.TypedSpec().Value.Data = string(data)
+
+	return true, nil
+}
+
 func skipLocked(ctx context.Context, r controller.Reader, patch kubernetes.UpgradeStep, upgradeStatus *omni.KubernetesUpgradeStatus) (bool, error) {
 	machineSetNode, err := r.Get(ctx, resource.NewMetadata(resources.DefaultNamespace, omni.MachineSetNodeType, patch.MachineID, resource.VersionUndefined))
 	if err != nil && !state.IsNotFoundError(err) {
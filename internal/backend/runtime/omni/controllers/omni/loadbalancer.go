@@ -9,23 +9,33 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/loadbalancer"
 )
 
 // LoadBalancerController starts, stops and updates the statuses of load balancers.
+//
+//nolint:govet
 type LoadBalancerController struct {
 	NewLoadBalancer loadbalancer.NewFunc
 
 	loadBalancers *loadbalancer.Manager
+
+	// statuses are the last reported load balancer statuses including the upstream traffic stats, they are exported as metrics
+	statusesMu sync.Mutex
+	statuses   map[loadbalancer.ID]loadbalancer.Status
 }
 
 // Name returns the name of the controller.
@@ -71,6 +81,8 @@ func (ctrl *LoadBalancerController) Run(ctx context.Context, r controller.Runtim
 		if err := ctrl.loadBalancers.Stop(); err != nil {
 			logger.Error("error shutting down load balancers", zap.Error(err))
 		}
+
+		ctrl.setStatuses(nil)
 	}()
 
 	ticker := time.NewTicker(time.Second * 10)
@@ -148,6 +160,7 @@ func (ctrl *LoadBalancerController) reconcileLoadBalancers(ctx context.Context,
 		shouldRun[item.Metadata().ID()] = loadbalancer.Spec{
 			BindAddress: "0.0.0.0",
 			BindPort:    bindPort,
+			SNIHostname: lbSpec.SniHostname,
 		}
 
 		endpoints[item.Metadata().ID()] = lbSpec.Endpoints
@@ -176,6 +189,7 @@ func (ctrl *LoadBalancerController) reconcileLoadBalancers(ctx context.Context,
 
 				status.TypedSpec().Value.Stopped = true
 				status.TypedSpec().Value.Healthy = false
+				status.TypedSpec().Value.Upstreams = nil
 
 				return nil
 			}); err != nil {
@@ -199,12 +213,24 @@ func (ctrl *LoadBalancerController) reconcileLoadBalancers(ctx context.Context,
 }
 
 func (ctrl *LoadBalancerController) updateHealthStatus(ctx context.Context, r controller.Runtime) error {
-	for id, healthy := range ctrl.loadBalancers.GetHealthStatus() {
+	statuses := ctrl.loadBalancers.GetStatus()
+
+	ctrl.setStatuses(statuses)
+
+	// the upstream traffic stats change all the time, so they are exported only as metrics,
+	// the resource holds only the health and the state, so it is updated only when they change
+	for id, lbStatus := range statuses {
 		if err := safe.WriterModify(ctx, r, omni.NewLoadBalancerStatus(resources.DefaultNamespace, id), func(status *omni.LoadBalancerStatus) error {
 			status.Metadata().Labels().Set(omni.LabelCluster, id)
 
 			status.TypedSpec().Value.Stopped = false
-			status.TypedSpec().Value.Healthy = healthy
+			status.TypedSpec().Value.Healthy = lbStatus.Healthy
+			status.TypedSpec().Value.Upstreams = xslices.Map(lbStatus.Upstreams, func(upstream loadbalancer.UpstreamStatus) *specs.LoadBalancerStatusSpec_Upstream {
+				return &specs.LoadBalancerStatusSpec_Upstream{
+					Address: upstream.Address,
+					State:   upstreamState(upstream),
+				}
+			})
 
 			return nil
 		}); err != nil {
@@ -214,3 +240,86 @@ func (ctrl *LoadBalancerController) updateHealthStatus(ctx context.Context, r co
 
 	return nil
 }
+
+func (ctrl *LoadBalancerController) setStatuses(statuses map[loadbalancer.ID]loadbalancer.Status) {
+	ctrl.statusesMu.Lock()
+	defer ctrl.statusesMu.Unlock()
+
+	ctrl.statuses = statuses
+}
+
+func upstreamState(upstream loadbalancer.UpstreamStatus) specs.LoadBalancerStatusSpec_Upstream_State {
+	healthy, ok := upstream.Healthy.Get()
+
+	switch {
+	case !ok:
+		return specs.LoadBalancerStatusSpec_Upstream_Unknown
+	case healthy:
+		return specs.LoadBalancerStatusSpec_Upstream_Healthy
+	default:
+		return specs.LoadBalancerStatusSpec_Upstream_Unhealthy
+	}
+}
+
+var (
+	loadBalancerUpstreamLabels = []string{"cluster", "upstream"}
+
+	loadBalancerUpstreamHealthyDesc = prometheus.NewDesc(
+		"omni_loadbalancer_upstream_healthy",
+		"Whether the Kubernetes API load balancer upstream is healthy.",
+		loadBalancerUpstreamLabels, nil,
+	)
+	loadBalancerUpstreamActiveConnectionsDesc = prometheus.NewDesc(
+		"omni_loadbalancer_upstream_active_connections",
+		"Number of the active connections proxied by the Kubernetes API load balancer to the upstream.",
+		loadBalancerUpstreamLabels, nil,
+	)
+	loadBalancerUpstreamSentBytesDesc = prometheus.NewDesc(
+		"omni_loadbalancer_upstream_sent_bytes_total",
+		"Number of bytes sent by the Kubernetes API load balancer to the upstream.",
+		loadBalancerUpstreamLabels, nil,
+	)
+	loadBalancerUpstreamReceivedBytesDesc = prometheus.NewDesc(
+		"omni_loadbalancer_upstream_received_bytes_total",
+		"Number of bytes received by the Kubernetes API load balancer from the upstream.",
+		loadBalancerUpstreamLabels, nil,
+	)
+	loadBalancerUpstreamDialErrorsDesc = prometheus.NewDesc(
+		"omni_loadbalancer_upstream_dial_errors_total",
+		"Number of the failed attempts of the Kubernetes API load balancer to connect to the upstream.",
+		loadBalancerUpstreamLabels, nil,
+	)
+)
+
+// Describe implements prom.Collector interface.
+func (ctrl *LoadBalancerController) Describe(ch chan<- *prometheus.Desc) {
+	ch <- loadBalancerUpstreamHealthyDesc
+	ch <- loadBalancerUpstreamActiveConnectionsDesc
+	ch <- loadBalancerUpstreamSentBytesDesc
+	ch <- loadBalancerUpstreamReceivedBytesDesc
+	ch <- loadBalancerUpstreamDialErrorsDesc
+}
+
+// Collect implements prom.Collector interface.
+func (ctrl *LoadBalancerController) Collect(ch chan<- prometheus.Metric) {
+	ctrl.statusesMu.Lock()
+	defer ctrl.statusesMu.Unlock()
+
+	for id, status := range ctrl.statuses {
+		for _, upstream := range status.Upstreams {
+			healthy := 0.0
+
+			if upstreamState(upstream) == specs.LoadBalancerStatusSpec_Upstream_Healthy {
+				healthy = 1.0
+			}
+
+			ch <- prometheus.MustNewConstMetric(loadBalancerUpstreamHealthyDesc, prometheus.GaugeValue, healthy, id, upstream.Address)
+			ch <- prometheus.MustNewConstMetric(loadBalancerUpstreamActiveConnectionsDesc, prometheus.GaugeValue, float64(upstream.ActiveConnections), id, upstream.Address)
+			ch <- prometheus.MustNewConstMetric(loadBalancerUpstreamSentBytesDesc, prometheus.CounterValue, float64(upstream.BytesSent), id, upstream.Address)
+			ch <- prometheus.MustNewConstMetric(loadBalancerUpstreamReceivedBytesDesc, prometheus.CounterValue, float64(upstream.BytesReceived), id, upstream.Address)
+			ch <- prometheus.MustNewConstMetric(loadBalancerUpstreamDialErrorsDesc, prometheus.CounterValue, float64(upstream.DialErrors), id, upstream.Address)
+		}
+	}
+}
+
+var _ prometheus.Collector = &LoadBalancerController{}
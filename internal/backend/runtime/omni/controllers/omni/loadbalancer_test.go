@@ -9,14 +9,17 @@ import (
 	"context"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/gen/optional"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	omniresources "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
@@ -29,6 +32,7 @@ type mockLoadBalancer struct {
 	upstreamsCh          chan []string
 	ctxCancel            context.CancelFunc
 	wg                   sync.WaitGroup
+	statusCalls          atomic.Uint64
 }
 
 func (lb *mockLoadBalancer) Start(upstreamCh <-chan []string) error {
@@ -90,6 +94,26 @@ func (lb *mockLoadBalancer) Healthy() (bool, error) {
 	return true, nil
 }
 
+func (lb *mockLoadBalancer) UpstreamStatuses() []loadbalancer.UpstreamStatus {
+	// the traffic grows on each call
+	calls := lb.statusCalls.Add(1)
+
+	return []loadbalancer.UpstreamStatus{
+		{
+			Address:           "testEndpoint",
+			Healthy:           optional.Some(true),
+			ActiveConnections: 2,
+			BytesSent:         100 * calls,
+			BytesReceived:     200 * calls,
+		},
+		{
+			Address:    "unhealthyEndpoint",
+			Healthy:    optional.Some(false),
+			DialErrors: 3,
+		},
+	}
+}
+
 type LoadBalancerSuite struct {
 	OmniSuite
 }
@@ -116,7 +140,7 @@ func (suite *LoadBalancerSuite) setupMock() (<-chan *mockLoadBalancer, <-chan ne
 	mockCh := make(chan *mockLoadBalancer)
 	newLoadBalancerMethodCalled := make(chan newLoadBalancerSignature)
 
-	newMockFunc := func(bindAddress string, bindPort int, _ *zap.Logger) (loadbalancer.LoadBalancer, error) {
+	newMockFunc := func(spec loadbalancer.Spec, _ *loadbalancer.SNIRouter, _ *zap.Logger) (loadbalancer.LoadBalancer, error) {
 		mock := &mockLoadBalancer{
 			startMethodCalled:    make(chan struct{}),
 			shutdownMethodCalled: make(chan struct{}),
@@ -125,8 +149,8 @@ func (suite *LoadBalancerSuite) setupMock() (<-chan *mockLoadBalancer, <-chan ne
 
 		go func() {
 			newLoadBalancerMethodCalled <- newLoadBalancerSignature{
-				bindAddress: bindAddress,
-				bindPort:    bindPort,
+				bindAddress: spec.BindAddress,
+				bindPort:    spec.BindPort,
 			}
 		}()
 
@@ -256,6 +280,64 @@ func (suite *LoadBalancerSuite) assertStatus(id string, expectedHealthy, expecte
 	)
 }
 
+func (suite *LoadBalancerSuite) TestUpstreamStatuses() {
+	mockCh, _ := suite.setupMock()
+
+	suite.startRuntime()
+
+	const testID = "upstreams"
+
+	loadBalancer := omniresources.NewLoadBalancerConfig(resources.DefaultNamespace, testID)
+	loadBalancer.TypedSpec().Value.BindPort = "20000"
+	suite.Require().NoError(suite.state.Create(suite.ctx, loadBalancer))
+
+	clusterStatus := omniresources.NewClusterStatus(resources.DefaultNamespace, testID)
+	clusterStatus.TypedSpec().Value.HasConnectedControlPlanes = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+
+	lb := <-mockCh
+
+	assertResource(
+		&suite.OmniSuite,
+		*omniresources.NewLoadBalancerStatus(resources.DefaultNamespace, testID).Metadata(),
+		func(res *omniresources.LoadBalancerStatus, assertions *assert.Assertions) {
+			upstreams := res.TypedSpec().Value.Upstreams
+
+			if !assertions.Len(upstreams, 2) {
+				return
+			}
+
+			assertions.Equal("testEndpoint", upstreams[0].Address)
+			assertions.Equal(specs.LoadBalancerStatusSpec_Upstream_Healthy, upstreams[0].State)
+
+			assertions.Equal("unhealthyEndpoint", upstreams[1].Address)
+			assertions.Equal(specs.LoadBalancerStatusSpec_Upstream_Unhealthy, upstreams[1].State)
+		},
+	)
+
+	status, err := safe.StateGet[*omniresources.LoadBalancerStatus](suite.ctx, suite.state, omniresources.NewLoadBalancerStatus(resources.DefaultNamespace, testID).Metadata())
+	suite.Require().NoError(err)
+
+	// trigger the status updates, the upstream traffic changes, but the health doesn't,
+	// the second round makes sure that the status write of the first one has completed
+	for _, endpoints := range [][]string{{"testEndpoint"}, {"testEndpoint", "unhealthyEndpoint"}} {
+		calls := lb.statusCalls.Load()
+
+		loadBalancer.TypedSpec().Value.Endpoints = endpoints
+		suite.Require().NoError(suite.state.Update(suite.ctx, loadBalancer))
+
+		suite.Require().Eventually(func() bool {
+			return lb.statusCalls.Load() > calls
+		}, 3*time.Second, 10*time.Millisecond)
+	}
+
+	newStatus, err := safe.StateGet[*omniresources.LoadBalancerStatus](suite.ctx, suite.state, status.Metadata())
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(status.Metadata().Version(), newStatus.Metadata().Version())
+}
+
 func (suite *LoadBalancerSuite) TestChangeAddress() {
 	mockCh, newLoadBalancerMethodCalled := suite.setupMock()
 
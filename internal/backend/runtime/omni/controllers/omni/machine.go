@@ -48,6 +48,12 @@ func NewMachineController() *MachineController {
 
 				machine.Metadata().Labels().Set(omni.MachineAddressLabel, spec.ManagementAddress)
 
+				if _, pending := link.Metadata().Labels().Get(omni.MachineStatusLabelPendingAcceptance); pending {
+					machine.Metadata().Labels().Set(omni.MachineStatusLabelPendingAcceptance, "")
+				} else {
+					machine.Metadata().Labels().Delete(omni.MachineStatusLabelPendingAcceptance)
+				}
+
 				return nil
 			},
 		},
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// MachineClassStatusController explains which machines match the machine class and why the others don't.
+type MachineClassStatusController = qtransform.QController[*omni.MachineClass, *omni.MachineClassStatus]
+
+// NewMachineClassStatusController initializes MachineClassStatusController.
+func NewMachineClassStatusController() *MachineClassStatusController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.MachineClass, *omni.MachineClassStatus]{
+			Name: "MachineClassStatusController",
+			MapMetadataFunc: func(machineClass *omni.MachineClass) *omni.MachineClassStatus {
+				return omni.NewMachineClassStatus(resources.DefaultNamespace, machineClass.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(machineClassStatus *omni.MachineClassStatus) *omni.MachineClass {
+				return omni.NewMachineClass(resources.DefaultNamespace, machineClassStatus.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, machineClass *omni.MachineClass, machineClassStatus *omni.MachineClassStatus) error {
+				machineStatuses, err := safe.ReaderListAll[*omni.MachineStatus](ctx, r)
+				if err != nil {
+					return err
+				}
+
+				selectors, err := labels.ParseSelectors(machineClass.TypedSpec().Value.MatchLabels)
+				if err != nil {
+					return err
+				}
+
+				spec := machineClassStatus.TypedSpec().Value
+
+				spec.MatchingMachines = 0
+				spec.Mismatches = nil
+
+				machineStatuses.ForEach(func(machineStatus *omni.MachineStatus) {
+					reasons := machineClassMismatches(machineClass, selectors, machineStatus)
+					if len(reasons) == 0 {
+						spec.MatchingMachines++
+
+						return
+					}
+
+					spec.Mismatches = append(spec.Mismatches, &specs.MachineClassStatusSpec_Mismatch{
+						MachineId: machineStatus.Metadata().ID(),
+						Reasons:   reasons,
+					})
+				})
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			// any machine might change the status of every machine class
+			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, _ *omni.MachineStatus) ([]resource.Pointer, error) {
+				machineClasses, err := safe.ReaderListAll[*omni.MachineClass](ctx, r)
+				if err != nil {
+					return nil, err
+				}
+
+				return safe.Map(machineClasses, func(machineClass *omni.MachineClass) (resource.Pointer, error) {
+					return machineClass.Metadata(), nil
+				})
+			},
+		),
+	)
+}
+
+// machineClassMismatches returns the reasons why the machine doesn't match the machine class.
+func machineClassMismatches(machineClass *omni.MachineClass, selectors resource.LabelQueries, machineStatus *omni.MachineStatus) []string {
+	requirements := machineClass.TypedSpec().Value.Requirements
+
+	if len(selectors) == 0 && requirements == nil {
+		return []string{"the machine class has neither match labels nor requirements"}
+	}
+
+	var reasons []string
+
+	if len(selectors) > 0 && !selectorsMatch(selectors, machineStatus) {
+		reasons = append(reasons, "labels don't match any of the match labels")
+	}
+
+	return append(reasons, omni.MachineClassRequirementsMismatches(requirements, machineStatus)...)
+}
+
+func selectorsMatch(selectors resource.LabelQueries, machineStatus *omni.MachineStatus) bool {
+	for _, selector := range selectors {
+		if selector.Matches(*machineStatus.Metadata().Labels()) {
+			return true
+		}
+	}
+
+	return false
+}
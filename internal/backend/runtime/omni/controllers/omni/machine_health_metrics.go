@@ -0,0 +1,230 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// MachineHealthMetricsController provides per-machine health metrics based on MachineStatusLink and ClusterMachineStatus.
+//
+//nolint:govet
+type MachineHealthMetricsController struct {
+	metricsMu sync.Mutex
+	metrics   []prometheus.Metric
+
+	metricsOnce                   sync.Once
+	metricConnected               *prometheus.Desc
+	metricStage                   *prometheus.Desc
+	metricConfigUpToDate          *prometheus.Desc
+	metricSiderolinkBytesReceived *prometheus.Desc
+	metricSiderolinkBytesSent     *prometheus.Desc
+	metricSiderolinkLastAlive     *prometheus.Desc
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MachineHealthMetricsController) Name() string {
+	return "MachineHealthMetricsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MachineHealthMetricsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: resources.MetricsNamespace,
+			Type:      omni.MachineStatusLinkType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ClusterMachineStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MachineHealthMetricsController) Outputs() []controller.Output {
+	return nil
+}
+
+func (ctrl *MachineHealthMetricsController) initMetrics() {
+	ctrl.metricsOnce.Do(func() {
+		labels := []string{"machine", "cluster", "machine_set"}
+
+		ctrl.metricConnected = prometheus.NewDesc(
+			"omni_machine_connected",
+			"Whether the machine is connected.",
+			labels,
+			nil,
+		)
+
+		ctrl.metricStage = prometheus.NewDesc(
+			"omni_machine_stage",
+			"Stage of the cluster machine, 1 for the current stage and 0 for the others.",
+			[]string{"machine", "cluster", "machine_set", "stage"},
+			nil,
+		)
+
+		ctrl.metricConfigUpToDate = prometheus.NewDesc(
+			"omni_machine_config_up_to_date",
+			"Whether the configuration of the cluster machine is up to date.",
+			labels,
+			nil,
+		)
+
+		ctrl.metricSiderolinkBytesReceived = prometheus.NewDesc(
+			"omni_machine_siderolink_received_bytes_total",
+			"Number of bytes received from the machine over SideroLink.",
+			labels,
+			nil,
+		)
+
+		ctrl.metricSiderolinkBytesSent = prometheus.NewDesc(
+			"omni_machine_siderolink_sent_bytes_total",
+			"Number of bytes sent to the machine over SideroLink.",
+			labels,
+			nil,
+		)
+
+		ctrl.metricSiderolinkLastAlive = prometheus.NewDesc(
+			"omni_machine_siderolink_last_alive_timestamp_seconds",
+			"Time of the last SideroLink handshake of the machine as a Unix timestamp.",
+			labels,
+			nil,
+		)
+	})
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MachineHealthMetricsController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ctrl.initMetrics()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		metrics, err := ctrl.gatherMetrics(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		// the metrics are replaced as a whole, so the metrics of the removed machines are dropped
+		ctrl.metricsMu.Lock()
+		ctrl.metrics = metrics
+		ctrl.metricsMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(10 * time.Second): // don't reconcile too often, as metrics are not scraped that often
+		}
+	}
+}
+
+func (ctrl *MachineHealthMetricsController) gatherMetrics(ctx context.Context, r controller.Runtime) ([]prometheus.Metric, error) {
+	machineStatusLinks, err := safe.ReaderListAll[*omni.MachineStatusLink](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterMachineStatuses, err := safe.ReaderListAll[*omni.ClusterMachineStatus](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	type machineLabels struct {
+		cluster    string
+		machineSet string
+	}
+
+	clusterMachines := make(map[resource.ID]machineLabels, clusterMachineStatuses.Len())
+
+	var metrics []prometheus.Metric
+
+	for iter := clusterMachineStatuses.Iterator(); iter.Next(); {
+		id := iter.Value().Metadata().ID()
+		spec := iter.Value().TypedSpec().Value
+
+		cluster, _ := iter.Value().Metadata().Labels().Get(omni.LabelCluster)
+		machineSet, _ := iter.Value().Metadata().Labels().Get(omni.LabelMachineSet)
+
+		clusterMachines[id] = machineLabels{
+			cluster:    cluster,
+			machineSet: machineSet,
+		}
+
+		metrics = appendPhaseMetrics(metrics, ctrl.metricStage, specs.ClusterMachineStatusSpec_Stage_name, int32(spec.Stage), id, cluster, machineSet)
+		metrics = append(metrics, prometheus.MustNewConstMetric(ctrl.metricConfigUpToDate, prometheus.GaugeValue, boolToFloat(spec.ConfigUpToDate), id, cluster, machineSet))
+	}
+
+	for iter := machineStatusLinks.Iterator(); iter.Next(); {
+		id := iter.Value().Metadata().ID()
+		spec := iter.Value().TypedSpec().Value
+		labels := clusterMachines[id]
+
+		metrics = append(metrics,
+			prometheus.MustNewConstMetric(ctrl.metricConnected, prometheus.GaugeValue, boolToFloat(spec.GetMessageStatus().GetConnected()), id, labels.cluster, labels.machineSet),
+		)
+
+		counter := spec.GetSiderolinkCounter()
+		if counter == nil {
+			continue
+		}
+
+		metrics = append(metrics,
+			prometheus.MustNewConstMetric(ctrl.metricSiderolinkBytesReceived, prometheus.CounterValue, float64(counter.BytesReceived), id, labels.cluster, labels.machineSet),
+			prometheus.MustNewConstMetric(ctrl.metricSiderolinkBytesSent, prometheus.CounterValue, float64(counter.BytesSent), id, labels.cluster, labels.machineSet),
+		)
+
+		if counter.LastAlive != nil {
+			metrics = append(metrics,
+				prometheus.MustNewConstMetric(ctrl.metricSiderolinkLastAlive, prometheus.GaugeValue, float64(counter.LastAlive.AsTime().Unix()), id, labels.cluster, labels.machineSet),
+			)
+		}
+	}
+
+	return metrics, nil
+}
+
+// Describe implements prom.Collector interface.
+func (ctrl *MachineHealthMetricsController) Describe(ch chan<- *prometheus.Desc) {
+	ctrl.initMetrics()
+
+	ch <- ctrl.metricConnected
+	ch <- ctrl.metricStage
+	ch <- ctrl.metricConfigUpToDate
+	ch <- ctrl.metricSiderolinkBytesReceived
+	ch <- ctrl.metricSiderolinkBytesSent
+	ch <- ctrl.metricSiderolinkLastAlive
+}
+
+// Collect implements prom.Collector interface.
+func (ctrl *MachineHealthMetricsController) Collect(ch chan<- prometheus.Metric) {
+	ctrl.metricsMu.Lock()
+	defer ctrl.metricsMu.Unlock()
+
+	for _, metric := range ctrl.metrics {
+		ch <- metric
+	}
+}
+
+var _ prometheus.Collector = &MachineHealthMetricsController{}
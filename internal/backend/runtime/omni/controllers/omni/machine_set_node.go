@@ -24,6 +24,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/machineset"
 )
 
 // MachineSetNodeController manages MachineSetNode resource lifecycle.
@@ -64,6 +65,16 @@ func (ctrl *MachineSetNodeController) Inputs() []controller.Input {
 			Type:      omni.MachineClassType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ClusterMachineType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ClusterMachineStatusType,
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: resources.DefaultNamespace,
 			Type:      omni.MachineSetNodeType,
@@ -190,36 +201,143 @@ func (ctrl *MachineSetNodeController) reconcileMachineSet(
 
 	existingMachineSetNodes := allMachineSetNodes.FilterLabelQuery(resource.LabelEqual(omni.LabelMachineSet, machineSet.Metadata().ID()))
 
+	spread := machineset.NewSpread(machineClassCfg.TopologySpread)
+
 	switch machineClassCfg.AllocationType {
 	case specs.MachineSetSpec_MachineClass_Unlimited:
-		return ctrl.createNodes(ctx, r, machineSet, machineClass, allMachineStatuses, math.MaxInt32)
+		return ctrl.createNodes(ctx, r, machineSet, machineClass, allMachineStatuses, existingMachineSetNodes, machineStatusMap, spread, math.MaxInt32)
 	case specs.MachineSetSpec_MachineClass_Static:
-		diff := int(machineClassCfg.MachineCount) - existingMachineSetNodes.Len()
+		var replacing []*omni.MachineSetNode
 
-		if diff == 0 {
-			return nil
+		maxSurge := machineset.MaxSurge(machineSet)
+		if maxSurge > 0 {
+			if replacing, err = ctrl.getPendingReplacement(ctx, r, existingMachineSetNodes); err != nil {
+				return err
+			}
 		}
 
+		// allocate the surge machines on top of the machine count while the outdated machines are being replaced
+		diff := int(machineClassCfg.MachineCount) + min(len(replacing), maxSurge) - existingMachineSetNodes.Len()
+
 		if diff < 0 {
 			logger.Info("scaling machine set down", zap.Int("pending", -diff), zap.String("machine_set", machineSet.Metadata().ID()))
 
-			return ctrl.deleteNodes(ctx, r, existingMachineSetNodes, machineStatusMap, -diff)
+			var machineSetNodes []*omni.MachineSetNode
+
+			if machineSetNodes, err = safe.Map(existingMachineSetNodes, func(m *omni.MachineSetNode) (*omni.MachineSetNode, error) {
+				return m, nil
+			}); err != nil {
+				return err
+			}
+
+			return ctrl.deleteNodes(ctx, r, machineSetNodes, machineStatusMap, spread, -diff)
 		}
 
-		logger.Info("scaling machine set up", zap.Int("pending", diff), zap.String("machine_set", machineSet.Metadata().ID()))
+		if diff > 0 {
+			logger.Info("scaling machine set up", zap.Int("pending", diff), zap.String("machine_set", machineSet.Metadata().ID()))
 
-		return ctrl.createNodes(ctx, r, machineSet, machineClass, allMachineStatuses, diff)
+			if err = ctrl.createNodes(ctx, r, machineSet, machineClass, allMachineStatuses, existingMachineSetNodes, machineStatusMap, spread, diff); err != nil {
+				return err
+			}
+		}
+
+		if len(replacing) > 0 {
+			return ctrl.replaceNodes(ctx, r, existingMachineSetNodes, replacing, machineStatusMap, int(machineClassCfg.MachineCount), logger)
+		}
 	}
 
 	return nil
 }
 
+// getPendingReplacement returns the machine set nodes which cluster machines are marked for the replacement by the surge rolling update.
+func (ctrl *MachineSetNodeController) getPendingReplacement(
+	ctx context.Context,
+	r controller.Runtime,
+	machineSetNodes safe.List[*omni.MachineSetNode],
+) ([]*omni.MachineSetNode, error) {
+	var replacing []*omni.MachineSetNode
+
+	if err := machineSetNodes.ForEachErr(func(machineSetNode *omni.MachineSetNode) error {
+		if machineSetNode.Metadata().Phase() != resource.PhaseRunning {
+			return nil
+		}
+
+		clusterMachine, err := safe.ReaderGetByID[*omni.ClusterMachine](ctx, r, machineSetNode.Metadata().ID())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if _, ok := clusterMachine.Metadata().Annotations().Get(omni.ClusterMachinePendingReplacement); ok {
+			replacing = append(replacing, machineSetNode)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return replacing, nil
+}
+
+// replaceNodes removes the machine set nodes pending the replacement as long as the number of ready machines doesn't go below the machine count.
+func (ctrl *MachineSetNodeController) replaceNodes(
+	ctx context.Context,
+	r controller.Runtime,
+	machineSetNodes safe.List[*omni.MachineSetNode],
+	replacing []*omni.MachineSetNode,
+	machineStatuses map[resource.ID]*omni.MachineStatus,
+	machineCount int,
+	logger *zap.Logger,
+) error {
+	ready := 0
+
+	if err := machineSetNodes.ForEachErr(func(machineSetNode *omni.MachineSetNode) error {
+		if machineSetNode.Metadata().Phase() != resource.PhaseRunning || slices.Contains(replacing, machineSetNode) {
+			return nil
+		}
+
+		clusterMachineStatus, err := safe.ReaderGetByID[*omni.ClusterMachineStatus](ctx, r, machineSetNode.Metadata().ID())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if clusterMachineStatus.TypedSpec().Value.Ready && clusterMachineStatus.TypedSpec().Value.ConfigUpToDate {
+			ready++
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// the machines pending replacement are still serving, so they are counted as ready until removed
+	removable := min(ready+len(replacing)-machineCount, len(replacing))
+	if removable <= 0 {
+		return nil
+	}
+
+	logger.Info("replacing outdated machines", zap.Int("pending", len(replacing)), zap.Int("removable", removable))
+
+	return ctrl.deleteNodes(ctx, r, replacing, machineStatuses, nil, removable)
+}
+
 func (ctrl *MachineSetNodeController) createNodes(
 	ctx context.Context,
 	r controller.Runtime,
 	machineSet *omni.MachineSet,
 	machineClass *omni.MachineClass,
 	allMachineStatuses safe.List[*omni.MachineStatus],
+	existingMachineSetNodes safe.List[*omni.MachineSetNode],
+	machineStatuses map[resource.ID]*omni.MachineStatus,
+	spread *machineset.Spread,
 	count int,
 ) error {
 	selectors, err := labels.ParseSelectors(machineClass.TypedSpec().Value.MatchLabels)
@@ -244,6 +362,17 @@ func (ctrl *MachineSetNodeController) createNodes(
 		return fmt.Errorf("failed to parse talos version of the cluster %w", err)
 	}
 
+	requirements := machineClass.TypedSpec().Value.Requirements
+
+	// the machine class without the labels selects all machines which satisfy the requirements
+	if len(selectors) == 0 && requirements != nil {
+		selectors = resource.LabelQueries{{}}
+	}
+
+	var candidates []*omni.MachineStatus
+
+	seen := map[resource.ID]struct{}{}
+
 	for _, selector := range selectors {
 		selector.Terms = append(selector.Terms, resource.LabelTerm{
 			Key: omni.MachineStatusLabelAvailable,
@@ -255,6 +384,14 @@ func (ctrl *MachineSetNodeController) createNodes(
 		for i := range availableMachineClassMachines.Len() {
 			machine := availableMachineClassMachines.Get(i)
 
+			if _, ok = seen[machine.Metadata().ID()]; ok {
+				continue
+			}
+
+			if len(omni.MachineClassRequirementsMismatches(requirements, machine)) > 0 {
+				continue
+			}
+
 			var machineVersion semver.Version
 
 			machineVersion, err = semver.Parse(strings.TrimPrefix(machine.TypedSpec().Value.TalosVersion, "v"))
@@ -268,21 +405,48 @@ func (ctrl *MachineSetNodeController) createNodes(
 				continue
 			}
 
-			id := machine.Metadata().ID()
+			seen[machine.Metadata().ID()] = struct{}{}
+
+			candidates = append(candidates, machine)
+		}
+	}
 
-			if err := r.Create(ctx, omni.NewMachineSetNode(resources.DefaultNamespace, id, machineSet)); err != nil {
-				if state.IsConflictError(err) {
-					continue
-				}
+	if spread != nil {
+		existingMachineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+			spread.Add(machineStatuses[machineSetNode.Metadata().ID()])
+		})
+	}
 
-				return err
-			}
+	for len(candidates) > 0 {
+		machine := candidates[0]
 
-			created++
-			if created == count {
+		// pick the machine from the least populated failure domain
+		if spread != nil {
+			if machine = spread.Pick(candidates); machine == nil {
 				return nil
 			}
 		}
+
+		candidates = slices.DeleteFunc(candidates, func(candidate *omni.MachineStatus) bool {
+			return candidate == machine
+		})
+
+		if err = r.Create(ctx, omni.NewMachineSetNode(resources.DefaultNamespace, machine.Metadata().ID(), machineSet)); err != nil {
+			if state.IsConflictError(err) {
+				continue
+			}
+
+			return err
+		}
+
+		if spread != nil {
+			spread.Add(machine)
+		}
+
+		created++
+		if created == count {
+			return nil
+		}
 	}
 
 	return nil
@@ -291,38 +455,34 @@ func (ctrl *MachineSetNodeController) createNodes(
 func (ctrl *MachineSetNodeController) deleteNodes(
 	ctx context.Context,
 	r controller.Runtime,
-	machineSetNodes safe.List[*omni.MachineSetNode],
+	machineSetNodes []*omni.MachineSetNode,
 	machineStatuses map[string]*omni.MachineStatus,
+	spread *machineset.Spread,
 	machinesToDestroyCount int,
 ) error {
-	usedMachineSetNodes, err := safe.Map(machineSetNodes, func(m *omni.MachineSetNode) (*omni.MachineSetNode, error) {
-		return m, nil
-	})
-	if err != nil {
-		return err
-	}
-
 	// filter only running used machines
-	xslices.FilterInPlace(usedMachineSetNodes, func(r *omni.MachineSetNode) bool {
+	usedMachineSetNodes := xslices.Filter(machineSetNodes, func(r *omni.MachineSetNode) bool {
 		return r.Metadata().Phase() == resource.PhaseRunning
 	})
 
 	slices.SortStableFunc(usedMachineSetNodes, getSortFunction(machineStatuses))
 
+	if spread != nil {
+		usedMachineSetNodes = orderBySpread(usedMachineSetNodes, machineStatuses, spread)
+	}
+
 	// destroy all machines which are currently in tearing down phase and have no finalizers
-	if err = machineSetNodes.ForEachErr(func(machineSetNode *omni.MachineSetNode) error {
+	for _, machineSetNode := range machineSetNodes {
 		if machineSetNode.Metadata().Phase() == resource.PhaseRunning {
-			return nil
+			continue
 		}
 
 		machinesToDestroyCount--
 		if machineSetNode.Metadata().Finalizers().Empty() {
-			return r.Destroy(ctx, machineSetNode.Metadata())
+			if err := r.Destroy(ctx, machineSetNode.Metadata()); err != nil {
+				return err
+			}
 		}
-
-		return nil
-	}); err != nil {
-		return err
 	}
 
 	iterations := len(usedMachineSetNodes)
@@ -352,6 +512,45 @@ func (ctrl *MachineSetNodeController) deleteNodes(
 	return nil
 }
 
+// orderBySpread keeps the missing and disconnected machines first and orders the rest
+// so that removing them keeps the machine set spread evenly across the failure domains.
+func orderBySpread(machineSetNodes []*omni.MachineSetNode, machineStatuses map[resource.ID]*omni.MachineStatus, spread *machineset.Spread) []*omni.MachineSetNode {
+	var (
+		result  []*omni.MachineSetNode
+		healthy []resource.ID
+	)
+
+	nodes := make(map[resource.ID]*omni.MachineSetNode, len(machineSetNodes))
+
+	for _, machineSetNode := range machineSetNodes {
+		id := machineSetNode.Metadata().ID()
+
+		machineStatus, ok := machineStatuses[id]
+		if !ok {
+			result = append(result, machineSetNode)
+
+			continue
+		}
+
+		if _, disconnected := machineStatus.Metadata().Labels().Get(omni.MachineStatusLabelDisconnected); disconnected {
+			result = append(result, machineSetNode)
+
+			continue
+		}
+
+		spread.Add(machineStatus)
+
+		nodes[id] = machineSetNode
+		healthy = append(healthy, id)
+	}
+
+	for _, id := range spread.OrderRemovals(healthy, machineStatuses) {
+		result = append(result, nodes[id])
+	}
+
+	return result
+}
+
 func getSortFunction(machineStatuses map[resource.ID]*omni.MachineStatus) func(a, b *omni.MachineSetNode) int {
 	return func(a, b *omni.MachineSetNode) int {
 		ms1, ok1 := machineStatuses[a.Metadata().ID()]
@@ -14,6 +14,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/kvutils"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -183,6 +184,204 @@ func (suite *MachineSetNodeSuite) TestReconcile() {
 	assertNoMachineSetNode(machines[4])
 }
 
+func (suite *MachineSetNodeSuite) TestRequirements() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.MachineSetNodeController{}))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineClassStatusController()))
+
+	machines := suite.createMachines(
+		map[string]string{omni.MachineStatusLabelAvailable: ""},
+		map[string]string{omni.MachineStatusLabelAvailable: ""},
+		map[string]string{omni.MachineStatusLabelAvailable: ""},
+	)
+
+	disk := func(diskType string, sizeGB uint64) *specs.MachineStatusSpec_HardwareStatus_BlockDevice {
+		return &specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+			Type: diskType,
+			Size: sizeGB * 1000 * 1000 * 1000,
+		}
+	}
+
+	for i, hardware := range []*specs.MachineStatusSpec_HardwareStatus{
+		{
+			Processors:   []*specs.MachineStatusSpec_HardwareStatus_Processor{{CoreCount: 4}},
+			Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{disk("NVME", 2000), disk("NVME", 2000)},
+		},
+		{
+			Processors:   []*specs.MachineStatusSpec_HardwareStatus_Processor{{CoreCount: 4}},
+			Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{disk("NVME", 2000), disk("HDD", 4000)},
+		},
+		{
+			Processors:   []*specs.MachineStatusSpec_HardwareStatus_Processor{{CoreCount: 1}},
+			Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{disk("NVME", 2000), disk("NVME", 2000)},
+		},
+	} {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machines[i].Metadata(), func(res *omni.MachineStatus) error {
+			res.TypedSpec().Value.Hardware = hardware
+
+			return nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "cluster1")
+	cluster.TypedSpec().Value.TalosVersion = "1.6.0"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	machineClass := newMachineClass()
+	machineClass.TypedSpec().Value.Requirements = &specs.MachineClassSpec_Requirements{
+		MinCores: 2,
+		Disks: []*specs.MachineClassSpec_Requirements_Disk{
+			{
+				Type:      "nvme",
+				MinSizeGb: 1000,
+				Count:     2,
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineClass))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "requirements")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	machineSet.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+	machineSet.TypedSpec().Value.MachineClass = &specs.MachineSetSpec_MachineClass{
+		Name:           machineClass.Metadata().ID(),
+		AllocationType: specs.MachineSetSpec_MachineClass_Unlimited,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineSet))
+
+	assertResource[*omni.MachineSetNode](
+		&suite.OmniSuite,
+		omni.NewMachineSetNode(resources.DefaultNamespace, machines[0].Metadata().ID(), machineSet).Metadata(),
+		func(*omni.MachineSetNode, *assert.Assertions) {},
+	)
+
+	assertResource[*omni.MachineClassStatus](
+		&suite.OmniSuite,
+		omni.NewMachineClassStatus(resources.DefaultNamespace, machineClass.Metadata().ID()).Metadata(),
+		func(res *omni.MachineClassStatus, assertion *assert.Assertions) {
+			assertion.EqualValues(1, res.TypedSpec().Value.MatchingMachines)
+			assertion.Equal([]*specs.MachineClassStatusSpec_Mismatch{
+				{
+					MachineId: machines[1].Metadata().ID(),
+					Reasons:   []string{"has 1 disks matching (type nvme, size >= 1000GB), at least 2 required"},
+				},
+				{
+					MachineId: machines[2].Metadata().ID(),
+					Reasons:   []string{"has 1 CPU cores, at least 2 required"},
+				},
+			}, res.TypedSpec().Value.Mismatches)
+		},
+	)
+
+	for _, machine := range machines[1:] {
+		assertNoResource[*omni.MachineSetNode](
+			&suite.OmniSuite,
+			omni.NewMachineSetNode(resources.DefaultNamespace, machine.Metadata().ID(), machineSet),
+		)
+	}
+}
+
+func (suite *MachineSetNodeSuite) TestTopologySpread() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.MachineSetNodeController{}))
+
+	zoneLabels := func(zone string) map[string]string {
+		return map[string]string{
+			omni.MachineStatusLabelAvailable: "",
+			omni.MachineStatusLabelZone:      zone,
+		}
+	}
+
+	suite.createMachines(
+		zoneLabels("a"),
+		zoneLabels("a"),
+		zoneLabels("a"),
+		zoneLabels("b"),
+		zoneLabels("b"),
+		zoneLabels("c"),
+		map[string]string{omni.MachineStatusLabelAvailable: ""},
+	)
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "cluster1")
+	cluster.TypedSpec().Value.TalosVersion = "1.6.0"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	machineClass := newMachineClass(omni.MachineStatusLabelAvailable)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineClass))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "spread")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	machineSet.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+	machineSet.TypedSpec().Value.MachineClass = &specs.MachineSetSpec_MachineClass{
+		Name:         machineClass.Metadata().ID(),
+		MachineCount: 3,
+		TopologySpread: &specs.MachineSetSpec_MachineClass_TopologySpread{
+			Key:     omni.MachineStatusLabelZone,
+			MaxSkew: 1,
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineSet))
+
+	zoneCounts := func() map[string]int {
+		machineSetNodes, err := safe.StateListAll[*omni.MachineSetNode](suite.ctx, suite.state)
+		suite.Require().NoError(err)
+
+		counts := map[string]int{}
+
+		machineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+			machineStatus, err := safe.StateGetByID[*omni.MachineStatus](suite.ctx, suite.state, machineSetNode.Metadata().ID())
+			suite.Require().NoError(err)
+
+			zone, _ := machineStatus.Metadata().Labels().Get(omni.MachineStatusLabelZone)
+
+			counts[zone]++
+		})
+
+		return counts
+	}
+
+	expectZoneCounts := func(expected map[string]int) {
+		suite.EventuallyWithT(func(collect *assert.CollectT) {
+			assert.Equal(collect, expected, zoneCounts())
+		}, 5*time.Second, 100*time.Millisecond)
+	}
+
+	scale := func(count uint32) {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+			res.TypedSpec().Value.MachineClass.MachineCount = count
+
+			return nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	expectZoneCounts(map[string]int{"a": 1, "b": 1, "c": 1})
+
+	scale(5)
+
+	expectZoneCounts(map[string]int{"a": 2, "b": 2, "c": 1})
+
+	// the last machine in zone a would exceed the max skew, the machine without the zone is never allocated
+	scale(7)
+
+	time.Sleep(time.Second)
+
+	suite.Require().Equal(map[string]int{"a": 2, "b": 2, "c": 1}, zoneCounts())
+
+	scale(3)
+
+	expectZoneCounts(map[string]int{"a": 1, "b": 1, "c": 1})
+}
+
 func TestSortFunction(t *testing.T) {
 	machineStatuses := map[resource.ID]*omni.MachineStatus{}
 	machineSetNodes := make([]*omni.MachineSetNode, 0, 10)
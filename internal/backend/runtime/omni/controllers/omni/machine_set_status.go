@@ -20,6 +20,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/configpatch"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/machineset"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 )
@@ -121,6 +122,28 @@ func NewMachineSetStatusController() *MachineSetStatusController {
 				}, nil
 			},
 		),
+		qtransform.WithExtraMappedInput(
+			// machine status to machine set, if the machine is allocated, the failure domain labels are used to order the teardowns
+			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, machineStatus *omni.MachineStatus) ([]resource.Pointer, error) {
+				clusterMachine, err := r.Get(ctx, omni.NewClusterMachine(resources.DefaultNamespace, machineStatus.Metadata().ID()).Metadata())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return nil, nil
+					}
+
+					return nil, err
+				}
+
+				machineSetID, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+				if !ok {
+					return nil, nil
+				}
+
+				return []resource.Pointer{
+					omni.NewMachineSet(resources.DefaultNamespace, machineSetID).Metadata(),
+				}, nil
+			},
+		),
 		qtransform.WithExtraMappedInput(
 			// config patch to machine set if the machine is allocated, checks by different layers, if is on the cluster layer,
 			// matches all machine sets
@@ -160,6 +183,32 @@ func NewMachineSetStatusController() *MachineSetStatusController {
 				return xslices.Map(list.Items, func(r resource.Resource) resource.Pointer { return r.Metadata() }), nil
 			},
 		),
+		qtransform.WithExtraMappedInput(
+			// config patch library to the machine sets referencing it directly or through the cluster
+			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, library *omni.ConfigPatchLibrary) ([]resource.Pointer, error) {
+				return configpatch.MapLibraryToMachineSets(ctx, r, library)
+			},
+		),
+		qtransform.WithExtraMappedInput(
+			// config patch library version to the machine sets referencing its library
+			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, version *omni.ConfigPatchLibraryVersion) ([]resource.Pointer, error) {
+				libraryID, ok := version.Metadata().Labels().Get(omni.LabelConfigPatchLibrary)
+				if !ok {
+					return nil, nil
+				}
+
+				library, err := safe.ReaderGetByID[*omni.ConfigPatchLibrary](ctx, r, libraryID)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return nil, nil
+					}
+
+					return nil, err
+				}
+
+				return configpatch.MapLibraryToMachineSets(ctx, r, library)
+			},
+		),
 		qtransform.WithExtraOutputs(
 			controller.Output{
 				Type: omni.ClusterMachineType,
@@ -202,6 +251,12 @@ func (handler *machineSetStatusHandler) reconcileRunning(ctx context.Context, r
 		return controller.NewRequeueInterval(requeueInterval)
 	}
 
+	// come back when the maintenance window opens to run the postponed operations
+	if !rc.MaintenanceWindowOpen() && !rc.MaintenanceWindowOpens().IsZero() &&
+		(len(rc.GetMachinesToTeardown()) > 0 || machineset.MaxSurge(machineSet) > 0 && len(rc.GetMachinesToUpdate()) > 0) {
+		return controller.NewRequeueInterval(time.Until(rc.MaintenanceWindowOpens()))
+	}
+
 	return nil
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -250,6 +251,7 @@ func (suite *MachineSetStatusSuite) SetupTest() {
 	suite.startRuntime()
 
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.ConfigPatchLibraryVersionController{}))
 
 	// create siderolink config as it's endpoint is used while generating kubernetes endpoint
 	siderolink := siderolink.NewConfig(resources.DefaultNamespace)
@@ -562,6 +564,153 @@ func (suite *MachineSetStatusSuite) TestConfigUpdate() {
 	)
 }
 
+// TestConfigPatchLibraries tests that the config patch libraries referenced by the cluster and the machine set are resolved and updated.
+func (suite *MachineSetStatusSuite) TestConfigPatchLibraries() {
+	clusterName := "library-party"
+
+	machines := []string{
+		"library1",
+		"library2",
+	}
+
+	ntpLibrary := omni.NewConfigPatchLibrary(resources.DefaultNamespace, "ntp")
+	ntpLibrary.TypedSpec().Value.Data = `machine:
+  time:
+    servers: [time.cloudflare.com]`
+
+	sysctlsLibrary := omni.NewConfigPatchLibrary(resources.DefaultNamespace, "sysctls")
+	sysctlsLibrary.Metadata().Labels().Set("library", "workers")
+	sysctlsLibrary.TypedSpec().Value.Data = `machine:
+  sysctls:
+    net.core.somaxconn: "65535"`
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, ntpLibrary))
+	suite.Require().NoError(suite.state.Create(suite.ctx, sysctlsLibrary))
+
+	machineSet := suite.createMachineSet(clusterName, "machine-set-libraries", machines, `machine:
+  install:
+    disk: /dev/vdb`)
+
+	suite.assertMachinesState(machines, clusterName, machineSet.Metadata().ID())
+
+	suite.updateStage(machines, specs.ClusterMachineStatusSpec_RUNNING, true)
+	suite.syncConfig(machines)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewCluster(resources.DefaultNamespace, clusterName).Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.ConfigPatchLibraries = []*specs.ConfigPatchLibraryRef{{Name: "ntp"}}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.ConfigPatchLibraries = []*specs.ConfigPatchLibraryRef{{MatchLabels: []string{"library=workers"}}}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	// the libraries go before the patches of the same level, the machines are updated one by one
+	for _, machine := range machines {
+		suite.assertMachinePatches([]string{machine}, func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+			patches := res.TypedSpec().Value.Patches
+
+			if assertions.Len(patches, 4) {
+				assertions.Contains(patches[0], "time.cloudflare.com")
+				assertions.Contains(patches[1], "kubespan")
+				assertions.Contains(patches[2], "somaxconn")
+				assertions.Contains(patches[3], "/dev/vdb")
+			}
+		})
+
+		suite.syncConfig([]string{machine})
+	}
+
+	// the library update is rolled out to the machines
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, ntpLibrary.Metadata(), func(res *omni.ConfigPatchLibrary) error {
+		res.TypedSpec().Value.Data = `machine:
+  time:
+    servers: [time.google.com]`
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	for _, machine := range machines {
+		suite.assertMachinePatches([]string{machine}, func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+			patches := res.TypedSpec().Value.Patches
+
+			if assertions.Len(patches, 4) {
+				assertions.Contains(patches[0], "time.google.com")
+			}
+		})
+
+		suite.syncConfig([]string{machine})
+	}
+
+	// the previous version of the library is kept, so the cluster can be pinned to it
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{
+		omni.ConfigPatchLibraryVersionID("ntp", 1),
+		omni.ConfigPatchLibraryVersionID("ntp", 2),
+	}, func(*omni.ConfigPatchLibraryVersion, *assert.Assertions) {})
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewCluster(resources.DefaultNamespace, clusterName).Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.ConfigPatchLibraries = []*specs.ConfigPatchLibraryRef{{Name: "ntp", Version: 1}}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	for _, machine := range machines {
+		suite.assertMachinePatches([]string{machine}, func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+			patches := res.TypedSpec().Value.Patches
+
+			if assertions.Len(patches, 4) {
+				assertions.Contains(patches[0], "time.cloudflare.com")
+			}
+		})
+
+		suite.syncConfig([]string{machine})
+	}
+
+	// the library updates are not rolled out to the machines using the pinned version
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, ntpLibrary.Metadata(), func(res *omni.ConfigPatchLibrary) error {
+		res.TypedSpec().Value.Data = `machine:
+  time:
+    servers: [pool.ntp.org]`
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []resource.ID{omni.ConfigPatchLibraryVersionID("ntp", 3)},
+		func(res *omni.ConfigPatchLibraryVersion, assertions *assert.Assertions) {
+			assertions.Contains(res.TypedSpec().Value.Data, "pool.ntp.org")
+		},
+	)
+
+	suite.assertMachinePatches(machines, func(res *omni.ClusterMachineConfigPatches, assertions *assert.Assertions) {
+		patches := res.TypedSpec().Value.Patches
+
+		if assertions.Len(patches, 4) {
+			assertions.Contains(patches[0], "time.cloudflare.com")
+		}
+	})
+
+	// the versions are removed together with the library
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewCluster(resources.DefaultNamespace, clusterName).Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.ConfigPatchLibraries = nil
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.Destroy[*omni.ConfigPatchLibrary](suite.ctx, suite.T(), suite.state, []resource.ID{ntpLibrary.Metadata().ID()})
+
+	rtestutils.AssertNoResource[*omni.ConfigPatchLibraryVersion](suite.ctx, suite.T(), suite.state, omni.ConfigPatchLibraryVersionID("ntp", 1))
+	rtestutils.AssertNoResource[*omni.ConfigPatchLibraryVersion](suite.ctx, suite.T(), suite.state, omni.ConfigPatchLibraryVersionID("ntp", 3))
+}
+
 // TestConfigUpdateWithMaxParallelism tests if config updates are batched correctly when max parallelism is set in a worker machine set.
 func (suite *MachineSetStatusSuite) TestConfigUpdateWithMaxParallelism() {
 	clusterName := "cluster-test-update-max-parallelism"
@@ -758,6 +907,66 @@ func (suite *MachineSetStatusSuite) TestTeardown() {
 	}
 }
 
+// TestTeardownOutsideMaintenanceWindow verifies that the machine set is destroyed even if its maintenance window is closed.
+func (suite *MachineSetStatusSuite) TestTeardownOutsideMaintenanceWindow() {
+	clusterName := "teardown-maintenance-window"
+
+	machines := []string{
+		"window1",
+		"window2",
+	}
+
+	machineSet := suite.createMachineSet(clusterName, "machine-set-teardown-maintenance-window", machines)
+	suite.assertMachinesState(machines, clusterName, machineSet.Metadata().ID())
+
+	suite.updateStage(machines, specs.ClusterMachineStatusSpec_RUNNING, true)
+	suite.syncConfig(machines)
+	suite.assertMachineSetPhase(machineSet, specs.MachineSetPhase_Running)
+
+	// the window is open for a minute once a year
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.MaintenanceWindow = &specs.MaintenanceWindow{
+			Schedule: "0 0 1 1 *",
+			Duration: durationpb.New(time.Minute),
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	// scaling down is postponed until the window opens
+	rtestutils.Destroy[*omni.MachineSetNode](suite.ctx, suite.T(), suite.state, []string{machines[0]})
+
+	suite.assertMachineSetPhase(machineSet, specs.MachineSetPhase_ScalingDown)
+
+	suite.assertMachines(machines, func(machine *omni.ClusterMachine, assertions *assert.Assertions) {
+		assertions.Equal(resource.PhaseRunning, machine.Metadata().Phase())
+	})
+
+	// destroying the machine set is not
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			ready, err := suite.state.Teardown(suite.ctx, machineSet.Metadata())
+			if err != nil {
+				return err
+			}
+
+			if !ready {
+				return retry.ExpectedErrorf("the machine set is not ready to be destroyed yet")
+			}
+
+			return nil
+		},
+	))
+
+	for _, machine := range machines {
+		rtestutils.AssertNoResource[*omni.ClusterMachine](suite.ctx, suite.T(), suite.state, machine)
+	}
+
+	suite.Assert().NoError(suite.state.Destroy(suite.ctx, machineSet.Metadata()))
+	rtestutils.Destroy[*omni.MachineSetNode](suite.ctx, suite.T(), suite.state, machines[1:])
+}
+
 // TestMachineLocks verifies machine locks block patch updates, deletions, do not block cluster deletion.
 func (suite *MachineSetStatusSuite) TestMachineLocks() {
 	clusterName := "cluster-locks"
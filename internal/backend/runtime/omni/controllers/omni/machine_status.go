@@ -21,6 +21,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/task"
@@ -76,6 +77,16 @@ func (ctrl *MachineStatusController) Inputs() []controller.Input {
 			Type:      omni.MachineLabelsType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: siderolink.Namespace,
+			Type:      siderolink.LinkType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: siderolink.Namespace,
+			Type:      siderolink.JoinTokenType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -231,6 +242,12 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 	}
 
 	for id := range machines {
+		var targetLabels map[string]string
+
+		if targetLabels, err = ctrl.joinTokenTargetLabels(ctx, r, id); err != nil {
+			return err
+		}
+
 		if err = safe.WriterModify(ctx, r, omni.NewMachineStatus(resources.DefaultNamespace, id), func(m *omni.MachineStatus) error {
 			spec := m.TypedSpec().Value
 
@@ -269,7 +286,27 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 
 			omni.MachineStatusReconcileLabels(m)
 
-			return ctrl.setClusterRelation(clusterMachine, m)
+			for _, key := range []string{omni.LabelTargetCluster, omni.LabelTargetMachineClass} {
+				if value, ok := targetLabels[key]; ok {
+					m.Metadata().Labels().Set(key, value)
+				} else {
+					m.Metadata().Labels().Delete(key)
+				}
+			}
+
+			if err = ctrl.setClusterRelation(clusterMachine, m); err != nil {
+				return err
+			}
+
+			// the machines which are not accepted yet can't be allocated to the clusters
+			if _, pending := machines[id].Metadata().Labels().Get(omni.MachineStatusLabelPendingAcceptance); pending {
+				m.Metadata().Labels().Set(omni.MachineStatusLabelPendingAcceptance, "")
+				m.Metadata().Labels().Delete(omni.MachineStatusLabelAvailable)
+			} else {
+				m.Metadata().Labels().Delete(omni.MachineStatusLabelPendingAcceptance)
+			}
+
+			return nil
 		}); err != nil && !cosistate.IsPhaseConflictError(err) {
 			return err
 		}
@@ -278,6 +315,44 @@ func (ctrl *MachineStatusController) reconcileCollectors(ctx context.Context, r
 	return nil
 }
 
+// joinTokenTargetLabels returns the labels of the cluster and the machine class the machine is meant for by the join token it has joined with.
+func (ctrl *MachineStatusController) joinTokenTargetLabels(ctx context.Context, r controller.Reader, id resource.ID) (map[string]string, error) {
+	labels := map[string]string{}
+
+	link, err := safe.ReaderGetByID[*siderolink.Link](ctx, r, id)
+	if err != nil {
+		if cosistate.IsNotFoundError(err) {
+			return labels, nil
+		}
+
+		return nil, err
+	}
+
+	joinTokenID := link.TypedSpec().Value.JoinTokenId
+	if joinTokenID == "" {
+		return labels, nil
+	}
+
+	joinToken, err := safe.ReaderGetByID[*siderolink.JoinToken](ctx, r, joinTokenID)
+	if err != nil {
+		if cosistate.IsNotFoundError(err) {
+			return labels, nil
+		}
+
+		return nil, err
+	}
+
+	if cluster := joinToken.TypedSpec().Value.Cluster; cluster != "" {
+		labels[omni.LabelTargetCluster] = cluster
+	}
+
+	if machineClass := joinToken.TypedSpec().Value.MachineClass; machineClass != "" {
+		labels[omni.LabelTargetMachineClass] = machineClass
+	}
+
+	return labels, nil
+}
+
 func (ctrl *MachineStatusController) mergeLabels(m *omni.MachineStatus, machineLabels *omni.MachineLabels) map[string]string {
 	labels := map[string]string{}
 
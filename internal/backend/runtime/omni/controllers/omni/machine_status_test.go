@@ -25,6 +25,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/meta"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
 	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 )
@@ -112,6 +113,49 @@ func (suite *MachineStatusSuite) TestMachineConnected() {
 	})
 }
 
+func (suite *MachineStatusSuite) TestMachineJoinTokenLabels() {
+	suite.setup()
+
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	joinToken := siderolink.NewJoinToken(siderolink.Namespace, "cluster-a")
+	joinToken.TypedSpec().Value.Cluster = "cluster-a"
+
+	suite.Require().NoError(suite.state.Create(ctx, joinToken))
+
+	link := siderolink.NewLink(siderolink.Namespace, testID, &specs.SiderolinkSpec{JoinTokenId: joinToken.Metadata().ID()})
+
+	suite.Require().NoError(suite.state.Create(ctx, link))
+	suite.Require().NoError(suite.state.Create(ctx, omni.NewMachine(resources.DefaultNamespace, testID)))
+
+	rtestutils.AssertResource(ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		cluster, ok := status.Metadata().Labels().Get(omni.LabelTargetCluster)
+		assert.True(ok)
+		assert.Equal("cluster-a", cluster)
+
+		_, ok = status.Metadata().Labels().Get(omni.LabelTargetMachineClass)
+		assert.False(ok)
+	})
+
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, joinToken.Metadata(), func(res *siderolink.JoinToken) error {
+		res.TypedSpec().Value.Cluster = ""
+		res.TypedSpec().Value.MachineClass = "workers"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource(ctx, suite.T(), suite.state, testID, func(status *omni.MachineStatus, assert *assert.Assertions) {
+		_, ok := status.Metadata().Labels().Get(omni.LabelTargetCluster)
+		assert.False(ok)
+
+		machineClass, ok := status.Metadata().Labels().Get(omni.LabelTargetMachineClass)
+		assert.True(ok)
+		assert.Equal("workers", machineClass)
+	})
+}
+
 func (suite *MachineStatusSuite) TestMachineReportingEvents() {
 	suite.setup()
 
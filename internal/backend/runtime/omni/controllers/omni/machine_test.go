@@ -9,6 +9,7 @@ import (
 	"net/netip"
 	"testing"
 
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -59,6 +60,22 @@ func (suite *MachineSuite) TestReconcile() {
 			require.Equal(suite.T(), "fdae:41e4:649b:9303:648e:f4a7:8ca4:ac75", res.TypedSpec().Value.ManagementAddress)
 		},
 	)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, link2.Metadata(), func(res *siderolink.Link) error {
+		res.Metadata().Labels().Set(omni.MachineStatusLabelPendingAcceptance, "")
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewMachine(resources.DefaultNamespace, link2.Metadata().ID()).Metadata(),
+		func(res *omni.Machine, assertions *assert.Assertions) {
+			_, pending := res.Metadata().Labels().Get(omni.MachineStatusLabelPendingAcceptance)
+			assertions.True(pending)
+		},
+	)
 }
 
 func TestMachineSuite(t *testing.T) {
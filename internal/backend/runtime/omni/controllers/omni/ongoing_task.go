@@ -44,6 +44,16 @@ func (ctrl *OngoingTaskController) Inputs() []controller.Input {
 			Namespace: resources.DefaultNamespace,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Type:      omni.EtcdRestoreStatusType,
+			Namespace: resources.DefaultNamespace,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Type:      omni.ClusterMachineDrainStatusType,
+			Namespace: resources.DefaultNamespace,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -83,6 +93,16 @@ func (ctrl *OngoingTaskController) Run(ctx context.Context, r controller.Runtime
 			return err
 		}
 
+		etcdRestoreStatuses, err := safe.ReaderListAll[*omni.EtcdRestoreStatus](ctx, r)
+		if err != nil {
+			return err
+		}
+
+		drainStatuses, err := safe.ReaderListAll[*omni.ClusterMachineDrainStatus](ctx, r)
+		if err != nil {
+			return err
+		}
+
 		if err = destroyStatuses.ForEachErr(func(s *omni.ClusterDestroyStatus) error {
 			id := fmt.Sprintf("%s-destroy", s.Metadata().ID())
 
@@ -148,6 +168,54 @@ func (ctrl *OngoingTaskController) Run(ctx context.Context, r controller.Runtime
 			return err
 		}
 
+		if err = etcdRestoreStatuses.ForEachErr(func(s *omni.EtcdRestoreStatus) error {
+			switch s.TypedSpec().Value.Phase {
+			case specs.EtcdRestoreStatusSpec_Resetting, specs.EtcdRestoreStatusSpec_Recovering:
+			case specs.EtcdRestoreStatusSpec_Unknown, specs.EtcdRestoreStatusSpec_Done, specs.EtcdRestoreStatusSpec_Failed:
+				return nil
+			}
+
+			id := fmt.Sprintf("%s-etcd-restore", s.Metadata().ID())
+
+			task := omni.NewOngoingTask(resources.EphemeralNamespace, id)
+
+			tracker.keep(task)
+
+			return safe.WriterModify(ctx, r, task, func(res *omni.OngoingTask) error {
+				res.TypedSpec().Value.Title = "Restoring etcd of Cluster " + s.Metadata().ID()
+				res.TypedSpec().Value.Details = &specs.OngoingTaskSpec_EtcdRestore{
+					EtcdRestore: s.TypedSpec().Value,
+				}
+
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+
+		if err = drainStatuses.ForEachErr(func(s *omni.ClusterMachineDrainStatus) error {
+			if s.TypedSpec().Value.Phase != specs.ClusterMachineDrainStatusSpec_DRAINING {
+				return nil
+			}
+
+			id := fmt.Sprintf("%s-drain", s.Metadata().ID())
+
+			task := omni.NewOngoingTask(resources.EphemeralNamespace, id)
+
+			tracker.keep(task)
+
+			return safe.WriterModify(ctx, r, task, func(res *omni.OngoingTask) error {
+				res.TypedSpec().Value.Title = "Draining Node " + s.TypedSpec().Value.Nodename
+				res.TypedSpec().Value.Details = &specs.OngoingTaskSpec_Drain{
+					Drain: s.TypedSpec().Value,
+				}
+
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+
 		if err = tracker.cleanup(ctx); err != nil {
 			return err
 		}
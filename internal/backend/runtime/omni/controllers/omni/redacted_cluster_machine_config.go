@@ -45,17 +45,12 @@ func NewRedactedClusterMachineConfigController() *RedactedClusterMachineConfigCo
 					return nil
 				}
 
-				config, err := configloader.NewFromBytes(cmc.TypedSpec().Value.GetData())
+				redactedData, err := redactMachineConfig(data)
 				if err != nil {
 					return err
 				}
 
-				redactedData, err := config.RedactSecrets(x509.Redacted).EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
-				if err != nil {
-					return err
-				}
-
-				cmcr.TypedSpec().Value.Data = string(redactedData)
+				cmcr.TypedSpec().Value.Data = redactedData
 
 				helpers.CopyAllLabels(cmc, cmcr)
 
@@ -64,3 +59,18 @@ func NewRedactedClusterMachineConfigController() *RedactedClusterMachineConfigCo
 		},
 	)
 }
+
+// redactMachineConfig removes the secrets from the machine config.
+func redactMachineConfig(data []byte) (string, error) {
+	config, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return "", err
+	}
+
+	redactedData, err := config.RedactSecrets(x509.Redacted).EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return "", err
+	}
+
+	return string(redactedData), nil
+}
@@ -84,6 +84,10 @@ func (m *mockBackupStore) Upload(context.Context, etcdbackup.Description, io.Rea
 	return nil
 }
 
+func (m *mockBackupStore) Remove(context.Context, string, string) error {
+	return nil
+}
+
 func (m *mockBackupStore) Download(context.Context, []byte, string, string) (etcdbackup.BackupData, io.ReadCloser, error) {
 	return etcdbackup.BackupData{
 		AESCBCEncryptionSecret:    "aes-cbc-test",
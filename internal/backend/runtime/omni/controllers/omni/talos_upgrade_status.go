@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
@@ -19,6 +20,7 @@ import (
 	"github.com/siderolabs/gen/xerrors"
 	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -127,6 +129,12 @@ func NewTalosUpgradeStatusController() *TalosUpgradeStatusController {
 		qtransform.WithExtraMappedInput(
 			mappers.MapByClusterLabel[*omni.SchematicConfiguration, *omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.KubernetesStatus, *omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabel[*omni.ControlPlaneStatus, *omni.Cluster](),
+		),
 		qtransform.WithExtraMappedInput(
 			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, cmcs *omni.ClusterMachineConfigStatus) ([]resource.Pointer, error) {
 				cm, err := safe.ReaderGetByID[*omni.ClusterMachine](ctx, r, cmcs.Metadata().ID())
@@ -229,6 +237,16 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 	machineSetNodes safe.List[*omni.MachineSetNode],
 ) error {
 	talosVersion := cluster.TypedSpec().Value.TalosVersion
+	previousUpgradeVersion := upgradeStatus.TypedSpec().Value.CurrentUpgradeVersion
+
+	if upgradeStatus.TypedSpec().Value.FailedUpgradeVersion != talosVersion {
+		upgradeStatus.TypedSpec().Value.FailedUpgradeVersion = ""
+	}
+
+	// the upgrade which has failed the health check is rolled back to the last successful version until the cluster Talos version is changed
+	if upgradeStatus.TypedSpec().Value.FailedUpgradeVersion != "" && upgradeStatus.TypedSpec().Value.LastUpgradeVersion != "" {
+		talosVersion = upgradeStatus.TypedSpec().Value.LastUpgradeVersion
+	}
 
 	// if not reverting to the previous successful version, perform pre-checks on each step
 	versionMismatch := talosVersion != upgradeStatus.TypedSpec().Value.LastUpgradeVersion
@@ -333,13 +351,33 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 
 	upgradeStatus.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
 
+	if policy := cluster.TypedSpec().Value.TalosUpgradePolicy; policy != nil && versionMismatch {
+		if previousUpgradeVersion != talosVersion || len(upgradeStatus.TypedSpec().Value.Stages) == 0 {
+			upgradeStatus.TypedSpec().Value.Stages = planUpgradeStages(policy, clusterMachines)
+		}
+
+		var done bool
+
+		done, err = reconcileUpgradeStages(ctx, r, logger, upgradeStatus, cluster, clusterStatus, policy, machinesToUpdate, machineSetNodes, configsReady)
+		if err != nil || !done {
+			return err
+		}
+	}
+
 	if len(machinesToUpdate) == 0 {
 		upgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Done
 		upgradeStatus.TypedSpec().Value.Status = ""
 		upgradeStatus.TypedSpec().Value.Step = ""
+		upgradeStatus.TypedSpec().Value.Error = ""
 		upgradeStatus.TypedSpec().Value.CurrentUpgradeVersion = ""
 		upgradeStatus.TypedSpec().Value.LastUpgradeVersion = talosVersion
 
+		if upgradeStatus.TypedSpec().Value.FailedUpgradeVersion != "" {
+			upgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Failed
+			upgradeStatus.TypedSpec().Value.Error = fmt.Sprintf("the upgrade to %s has failed the health check and was rolled back",
+				upgradeStatus.TypedSpec().Value.FailedUpgradeVersion)
+		}
+
 		// upgrade is done, so calculate the upgrade versions
 		upgradeStatus.TypedSpec().Value.UpgradeVersions, err = talos.CalculateUpgradeVersions(
 			ctx, r, upgradeStatus.TypedSpec().Value.LastUpgradeVersion, cluster.TypedSpec().Value.KubernetesVersion)
@@ -560,3 +598,245 @@ func updateSchematicsFinalizers(ctx context.Context, r controller.ReaderWriter,
 		return nil
 	})
 }
+
+const (
+	upgradeStageCanary           = "canary"
+	upgradeStageWorkers          = "workers"
+	upgradeStageControlPlanes    = "control-planes"
+	upgradeStageRemainingWorkers = "remaining-workers"
+
+	defaultUpgradeHealthTimeout = 10 * time.Minute
+	upgradeHealthCheckInterval  = 15 * time.Second
+)
+
+// planUpgradeStages splits the cluster machines into the staged upgrade stages.
+func planUpgradeStages(policy *specs.TalosUpgradePolicy, clusterMachines safe.List[*omni.ClusterMachine]) []*specs.TalosUpgradeStatusSpec_Stage {
+	var controlPlanes, workers []*omni.ClusterMachine
+
+	clusterMachines.ForEach(func(machine *omni.ClusterMachine) {
+		if machine.Metadata().Phase() == resource.PhaseTearingDown {
+			return
+		}
+
+		if _, ok := machine.Metadata().Labels().Get(omni.LabelControlPlaneRole); ok {
+			controlPlanes = append(controlPlanes, machine)
+
+			return
+		}
+
+		workers = append(workers, machine)
+	})
+
+	// the machines are upgraded in the order they were created, keep the same order in the stages
+	for _, machines := range [][]*omni.ClusterMachine{controlPlanes, workers} {
+		slices.SortStableFunc(machines, func(a, b *omni.ClusterMachine) int {
+			return a.Metadata().Created().Compare(b.Metadata().Created())
+		})
+	}
+
+	workersCount := len(workers)
+
+	if percentage := policy.GetWorkersPercentage(); percentage > 0 && percentage < 100 {
+		workersCount = max((len(workers)*int(percentage)+99)/100, 1)
+	}
+
+	workersCount = min(workersCount, len(workers))
+
+	var stages []*specs.TalosUpgradeStatusSpec_Stage
+
+	for _, stage := range []struct {
+		name     string
+		machines []*omni.ClusterMachine
+	}{
+		{name: upgradeStageCanary, machines: workers[:min(1, workersCount)]},
+		{name: upgradeStageWorkers, machines: workers[min(1, workersCount):workersCount]},
+		{name: upgradeStageControlPlanes, machines: controlPlanes},
+		{name: upgradeStageRemainingWorkers, machines: workers[workersCount:]},
+	} {
+		if len(stage.machines) == 0 {
+			continue
+		}
+
+		stages = append(stages, &specs.TalosUpgradeStatusSpec_Stage{
+			Name: stage.name,
+			Machines: xslices.Map(stage.machines, func(machine *omni.ClusterMachine) string {
+				return machine.Metadata().ID()
+			}),
+		})
+	}
+
+	return stages
+}
+
+// reconcileUpgradeStages upgrades the machines of the first stage which hasn't passed yet and runs the health check once they are upgraded.
+//
+// Returns true if all stages have passed.
+//
+//nolint:gocognit,gocyclo,cyclop
+func reconcileUpgradeStages(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger,
+	upgradeStatus *omni.TalosUpgradeStatus, cluster *omni.Cluster, clusterStatus *omni.ClusterStatus, policy *specs.TalosUpgradePolicy,
+	machinesToUpdate []*omni.ClusterMachineTalosVersion, machineSetNodes safe.List[*omni.MachineSetNode], configsReady bool,
+) (bool, error) {
+	spec := upgradeStatus.TypedSpec().Value
+
+	planned := map[resource.ID]struct{}{}
+
+	for _, stage := range spec.Stages {
+		for _, id := range stage.Machines {
+			planned[id] = struct{}{}
+		}
+	}
+
+	healthTimeout := policy.GetHealthTimeout().AsDuration()
+	if healthTimeout == 0 {
+		healthTimeout = defaultUpgradeHealthTimeout
+	}
+
+	for i, stage := range spec.Stages {
+		if stage.Phase == specs.TalosUpgradeStatusSpec_Stage_Passed {
+			continue
+		}
+
+		lastStage := i == len(spec.Stages)-1
+
+		// the machines which were added to the cluster after the upgrade has started are upgraded in the last stage
+		stageMachines := xslices.Filter(machinesToUpdate, func(machine *omni.ClusterMachineTalosVersion) bool {
+			_, isPlanned := planned[machine.Metadata().ID()]
+
+			return slices.Contains(stage.Machines, machine.Metadata().ID()) || (lastStage && !isPlanned)
+		})
+
+		if len(stageMachines) > 0 {
+			stage.Phase = specs.TalosUpgradeStatusSpec_Stage_Upgrading
+			stage.Error = ""
+			stage.HealthCheckStarted = nil
+
+			spec.Phase = specs.TalosUpgradeStatusSpec_Upgrading
+			spec.Error = ""
+
+			if clusterStatus.TypedSpec().Value.Phase != specs.ClusterStatusSpec_RUNNING || !clusterStatus.TypedSpec().Value.Ready || !configsReady {
+				spec.Step = "update paused"
+				spec.Status = "waiting for the cluster to be ready"
+
+				return false, nil
+			}
+
+			spec.Status = fmt.Sprintf("stage %d/%d %s: updating machines %d/%d",
+				i+1, len(spec.Stages), stage.Name, len(stage.Machines)-len(stageMachines)+1, max(len(stage.Machines), len(stageMachines)))
+
+			machine, err := updateMachines(ctx, r, logger, upgradeStatus, stageMachines, machineSetNodes)
+			if err != nil {
+				if errors.Is(err, errMachineLocked) {
+					return false, nil
+				}
+
+				return false, err
+			}
+
+			spec.Step = fmt.Sprintf("current machine %s", machine)
+
+			return false, nil
+		}
+
+		if stage.HealthCheckStarted == nil {
+			stage.HealthCheckStarted = timestamppb.Now()
+		}
+
+		if stage.Phase != specs.TalosUpgradeStatusSpec_Stage_Failed {
+			stage.Phase = specs.TalosUpgradeStatusSpec_Stage_HealthCheck
+		}
+
+		err := checkUpgradeHealth(ctx, r, cluster, clusterStatus)
+		if err == nil {
+			logger.Info("upgrade stage has passed the health check", zap.String("stage", stage.Name))
+
+			stage.Phase = specs.TalosUpgradeStatusSpec_Stage_Passed
+			stage.Error = ""
+
+			spec.Phase = specs.TalosUpgradeStatusSpec_Upgrading
+			spec.Error = ""
+
+			continue
+		}
+
+		stage.Error = err.Error()
+
+		spec.Status = fmt.Sprintf("stage %d/%d %s: waiting for the cluster to become healthy", i+1, len(spec.Stages), stage.Name)
+		spec.Step = err.Error()
+
+		if time.Since(stage.HealthCheckStarted.AsTime()) < healthTimeout {
+			return false, controller.NewRequeueInterval(upgradeHealthCheckInterval)
+		}
+
+		if stage.Phase != specs.TalosUpgradeStatusSpec_Stage_Failed {
+			logger.Warn("upgrade stage has failed the health check", zap.String("stage", stage.Name), zap.Error(err),
+				zap.Stringer("action", policy.GetOnFailure()))
+		}
+
+		stage.Phase = specs.TalosUpgradeStatusSpec_Stage_Failed
+
+		if policy.GetOnFailure() == specs.TalosUpgradePolicy_Rollback && spec.LastUpgradeVersion != "" {
+			spec.Phase = specs.TalosUpgradeStatusSpec_Reverting
+			spec.FailedUpgradeVersion = cluster.TypedSpec().Value.TalosVersion
+			spec.Error = fmt.Sprintf("stage %s has failed the health check, rolling back to %s: %s", stage.Name, spec.LastUpgradeVersion, err)
+
+			// the next reconcile picks up the rollback
+			return false, controller.NewRequeueInterval(time.Second)
+		}
+
+		// the rollout resumes as soon as the cluster becomes healthy again
+		spec.Phase = specs.TalosUpgradeStatusSpec_Failed
+		spec.Error = fmt.Sprintf("upgrade paused, stage %s has failed the health check: %s", stage.Name, err)
+
+		return false, controller.NewRequeueInterval(upgradeHealthCheckInterval)
+	}
+
+	return true, nil
+}
+
+// checkUpgradeHealth checks that the cluster is ready, all Kubernetes nodes are ready and etcd is healthy.
+//
+// The etcd health is read from the control plane status, which runs the etcd check.
+func checkUpgradeHealth(ctx context.Context, r controller.Reader, cluster *omni.Cluster, clusterStatus *omni.ClusterStatus) error {
+	if clusterStatus.TypedSpec().Value.Phase != specs.ClusterStatusSpec_RUNNING || !clusterStatus.TypedSpec().Value.Ready {
+		return errors.New("the cluster is not ready")
+	}
+
+	kubernetesStatus, err := safe.ReaderGetByID[*omni.KubernetesStatus](ctx, r, cluster.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return errors.New("the Kubernetes status is not available")
+		}
+
+		return err
+	}
+
+	for _, node := range kubernetesStatus.TypedSpec().Value.Nodes {
+		if !node.Ready {
+			return fmt.Errorf("the Kubernetes node %q is not ready", node.Nodename)
+		}
+	}
+
+	controlPlaneStatus, err := safe.ReaderGetByID[*omni.ControlPlaneStatus](ctx, r, omni.ControlPlanesResourceID(cluster.Metadata().ID()))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return errors.New("the etcd status is not available")
+		}
+
+		return err
+	}
+
+	for _, condition := range controlPlaneStatus.TypedSpec().Value.Conditions {
+		if condition.Type != specs.ConditionType_Etcd {
+			continue
+		}
+
+		if condition.Status != specs.ControlPlaneStatusSpec_Condition_Ready {
+			return fmt.Errorf("etcd is not healthy: %s", condition.Reason)
+		}
+
+		return nil
+	}
+
+	return errors.New("the etcd status is not available")
+}
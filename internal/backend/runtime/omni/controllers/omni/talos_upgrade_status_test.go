@@ -8,13 +8,16 @@ package omni_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -320,6 +323,166 @@ func (suite *TalosUpgradeStatusSuite) TestReconcileLocked() {
 	)
 }
 
+func (suite *TalosUpgradeStatusSuite) TestStagedUpgradeRollback() {
+	suite.startRuntime()
+
+	clusterName := "talos-upgrade-staged"
+
+	cluster, machines := suite.createCluster(clusterName, 1, 3)
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.Available = true
+	clusterStatus.TypedSpec().Value.Ready = true
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+
+	kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, clusterName)
+	kubernetesStatus.TypedSpec().Value.Nodes = []*specs.KubernetesStatusSpec_NodeStatus{
+		{Nodename: "node-1", Ready: true},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, kubernetesStatus))
+
+	controlPlaneStatus := omni.NewControlPlaneStatus(resources.DefaultNamespace, omni.ControlPlanesResourceID(clusterName))
+	controlPlaneStatus.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	controlPlaneStatus.TypedSpec().Value.SetCondition(specs.ConditionType_Etcd, specs.ControlPlaneStatusSpec_Condition_Ready, specs.ControlPlaneStatusSpec_Condition_Info, "")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, controlPlaneStatus))
+
+	for _, res := range machines {
+		configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, res.Metadata().ID())
+
+		helpers.CopyAllLabels(res, configStatus)
+
+		configStatus.TypedSpec().Value.ClusterMachineConfigSha256 = "aaaa"
+		configStatus.TypedSpec().Value.TalosVersion = cluster.TypedSpec().Value.TalosVersion
+		configStatus.TypedSpec().Value.SchematicId = defaultSchematic
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	}
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController()))
+
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterName).Metadata(),
+		func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+			assertions.Equal(specs.TalosUpgradeStatusSpec_Done, res.TypedSpec().Value.Phase)
+		},
+	)
+
+	// simulates the machines being upgraded to the desired version
+	syncConfigStatuses := func() {
+		versions, err := safe.StateListAll[*omni.ClusterMachineTalosVersion](suite.ctx, suite.state, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterName)))
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(versions.ForEachErr(func(version *omni.ClusterMachineTalosVersion) error {
+			_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, version.Metadata().ID()).Metadata(),
+				func(res *omni.ClusterMachineConfigStatus) error {
+					res.TypedSpec().Value.TalosVersion = version.TypedSpec().Value.TalosVersion
+
+					return nil
+				},
+			)
+
+			return err
+		}))
+	}
+
+	assertVersions := func(expected ...string) {
+		for i, res := range machines {
+			assertResource(
+				&suite.OmniSuite,
+				*omni.NewClusterMachineTalosVersion(resources.DefaultNamespace, res.Metadata().ID()).Metadata(),
+				func(version *omni.ClusterMachineTalosVersion, assertions *assert.Assertions) {
+					assertions.Equal(expected[i], version.TypedSpec().Value.TalosVersion, res.Metadata().ID())
+				},
+			)
+		}
+	}
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.TalosVersion = "1.3.6"
+		res.TypedSpec().Value.TalosUpgradePolicy = &specs.TalosUpgradePolicy{
+			WorkersPercentage: 50,
+			HealthTimeout:     durationpb.New(time.Second),
+			OnFailure:         specs.TalosUpgradePolicy_Rollback,
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	// the canary worker goes first
+	assertVersions(TalosVersion, "1.3.6", TalosVersion, TalosVersion)
+
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterName).Metadata(),
+		func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+			stages := xslices.Map(res.TypedSpec().Value.Stages, func(stage *specs.TalosUpgradeStatusSpec_Stage) string {
+				return fmt.Sprintf("%s: %v", stage.Name, stage.Machines)
+			})
+
+			assertions.Equal([]string{
+				fmt.Sprintf("canary: [%s]", machines[1].Metadata().ID()),
+				fmt.Sprintf("workers: [%s]", machines[2].Metadata().ID()),
+				fmt.Sprintf("control-planes: [%s]", machines[0].Metadata().ID()),
+				fmt.Sprintf("remaining-workers: [%s]", machines[3].Metadata().ID()),
+			}, stages)
+		},
+	)
+
+	// the canary is healthy, the next stage starts
+	syncConfigStatuses()
+
+	assertVersions(TalosVersion, "1.3.6", "1.3.6", TalosVersion)
+
+	// the cluster becomes unhealthy after the second stage, the upgrade is rolled back
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, kubernetesStatus.Metadata(), func(res *omni.KubernetesStatus) error {
+		res.TypedSpec().Value.Nodes[0].Ready = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	syncConfigStatuses()
+
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterName).Metadata(),
+		func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+			assertions.Equal("1.3.6", res.TypedSpec().Value.FailedUpgradeVersion)
+			assertions.Equal(specs.TalosUpgradeStatusSpec_Stage_Passed, res.TypedSpec().Value.Stages[0].Phase)
+			assertions.Equal(specs.TalosUpgradeStatusSpec_Stage_Failed, res.TypedSpec().Value.Stages[1].Phase)
+			assertions.Contains(res.TypedSpec().Value.Stages[1].Error, "node-1")
+		},
+	)
+
+	suite.Require().EventuallyWithT(func(collect *assert.CollectT) {
+		syncConfigStatuses()
+
+		upgradeStatus, err := safe.StateGetByID[*omni.TalosUpgradeStatus](suite.ctx, suite.state, clusterName)
+		if !assert.NoError(collect, err) {
+			return
+		}
+
+		assert.Equal(collect, specs.TalosUpgradeStatusSpec_Failed, upgradeStatus.TypedSpec().Value.Phase)
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assertVersions(TalosVersion, TalosVersion, TalosVersion, TalosVersion)
+
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterName).Metadata(),
+		func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+			assertions.Equal(TalosVersion, res.TypedSpec().Value.LastUpgradeVersion)
+			assertions.Contains(res.TypedSpec().Value.Error, "rolled back")
+		},
+	)
+}
+
 func TestTalosUpgradeStatusSuite(t *testing.T) {
 	suite.Run(t, new(TalosUpgradeStatusSuite))
 }
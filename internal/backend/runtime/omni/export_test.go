@@ -58,14 +58,38 @@ func ConfigPatchValidationOptions(st state.State) []validated.StateOption {
 	return configPatchValidationOptions(st)
 }
 
+func ConfigPatchLibraryValidationOptions(st state.State) []validated.StateOption {
+	return configPatchLibraryValidationOptions(st)
+}
+
 func EtcdManualBackupValidationOptions() []validated.StateOption {
 	return etcdManualBackupValidationOptions()
 }
 
+func EtcdRestoreValidationOptions(st state.State, etcdBackupStoreFactory store.Factory) []validated.StateOption {
+	return etcdRestoreValidationOptions(st, etcdBackupStoreFactory)
+}
+
+func AccessPolicyValidationOptions(st state.State) []validated.StateOption {
+	return accessPolicyValidationOptions(st)
+}
+
+func RoleValidationOptions(st state.State) []validated.StateOption {
+	return roleValidationOptions(st)
+}
+
+func AccessGrantValidationOptions(st state.State) []validated.StateOption {
+	return accessGrantValidationOptions(st)
+}
+
 func SAMLLabelRuleValidationOptions() []validated.StateOption {
 	return samlLabelRuleValidationOptions()
 }
 
+func MachineClassValidationOptions() []validated.StateOption {
+	return machineClassValidationOptions()
+}
+
 func S3ConfigValidationOptions() []validated.StateOption {
 	return s3ConfigValidationOptions()
 }
@@ -73,3 +97,11 @@ func S3ConfigValidationOptions() []validated.StateOption {
 func SchematicConfigurationValidationOptions() []validated.StateOption {
 	return schematicConfigurationValidationOptions()
 }
+
+func JoinTokenValidationOptions(st state.State) []validated.StateOption {
+	return joinTokenValidationOptions(st)
+}
+
+func MachineAcceptanceValidationOptions(st state.State) []validated.StateOption {
+	return machineAcceptanceValidationOptions(st)
+}
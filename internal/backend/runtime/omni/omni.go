@@ -31,6 +31,7 @@ import (
 	siderolinkresources "github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	virtualres "github.com/siderolabs/omni/client/pkg/omni/resources/virtual"
 	pkgruntime "github.com/siderolabs/omni/client/pkg/runtime"
+	"github.com/siderolabs/omni/internal/backend/audit"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
 	"github.com/siderolabs/omni/internal/backend/resourcelogger"
@@ -75,7 +76,7 @@ type Runtime struct {
 //nolint:maintidx
 func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workloadProxyServiceRegistry *workloadproxy.ServiceRegistry,
 	resourceLogger *resourcelogger.Logger, imageFactoryClient *imagefactory.Client, linkCounterDeltaCh <-chan siderolink.LinkCounterDeltas, resourceState state.State,
-	virtualState *virtual.State, metricsRegistry prometheus.Registerer, discoveryClient omnictrl.DiscoveryClient, logger *zap.Logger,
+	virtualState *virtual.State, metricsRegistry prometheus.Registerer, discoveryClient omnictrl.DiscoveryClient, auditLog *audit.Log, logger *zap.Logger,
 ) (*Runtime, error) {
 	var opts []options.Option
 
@@ -137,6 +138,29 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 
 	metricsRegistry.MustRegister(storeFactory)
 
+	validationOptions := clusterValidationOptions(resourceState)
+	validationOptions = append(validationOptions, relationLabelsValidationOptions()...)
+	validationOptions = append(validationOptions, accessPolicyValidationOptions(resourceState)...)
+	validationOptions = append(validationOptions, aclValidationOptions(resourceState)...)
+	validationOptions = append(validationOptions, roleValidationOptions(resourceState)...)
+	validationOptions = append(validationOptions, accessGrantValidationOptions(resourceState)...)
+	validationOptions = append(validationOptions, machineSetNodeValidationOptions(resourceState)...)
+	validationOptions = append(validationOptions, machineSetValidationOptions(resourceState, storeFactory)...)
+	validationOptions = append(validationOptions, identityValidationOptions()...)
+	validationOptions = append(validationOptions, exposedServiceValidationOptions()...)
+	validationOptions = append(validationOptions, configPatchValidationOptions(resourceState)...)
+	validationOptions = append(validationOptions, configPatchLibraryValidationOptions(resourceState)...)
+	validationOptions = append(validationOptions, etcdManualBackupValidationOptions()...)
+	validationOptions = append(validationOptions, etcdRestoreValidationOptions(resourceState, storeFactory)...)
+	validationOptions = append(validationOptions, samlLabelRuleValidationOptions()...)
+	validationOptions = append(validationOptions, machineClassValidationOptions()...)
+	validationOptions = append(validationOptions, s3ConfigValidationOptions()...)
+	validationOptions = append(validationOptions, joinTokenValidationOptions(resourceState)...)
+	validationOptions = append(validationOptions, machineAcceptanceValidationOptions(resourceState)...)
+
+	// the controllers which change the resources owned by the users go through the same validations as the API
+	validatedState := state.WrapCore(validated.NewState(resourceState, validationOptions...))
+
 	backupController, err := omnictrl.NewEtcdBackupController(omnictrl.EtcdBackupControllerSettings{
 		ClientMaker: func(ctx context.Context, clusterName string) (omnictrl.TalosClient, error) {
 			return talosClientFactory.Get(ctx, clusterName)
@@ -148,6 +172,14 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		return nil, err
 	}
 
+	var clusterLoadBalancerOptions []omnictrl.ClusterLoadBalancerControllerOption
+
+	if config.Config.LoadBalancer.SNI.Enabled {
+		clusterLoadBalancerOptions = append(clusterLoadBalancerOptions,
+			omnictrl.WithLoadBalancerSNI(config.Config.LoadBalancer.SNI.Port, config.Config.LoadBalancer.SNI.Domain),
+		)
+	}
+
 	controllers := []controller.Controller{
 		omnictrl.NewCertRefreshTickController(constants.CertificateValidityTime / 10), // issue ticks at 10% of the validity, as we refresh certificates at 50% of the validity
 		omnictrl.NewClusterController(),
@@ -158,6 +190,7 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		omnictrl.NewClusterMachineController(),
 		&omnictrl.ClusterMachineEncryptionController{},
 		&omnictrl.ClusterStatusMetricsController{},
+		&omnictrl.ClusterHealthMetricsController{},
 		&omnictrl.ClusterWorkloadProxyController{},
 		omnictrl.NewEtcdBackupOverallStatusController(),
 		backupController,
@@ -175,16 +208,21 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		omnictrl.NewMachineCleanupController(),
 		omnictrl.NewMachineStatusLinkController(linkCounterDeltaCh),
 		&omnictrl.MachineStatusMetricsController{},
+		&omnictrl.MachineHealthMetricsController{},
 		&omnictrl.VersionsController{},
 		omnictrl.NewClusterLoadBalancerController(
 			config.Config.LoadBalancer.MinPort,
 			config.Config.LoadBalancer.MaxPort,
+			clusterLoadBalancerOptions...,
 		),
 		&omnictrl.InstallationMediaController{},
 		omnictrl.NewKeyPrunerController(
 			config.Config.KeyPruner.Interval,
 		),
+		// access checks ignore the expired grants on their own, so the interval only affects how soon the grants are cleaned up
+		omnictrl.NewAccessGrantController(time.Minute),
 		&omnictrl.OngoingTaskController{},
+		&omnictrl.ConfigPatchLibraryVersionController{},
 	}
 
 	qcontrollers := []controller.QController{
@@ -197,15 +235,19 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		omnictrl.NewClusterKubernetesNodesController(),
 		omnictrl.NewClusterMachineConfigController(config.Config.DefaultConfigGenOptions),
 		omnictrl.NewClusterMachineTeardownController(discoveryClient),
+		omnictrl.NewClusterMachineDrainController(nil),
 		omnictrl.NewMachineConfigGenOptionsController(),
+		omnictrl.NewMachineClassStatusController(),
 		omnictrl.NewClusterMachineConfigStatusController(),
 		omnictrl.NewClusterMachineEncryptionKeyController(),
 		omnictrl.NewClusterMachineStatusController(),
 		omnictrl.NewClusterStatusController(),
+		omnictrl.NewClusterUpgradeChannelController(validatedState, nil, auditLog),
 		omnictrl.NewClusterUUIDController(),
 		omnictrl.NewControlPlaneStatusController(),
 		omnictrl.NewKubernetesNodeAuditController(nil, time.Minute),
 		omnictrl.NewEtcdBackupEncryptionController(),
+		omnictrl.NewEtcdRestoreStatusController(storeFactory),
 		omnictrl.NewKubeconfigController(constants.CertificateValidityTime),
 		omnictrl.NewKubernetesUpgradeManifestStatusController(),
 		omnictrl.NewKubernetesUpgradeStatusController(),
@@ -269,20 +311,6 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 
 	metricsRegistry.MustRegister(expvarCollector)
 
-	validationOptions := clusterValidationOptions(resourceState)
-	validationOptions = append(validationOptions, relationLabelsValidationOptions()...)
-	validationOptions = append(validationOptions, accessPolicyValidationOptions()...)
-	validationOptions = append(validationOptions, aclValidationOptions(resourceState)...)
-	validationOptions = append(validationOptions, roleValidationOptions()...)
-	validationOptions = append(validationOptions, machineSetNodeValidationOptions(resourceState)...)
-	validationOptions = append(validationOptions, machineSetValidationOptions(resourceState, storeFactory)...)
-	validationOptions = append(validationOptions, identityValidationOptions()...)
-	validationOptions = append(validationOptions, exposedServiceValidationOptions()...)
-	validationOptions = append(validationOptions, configPatchValidationOptions(resourceState)...)
-	validationOptions = append(validationOptions, etcdManualBackupValidationOptions()...)
-	validationOptions = append(validationOptions, samlLabelRuleValidationOptions()...)
-	validationOptions = append(validationOptions, s3ConfigValidationOptions()...)
-
 	return &Runtime{
 		controllerRuntime:            controllerRuntime,
 		talosClientFactory:           talosClientFactory,
@@ -290,7 +318,7 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		dnsService:                   dnsService,
 		workloadProxyServiceRegistry: workloadProxyServiceRegistry,
 		resourceLogger:               resourceLogger,
-		state:                        state.WrapCore(validated.NewState(resourceState, validationOptions...)),
+		state:                        validatedState,
 		virtual:                      virtualState,
 		logger:                       logger,
 	}, nil
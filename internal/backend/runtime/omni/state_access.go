@@ -42,15 +42,19 @@ var (
 		omni.ClusterUUIDType,
 		omni.ClusterSecretsType,
 		omni.ClusterStatusType,
+		omni.ClusterUpgradeChannelStatusType,
 		omni.EtcdAuditResultType,
 		omni.EtcdBackupStatusType,
 		omni.EtcdManualBackupType,
+		omni.EtcdRestoreType,
+		omni.EtcdRestoreStatusType,
 	})
 
 	// clusterLabelTypeSet is the set of resource types which have the related cluster's ID as a label.
 	clusterLabelTypeSet = xslices.ToSet([]resource.Type{
 		omni.ClusterMachineConfigType,
 		omni.ClusterMachineConfigStatusType,
+		omni.ClusterMachineDrainStatusType,
 		omni.ClusterMachineIdentityType,
 		omni.ClusterMachineType,
 		omni.ClusterMachineConfigPatchesType,
@@ -191,7 +195,7 @@ func aclValidationOptions(st state.State) []validated.StateOption {
 					ResourceType:      existingRes.Metadata().Type(),
 					ResourceID:        existingRes.Metadata().ID(),
 					Verb:              state.Update,
-				}, existingClusterID, false); err != nil {
+				}, existingClusterID, false, updateCapabilities(existingRes, newRes)...); err != nil {
 					return err
 				}
 
@@ -237,7 +241,11 @@ func aclValidationOptions(st state.State) []validated.StateOption {
 	}
 }
 
-func checkForRole(ctx context.Context, st state.State, access state.Access, clusterID resource.ID, requireAll bool) error {
+// checkForRole checks the access against the role of the user for the given cluster.
+//
+// If the role is not sufficient, the access is still allowed if the user was granted all of the given capabilities by a custom role.
+// If no capabilities are given, the ones matching the access are used.
+func checkForRole(ctx context.Context, st state.State, access state.Access, clusterID resource.ID, requireAll bool, capabilities ...role.Capability) error {
 	if actor.ContextIsInternalActor(ctx) {
 		return nil
 	}
@@ -247,7 +255,7 @@ func checkForRole(ctx context.Context, st state.State, access state.Access, clus
 	}
 
 	if clusterID != "" {
-		clusterRole, matchesAll, err := accesspolicy.RoleForCluster(ctx, clusterID, st)
+		clusterRole, clusterCapabilities, matchesAll, err := accesspolicy.RoleAndCapabilitiesForCluster(ctx, clusterID, st)
 		if err != nil {
 			return err
 		}
@@ -256,9 +264,88 @@ func checkForRole(ctx context.Context, st state.State, access state.Access, clus
 			// override the role in the context with the computed role for this cluster
 			ctx = context.WithValue(ctx, auth.RoleContextKey{}, clusterRole)
 		}
+
+		if !requireAll {
+			ctx = context.WithValue(ctx, auth.CapabilitiesContextKey{}, clusterCapabilities)
+		}
+	}
+
+	if len(capabilities) == 0 {
+		capabilities = accessCapabilities(access)
+	}
+
+	return filterAccess(ctx, access, capabilities)
+}
+
+// accessCapabilities returns the capabilities which allow the access to the resource without having the role required by the verb.
+func accessCapabilities(access state.Access) []role.Capability {
+	switch access.ResourceType {
+	case omni.MachineSetNodeType:
+		switch access.Verb { //nolint:exhaustive
+		case state.Create:
+			return []role.Capability{role.CapabilityAddMachines}
+		case state.Update, state.Destroy:
+			// tearing down the resource is an update
+			return []role.Capability{role.CapabilityRemoveMachines}
+		}
+	case omni.ConfigPatchType:
+		if !access.Verb.Readonly() {
+			return []role.Capability{role.CapabilityManageConfigPatches}
+		}
+	}
+
+	return nil
+}
+
+// updateCapabilities returns the capabilities which allow the given update.
+//
+// It returns nil if there is no set of capabilities which allows the update, so the role check should be used.
+func updateCapabilities(existingRes, newRes resource.Resource) []role.Capability {
+	existingCluster, ok := existingRes.(*omni.Cluster)
+	if !ok {
+		return nil
+	}
+
+	newCluster, ok := newRes.(*omni.Cluster)
+	if !ok {
+		return nil
+	}
+
+	if existingCluster.Metadata().Phase() != newCluster.Metadata().Phase() ||
+		!existingCluster.Metadata().Labels().Equal(*newCluster.Metadata().Labels()) ||
+		!existingCluster.Metadata().Annotations().Equal(*newCluster.Metadata().Annotations()) {
+		return nil
+	}
+
+	var capabilities []role.Capability
+
+	existingSpec := existingCluster.TypedSpec().Value.CloneVT()
+	newSpec := newCluster.TypedSpec().Value
+
+	if existingSpec.TalosVersion != newSpec.TalosVersion {
+		existingSpec.TalosVersion = newSpec.TalosVersion
+
+		capabilities = append(capabilities, role.CapabilityUpdateTalos)
+	}
+
+	if existingSpec.KubernetesVersion != newSpec.KubernetesVersion {
+		existingSpec.KubernetesVersion = newSpec.KubernetesVersion
+
+		capabilities = append(capabilities, role.CapabilityUpdateKubernetes)
+	}
+
+	if !existingSpec.Features.EqualVT(newSpec.Features) {
+		existingSpec.Features = newSpec.Features
+
+		capabilities = append(capabilities, role.CapabilityManageClusterFeatures)
+	}
+
+	// anything else was changed
+	if !existingSpec.EqualVT(newSpec) {
+		return nil
 	}
 
-	return filterAccess(ctx, access)
+	return capabilities
 }
 
 func checkForKindAccess(ctx context.Context, st state.State, verb state.Verb, kind resource.Kind, labelTerms []resource.LabelTerm) error {
@@ -334,8 +421,10 @@ func verbToRole(verb state.Verb) role.Role {
 
 // filterAccess provides a filter to exclude some resources and operations from external sources.
 //
+// The capabilities, if set, allow the access to the cluster resources without having the role required by the verb.
+//
 //nolint:cyclop,gocyclo
-func filterAccess(ctx context.Context, access state.Access) error {
+func filterAccess(ctx context.Context, access state.Access, capabilities []role.Capability) error {
 	if actor.ContextIsInternalActor(ctx) {
 		return nil
 	}
@@ -350,6 +439,7 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.ClusterDestroyStatusType,
 		omni.ClusterEndpointType,
 		omni.ClusterKubernetesNodesType,
+		omni.ClusterMachineDrainStatusType,
 		omni.ClusterMachineIdentityType,
 		omni.ClusterMachineStatusType,
 		omni.ClusterMachineTalosVersionType,
@@ -358,7 +448,10 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.ClusterMachineTemplateType,
 		omni.ClusterUUIDType,
 		omni.ClusterStatusType,
+		omni.ClusterUpgradeChannelStatusType,
 		omni.ConfigPatchType,
+		omni.ConfigPatchLibraryType,
+		omni.ConfigPatchLibraryVersionType,
 		omni.ControlPlaneStatusType,
 		omni.KubernetesNodeAuditResultType,
 		omni.ExposedServiceType,
@@ -367,6 +460,8 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.EtcdBackupStoreStatusType,
 		omni.EtcdBackupOverallStatusType,
 		omni.EtcdManualBackupType,
+		omni.EtcdRestoreType,
+		omni.EtcdRestoreStatusType,
 		omni.ImagePullRequestType,
 		omni.ImagePullStatusType,
 		omni.KubernetesStatusType,
@@ -385,6 +480,7 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		siderolink.ConnectionParamsType,
 		omni.MachineType,
 		omni.MachineClassType,
+		omni.MachineClassStatusType,
 		omni.MachineExtensionsType,
 		omni.MachineStatusType,
 		omni.MachineStatusSnapshotType,
@@ -395,7 +491,7 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.ExtensionsConfigurationType,
 		omni.ExtensionsConfigurationStatusType,
 		virtual.KubernetesUsageType:
-		_, err = auth.CheckGRPC(ctx, auth.WithRole(verbToRole(access.Verb)))
+		_, err = auth.CheckGRPC(ctx, auth.WithRole(verbToRole(access.Verb)), auth.WithCapabilities(capabilities...))
 	case
 		meta.NamespaceType,
 		meta.ResourceDefinitionType,
@@ -412,7 +508,15 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		virtual.ClusterPermissionsType:
 		// allow access with just valid signature
 		_, err = auth.CheckGRPC(ctx, auth.WithValidSignature(true))
-	case authres.IdentityType, authres.UserType, authres.SAMLLabelRuleType, authres.AccessPolicyType, omni.EtcdBackupS3ConfType:
+	case
+		authres.IdentityType,
+		authres.UserType,
+		authres.SAMLLabelRuleType,
+		authres.AccessPolicyType,
+		authres.AccessGrantType,
+		authres.AccessGrantRecordType,
+		omni.EtcdBackupS3ConfType,
+		siderolink.JoinTokenType:
 		var checkResult auth.CheckResult
 		// user management access
 		checkResult, err = auth.CheckGRPC(ctx, auth.WithRole(role.Admin))
@@ -426,6 +530,14 @@ func filterAccess(ctx context.Context, access state.Access) error {
 				err = status.Errorf(codes.PermissionDenied, "destroying/updating resource %s is not allowed by the current user", access.ResourceID)
 			}
 		}
+	case siderolink.MachineAcceptanceType:
+		// the machines are accepted or rejected by the admins only
+		requiredRole := role.Admin
+		if access.Verb.Readonly() {
+			requiredRole = role.Reader
+		}
+
+		_, err = auth.CheckGRPC(ctx, auth.WithRole(requiredRole))
 	case authres.AuthConfigType:
 		// allow access even without auth
 	default:
@@ -467,6 +579,9 @@ func filterAccessByType(access state.Access) error {
 	}
 
 	switch access.ResourceType {
+	case siderolink.MachineAcceptanceType:
+		// allow full access, the machines can be accepted or rejected before they join
+		return nil
 	case siderolink.LinkType:
 		// Allow read, update and delete access
 		// Update access is required for siderolink by rtestutils.Destroy[*siderolink.Link] call on integration tests
@@ -480,6 +595,7 @@ func filterAccessByType(access state.Access) error {
 		omni.ClusterDestroyStatusType,
 		omni.ClusterEndpointType,
 		omni.ClusterKubernetesNodesType,
+		omni.ClusterMachineDrainStatusType,
 		omni.ClusterMachineIdentityType,
 		omni.ClusterMachineStatusType,
 		omni.ClusterMachineTalosVersionType,
@@ -487,6 +603,7 @@ func filterAccessByType(access state.Access) error {
 		omni.ClusterMachineConfigPatchesType,
 		omni.ClusterMachineTemplateType,
 		omni.ClusterStatusType,
+		omni.ClusterUpgradeChannelStatusType,
 		omni.ClusterUUIDType,
 		omni.ControlPlaneStatusType,
 		omni.KubernetesNodeAuditResultType,
@@ -495,6 +612,7 @@ func filterAccessByType(access state.Access) error {
 		omni.EtcdBackupStatusType,
 		omni.EtcdBackupOverallStatusType,
 		omni.EtcdBackupStoreStatusType,
+		omni.EtcdRestoreStatusType,
 		omni.FeaturesConfigType,
 		omni.ImagePullRequestType,
 		omni.ImagePullStatusType,
@@ -507,6 +625,7 @@ func filterAccessByType(access state.Access) error {
 		omni.MachineConfigGenOptionsType,
 		omni.MachineSetDestroyStatusType,
 		omni.MachineSetStatusType,
+		omni.MachineClassStatusType,
 		omni.MachineStatusType,
 		omni.MachineStatusLinkType,
 		omni.MachineStatusSnapshotType,
@@ -523,6 +642,7 @@ func filterAccessByType(access state.Access) error {
 		omni.MachineExtensionsType,
 		omni.MachineStatusMetricsType,
 		authres.AuthConfigType,
+		authres.AccessGrantRecordType,
 		siderolink.ConnectionParamsType,
 		system.SysVersionType,
 		meta.NamespaceType,
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 	"unicode"
@@ -24,6 +25,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
@@ -108,6 +110,23 @@ func clusterValidationOptions(st state.State) []validated.StateOption {
 		return nil
 	}
 
+	validateUpgradePolicy := func(res *omni.Cluster) error {
+		policy := res.TypedSpec().Value.GetTalosUpgradePolicy()
+		if policy == nil {
+			return nil
+		}
+
+		if policy.GetWorkersPercentage() > 100 {
+			return fmt.Errorf("talos upgrade policy workers percentage should not be greater than 100, actual %d", policy.GetWorkersPercentage())
+		}
+
+		if policy.GetHealthTimeout().AsDuration() < 0 {
+			return errors.New("talos upgrade policy health timeout should not be negative")
+		}
+
+		return nil
+	}
+
 	return []validated.StateOption{
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.Cluster, _ ...state.CreateOption) error {
 			if err := validateEncryption(res); err != nil {
@@ -118,6 +137,22 @@ func clusterValidationOptions(st state.State) []validated.StateOption {
 				return err
 			}
 
+			if err := validateUpgradePolicy(res); err != nil {
+				return err
+			}
+
+			if _, err := omni.ParseMaintenanceWindow(res.TypedSpec().Value.MaintenanceWindow); err != nil {
+				return err
+			}
+
+			if err := omni.ValidateUpgradeChannel(res.TypedSpec().Value.UpgradeChannel); err != nil {
+				return err
+			}
+
+			if err := validateConfigPatchLibraryRefs(ctx, st, nil, res.TypedSpec().Value.ConfigPatchLibraries); err != nil {
+				return err
+			}
+
 			return validateVersions(ctx, res, false, false)
 		})),
 		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, existingRes *omni.Cluster, newRes *omni.Cluster, _ ...state.UpdateOption) error {
@@ -137,6 +172,22 @@ func clusterValidationOptions(st state.State) []validated.StateOption {
 				return err
 			}
 
+			if err := validateUpgradePolicy(newRes); err != nil {
+				return err
+			}
+
+			if _, err := omni.ParseMaintenanceWindow(newRes.TypedSpec().Value.MaintenanceWindow); err != nil {
+				return err
+			}
+
+			if err := omni.ValidateUpgradeChannel(newRes.TypedSpec().Value.UpgradeChannel); err != nil {
+				return err
+			}
+
+			if err := validateConfigPatchLibraryRefs(ctx, st, existingRes.TypedSpec().Value.ConfigPatchLibraries, newRes.TypedSpec().Value.ConfigPatchLibraries); err != nil {
+				return err
+			}
+
 			return validateVersions(ctx, newRes, skipTalosVersion, skipKubernetesVersion)
 		})),
 	}
@@ -184,37 +235,156 @@ func relationLabelsValidationOptions() []validated.StateOption {
 }
 
 // accessPolicyValidationOptions returns the validation options for the access policy resource.
-func accessPolicyValidationOptions() []validated.StateOption {
+//
+// The custom roles defined in the access policy can't be removed while they are assigned to the users or the public keys (e.g. service account keys),
+// or while the active access grants reference them.
+func accessPolicyValidationOptions(st state.State) []validated.StateOption {
+	validateUsedRoles := func(ctx context.Context, accessPolicy *authres.AccessPolicy) error {
+		users, err := safe.StateListAll[*authres.User](ctx, st)
+		if err != nil {
+			return err
+		}
+
+		publicKeys, err := safe.StateListAll[*authres.PublicKey](ctx, st)
+		if err != nil {
+			return err
+		}
+
+		grants, err := safe.StateListAll[*authres.AccessGrant](ctx, st)
+		if err != nil {
+			return err
+		}
+
+		var multiErr error
+
+		for iter := users.Iterator(); iter.Next(); {
+			userRole := iter.Value().TypedSpec().Value.GetRole()
+
+			if _, _, err = accesspolicy.ResolveRole(accessPolicy, userRole); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("role %q is assigned to the user %q: %w", userRole, iter.Value().Metadata().ID(), err))
+			}
+		}
+
+		for iter := publicKeys.Iterator(); iter.Next(); {
+			keyRole := iter.Value().TypedSpec().Value.GetRole()
+
+			// the public keys without a role use the role of the user
+			if keyRole == "" {
+				continue
+			}
+
+			if _, _, err = accesspolicy.ResolveRole(accessPolicy, keyRole); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("role %q is assigned to the public key %q of %q: %w",
+					keyRole, iter.Value().Metadata().ID(), iter.Value().TypedSpec().Value.GetIdentity().GetEmail(), err))
+			}
+		}
+
+		now := time.Now()
+
+		for iter := grants.Iterator(); iter.Next(); {
+			// the expired grants are not evaluated anymore, they are kept only until they are revoked
+			if !accesspolicy.GrantActive(iter.Value(), now) {
+				continue
+			}
+
+			grantRole := iter.Value().TypedSpec().Value.GetRole()
+
+			if _, _, err = accesspolicy.ResolveRole(accessPolicy, grantRole); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("role %q is granted to %q by the access grant %q: %w",
+					grantRole, iter.Value().TypedSpec().Value.GetIdentity(), iter.Value().Metadata().ID(), err))
+			}
+		}
+
+		return multiErr
+	}
+
 	return []validated.StateOption{
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *authres.AccessPolicy, _ ...state.CreateOption) error {
 			return accesspolicy.Validate(res)
 		})),
-		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *authres.AccessPolicy, newRes *authres.AccessPolicy, _ ...state.UpdateOption) error {
-			return accesspolicy.Validate(newRes)
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, _ *authres.AccessPolicy, newRes *authres.AccessPolicy, _ ...state.UpdateOption) error {
+			if err := accesspolicy.Validate(newRes); err != nil {
+				return err
+			}
+
+			return validateUsedRoles(ctx, newRes)
+		})),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(func(ctx context.Context, _ resource.Pointer, _ *authres.AccessPolicy, _ ...state.DestroyOption) error {
+			return validateUsedRoles(ctx, nil)
 		})),
 	}
 }
 
 // roleValidationOptions returns the validation options for the user and public key resources, ensuring that their roles are valid.
-func roleValidationOptions() []validated.StateOption {
-	validateRole := func(roleStr string) error {
-		_, err := role.Parse(roleStr)
+//
+// The role is either a built-in role, or a custom role defined in the access policy.
+func roleValidationOptions(st state.State) []validated.StateOption {
+	validateRole := func(ctx context.Context, roleStr string) error {
+		_, _, err := accesspolicy.ResolveRoleFromState(ctx, st, roleStr)
 
 		return err
 	}
 
 	return []validated.StateOption{
-		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *authres.User, _ ...state.CreateOption) error {
-			return validateRole(res.TypedSpec().Value.GetRole())
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *authres.User, _ ...state.CreateOption) error {
+			return validateRole(ctx, res.TypedSpec().Value.GetRole())
 		})),
-		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *authres.User, newRes *authres.User, _ ...state.UpdateOption) error {
-			return validateRole(newRes.TypedSpec().Value.GetRole())
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, _ *authres.User, newRes *authres.User, _ ...state.UpdateOption) error {
+			return validateRole(ctx, newRes.TypedSpec().Value.GetRole())
 		})),
-		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *authres.PublicKey, _ ...state.CreateOption) error {
-			return validateRole(res.TypedSpec().Value.GetRole())
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *authres.PublicKey, _ ...state.CreateOption) error {
+			return validateRole(ctx, res.TypedSpec().Value.GetRole())
 		})),
-		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *authres.PublicKey, newRes *authres.PublicKey, _ ...state.UpdateOption) error {
-			return validateRole(newRes.TypedSpec().Value.GetRole())
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, _ *authres.PublicKey, newRes *authres.PublicKey, _ ...state.UpdateOption) error {
+			return validateRole(ctx, newRes.TypedSpec().Value.GetRole())
+		})),
+	}
+}
+
+// accessGrantValidationOptions returns the validation options for the access grant resource.
+func accessGrantValidationOptions(st state.State) []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *authres.AccessGrant, _ ...state.CreateOption) error {
+			spec := res.TypedSpec().Value
+
+			var multiErr error
+
+			if spec.Identity == "" {
+				multiErr = multierror.Append(multiErr, errors.New("identity is not set"))
+			}
+
+			if len(spec.Clusters) == 0 {
+				multiErr = multierror.Append(multiErr, errors.New("at least one cluster should be set"))
+			}
+
+			if err := accesspolicy.ValidateGrantClusters(spec.Clusters); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+
+			if _, _, err := accesspolicy.ResolveRoleFromState(ctx, st, spec.Role); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+
+			if spec.Expiration == nil {
+				multiErr = multierror.Append(multiErr, errors.New("expiration is not set"))
+			} else if expiration := spec.Expiration.AsTime(); !expiration.After(time.Now()) {
+				multiErr = multierror.Append(multiErr, errors.New("expiration should be in the future"))
+			} else if expiration.After(time.Now().Add(accesspolicy.MaxGrantDuration)) {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("expiration should be within %s", accesspolicy.MaxGrantDuration))
+			}
+
+			if strings.TrimSpace(spec.Reason) == "" {
+				multiErr = multierror.Append(multiErr, errors.New("reason is not set"))
+			}
+
+			return multiErr
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, oldRes *authres.AccessGrant, newRes *authres.AccessGrant, _ ...state.UpdateOption) error {
+			if oldRes.TypedSpec().Value.EqualVT(newRes.TypedSpec().Value) {
+				return nil
+			}
+
+			return errors.New("access grant spec is immutable after creation, create a new grant instead")
 		})),
 	}
 }
@@ -287,6 +457,41 @@ func machineSetValidationOptions(st state.State, etcdBackupStoreFactory store.Fa
 			}
 		}
 
+		if spec.GetUpdateStrategyConfig().GetRolling().GetMaxSurge() > 0 {
+			switch {
+			case isControlPlane:
+				return errors.New("max surge is not supported for the control plane machine sets")
+			case spec.UpdateStrategy != specs.MachineSetSpec_Rolling:
+				return errors.New("max surge requires the rolling update strategy")
+			case spec.MachineClass == nil || spec.MachineClass.AllocationType != specs.MachineSetSpec_MachineClass_Static:
+				return errors.New("max surge requires the machine class with the static allocation type")
+			}
+		}
+
+		for _, drain := range []*specs.MachineSetSpec_DrainConfig{spec.GetUpdateStrategyConfig().GetDrain(), spec.GetDeleteStrategyConfig().GetDrain()} {
+			if drain.GetTimeout().AsDuration() < 0 {
+				return errors.New("drain timeout should not be negative")
+			}
+
+			if drain.GetForce() && drain.GetTimeout().AsDuration() == 0 {
+				return errors.New("forced drain requires the drain timeout")
+			}
+		}
+
+		if _, err := omni.ParseMaintenanceWindow(spec.MaintenanceWindow); err != nil {
+			return err
+		}
+
+		var oldLibraryRefs []*specs.ConfigPatchLibraryRef
+
+		if oldRes != nil {
+			oldLibraryRefs = oldRes.TypedSpec().Value.ConfigPatchLibraries
+		}
+
+		if err := validateConfigPatchLibraryRefs(ctx, st, oldLibraryRefs, spec.ConfigPatchLibraries); err != nil {
+			return err
+		}
+
 		if oldRes != nil {
 			// ensure that the machine class type doesn't change from manually selected machines to the machine class
 			oldSpec := oldRes.TypedSpec().Value
@@ -356,47 +561,56 @@ func validateBootstrapSpec(ctx context.Context, st state.State, etcdBackupStoreF
 		return nil
 	}
 
-	clusterUUIDs, err := safe.StateListAll[*omni.ClusterUUID](ctx, st, state.WithLabelQuery(resource.LabelEqual(omni.LabelClusterUUID, bootstrapSpec.GetClusterUuid())))
+	_, err := validateBackupSnapshot(ctx, st, etcdBackupStoreFactory, bootstrapSpec.GetClusterUuid(), bootstrapSpec.GetSnapshot())
+
+	return err
+}
+
+// validateBackupSnapshot verifies that the snapshot exists and was taken from the cluster with the given UUID.
+//
+// It returns the backup data of the cluster the snapshot was taken from.
+func validateBackupSnapshot(ctx context.Context, st state.State, etcdBackupStoreFactory store.Factory, clusterUUID, snapshot string) (*omni.BackupData, error) {
+	clusterUUIDs, err := safe.StateListAll[*omni.ClusterUUID](ctx, st, state.WithLabelQuery(resource.LabelEqual(omni.LabelClusterUUID, clusterUUID)))
 	if err != nil {
-		return fmt.Errorf("error getting cluster UUIDs: %w", err)
+		return nil, fmt.Errorf("error getting cluster UUIDs: %w", err)
 	}
 
 	if clusterUUIDs.Len() == 0 {
-		return fmt.Errorf("invalid cluster UUID %q", bootstrapSpec.GetClusterUuid())
+		return nil, fmt.Errorf("invalid cluster UUID %q", clusterUUID)
 	}
 
 	if clusterUUIDs.Len() > 1 {
-		return fmt.Errorf("inconsistent state on cluster UUID %q", bootstrapSpec.GetClusterUuid())
+		return nil, fmt.Errorf("inconsistent state on cluster UUID %q", clusterUUID)
 	}
 
 	cluster := clusterUUIDs.Get(0).Metadata().ID()
 
 	backupData, err := safe.ReaderGetByID[*omni.BackupData](ctx, st, cluster)
 	if err != nil {
-		return fmt.Errorf("error getting backup data: %w", err)
+		return nil, fmt.Errorf("error getting backup data: %w", err)
 	}
 
 	backupStore, err := etcdBackupStoreFactory.GetStore()
 	if err != nil {
-		return fmt.Errorf("error getting etcd backup store: %w", err)
+		return nil, fmt.Errorf("error getting etcd backup store: %w", err)
 	}
 
-	data, readCloser, err := backupStore.Download(ctx, backupData.TypedSpec().Value.EncryptionKey, bootstrapSpec.ClusterUuid, bootstrapSpec.Snapshot)
+	data, readCloser, err := backupStore.Download(ctx, backupData.TypedSpec().Value.EncryptionKey, clusterUUID, snapshot)
 	if err != nil {
-		return fmt.Errorf("failed to get backup: %w", err)
+		return nil, fmt.Errorf("failed to get backup: %w", err)
 	}
 
 	readCloser.Close() //nolint:errcheck
 
 	if data.AESCBCEncryptionSecret != backupData.TypedSpec().Value.AesCbcEncryptionSecret {
-		return errors.New("aes cbc encryption secret mismatch")
+		return nil, errors.New("aes cbc encryption secret mismatch")
 	}
 
 	if data.SecretboxEncryptionSecret != backupData.TypedSpec().Value.SecretboxEncryptionSecret {
-		return errors.New("secretbox encryption secret mismatch")
+		return nil, errors.New("secretbox encryption secret mismatch")
 	}
 
-	return nil
+	return backupData, nil
 }
 
 // machineSetNodeValidationOptions returns the validation options for the machine set node resource.
@@ -468,6 +682,23 @@ func machineSetNodeValidationOptions(st state.State) []validated.StateOption {
 		return nil
 	}
 
+	validateMachineAccepted := func(ctx context.Context, res *omni.MachineSetNode) error {
+		machine, err := safe.ReaderGetByID[*omni.Machine](ctx, st, res.Metadata().ID())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if _, pending := machine.Metadata().Labels().Get(omni.MachineStatusLabelPendingAcceptance); pending {
+			return fmt.Errorf("cannot add the machine %q to a machine set as it is not accepted yet", res.Metadata().ID())
+		}
+
+		return nil
+	}
+
 	return []validated.StateOption{
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.MachineSetNode, _ ...state.CreateOption) error {
 			machineSet, err := getMachineSet(ctx, res)
@@ -487,6 +718,10 @@ func machineSetNodeValidationOptions(st state.State) []validated.StateOption {
 				return err
 			}
 
+			if err = validateMachineAccepted(ctx, res); err != nil {
+				return err
+			}
+
 			return validateNotControlplane(machineSet, res)
 		})),
 		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, res *omni.MachineSetNode, newRes *omni.MachineSetNode, _ ...state.UpdateOption) error {
@@ -640,6 +875,97 @@ func configPatchValidationOptions(st state.State) []validated.StateOption {
 	}
 }
 
+func configPatchLibraryValidationOptions(st state.State) []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.ConfigPatchLibrary, _ ...state.CreateOption) error {
+			return omni.ValidateConfigPatch(res.TypedSpec().Value.GetData())
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, oldRes *omni.ConfigPatchLibrary, newRes *omni.ConfigPatchLibrary, _ ...state.UpdateOption) error {
+			if oldRes.TypedSpec().Value.Data == newRes.TypedSpec().Value.Data {
+				return nil
+			}
+
+			return omni.ValidateConfigPatch(newRes.TypedSpec().Value.GetData())
+		})),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(func(ctx context.Context, ptr resource.Pointer, _ *omni.ConfigPatchLibrary, _ ...state.DestroyOption) error {
+			referencedByName := func(refs []*specs.ConfigPatchLibraryRef) bool {
+				return slices.ContainsFunc(refs, func(ref *specs.ConfigPatchLibraryRef) bool { return ref.Name == ptr.ID() })
+			}
+
+			clusters, err := safe.StateListAll[*omni.Cluster](ctx, st)
+			if err != nil {
+				return err
+			}
+
+			for iter := clusters.Iterator(); iter.Next(); {
+				if referencedByName(iter.Value().TypedSpec().Value.ConfigPatchLibraries) {
+					return fmt.Errorf("config patch library %q is used by the cluster %q", ptr.ID(), iter.Value().Metadata().ID())
+				}
+			}
+
+			machineSets, err := safe.StateListAll[*omni.MachineSet](ctx, st)
+			if err != nil {
+				return err
+			}
+
+			for iter := machineSets.Iterator(); iter.Next(); {
+				if referencedByName(iter.Value().TypedSpec().Value.ConfigPatchLibraries) {
+					return fmt.Errorf("config patch library %q is used by the machine set %q", ptr.ID(), iter.Value().Metadata().ID())
+				}
+			}
+
+			return nil
+		})),
+	}
+}
+
+// validateConfigPatchLibraryRefs validates the config patch library references, the references are checked only if they were changed.
+func validateConfigPatchLibraryRefs(ctx context.Context, st state.State, oldRefs, refs []*specs.ConfigPatchLibraryRef) error {
+	if slices.EqualFunc(oldRefs, refs, (*specs.ConfigPatchLibraryRef).EqualVT) {
+		return nil
+	}
+
+	for _, ref := range refs {
+		if ref.Name == "" && len(ref.MatchLabels) == 0 {
+			return errors.New("config patch library reference should have either name or match labels set")
+		}
+
+		if ref.Version != 0 && (ref.Name == "" || len(ref.MatchLabels) != 0) {
+			return errors.New("config patch library version can be pinned only for the library referenced by name without match labels")
+		}
+
+		if _, err := labels.ParseSelectors(ref.MatchLabels); err != nil {
+			return fmt.Errorf("invalid config patch library selectors: %w", err)
+		}
+
+		if ref.Name == "" {
+			continue
+		}
+
+		if _, err := safe.StateGetByID[*omni.ConfigPatchLibrary](ctx, st, ref.Name); err != nil {
+			if state.IsNotFoundError(err) {
+				return fmt.Errorf("config patch library with name %q doesn't exist", ref.Name)
+			}
+
+			return err
+		}
+
+		if ref.Version == 0 {
+			continue
+		}
+
+		if _, err := safe.StateGetByID[*omni.ConfigPatchLibraryVersion](ctx, st, omni.ConfigPatchLibraryVersionID(ref.Name, ref.Version)); err != nil {
+			if state.IsNotFoundError(err) {
+				return fmt.Errorf("version %d of the config patch library %q doesn't exist", ref.Version, ref.Name)
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func validateNotControlplane(machineSet *omni.MachineSet, res *omni.MachineSetNode) error {
 	if _, locked := res.Metadata().Annotations().Get(omni.MachineLocked); !locked {
 		return nil
@@ -675,6 +1001,83 @@ func etcdManualBackupValidationOptions() []validated.StateOption {
 	}
 }
 
+// etcdRestoreValidationOptions returns the validation options for the etcd restore resource.
+func etcdRestoreValidationOptions(st state.State, etcdBackupStoreFactory store.Factory) []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.EtcdRestore, _ ...state.CreateOption) error {
+			return validateEtcdRestore(ctx, st, etcdBackupStoreFactory, res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, oldRes *omni.EtcdRestore, newRes *omni.EtcdRestore, _ ...state.UpdateOption) error {
+			if oldRes == nil {
+				return nil
+			}
+
+			if !oldRes.TypedSpec().Value.EqualVT(newRes.TypedSpec().Value) {
+				return errors.New("etcd restore spec is immutable after creation")
+			}
+
+			return nil
+		})),
+	}
+}
+
+func validateEtcdRestore(ctx context.Context, st state.State, etcdBackupStoreFactory store.Factory, res *omni.EtcdRestore) error {
+	clusterName := res.Metadata().ID()
+	spec := res.TypedSpec().Value
+
+	if spec.GetClusterUuid() == "" {
+		return errors.New("cluster uuid is required")
+	}
+
+	if spec.GetSnapshot() == "" {
+		return errors.New("snapshot is required")
+	}
+
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, clusterName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return fmt.Errorf("cluster %q does not exist", clusterName)
+		}
+
+		return err
+	}
+
+	if cluster.Metadata().Phase() == resource.PhaseTearingDown {
+		return fmt.Errorf("the cluster %q is tearing down", clusterName)
+	}
+
+	bootstrapStatus, err := safe.StateGetByID[*omni.ClusterBootstrapStatus](ctx, st, clusterName)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting cluster bootstrap status: %w", err)
+	}
+
+	if bootstrapStatus == nil || !bootstrapStatus.TypedSpec().Value.GetBootstrapped() {
+		return fmt.Errorf("the cluster %q is not bootstrapped, use the control plane machine set bootstrap spec to restore from a backup", clusterName)
+	}
+
+	sourceBackupData, err := validateBackupSnapshot(ctx, st, etcdBackupStoreFactory, spec.GetClusterUuid(), spec.GetSnapshot())
+	if err != nil {
+		return err
+	}
+
+	if sourceBackupData.Metadata().ID() == clusterName {
+		return nil
+	}
+
+	// the backup was taken from another cluster, it can only be restored if the clusters share the secrets
+	backupData, err := safe.StateGetByID[*omni.BackupData](ctx, st, clusterName)
+	if err != nil {
+		return fmt.Errorf("error getting backup data: %w", err)
+	}
+
+	if backupData.TypedSpec().Value.GetAesCbcEncryptionSecret() != sourceBackupData.TypedSpec().Value.GetAesCbcEncryptionSecret() ||
+		backupData.TypedSpec().Value.GetSecretboxEncryptionSecret() != sourceBackupData.TypedSpec().Value.GetSecretboxEncryptionSecret() {
+		return fmt.Errorf("the backup was taken from a cluster with different secrets than the cluster %q", clusterName)
+	}
+
+	return nil
+}
+
 // TODO: maybe move the role validation into roleValidationOptions and create a "matchLabelsValidationOptions" function.
 func samlLabelRuleValidationOptions() []validated.StateOption {
 	validate := func(res *authres.SAMLLabelRule) error {
@@ -701,6 +1104,31 @@ func samlLabelRuleValidationOptions() []validated.StateOption {
 	}
 }
 
+func machineClassValidationOptions() []validated.StateOption {
+	validate := func(res *omni.MachineClass) error {
+		var multiErr error
+
+		if _, err := labels.ParseSelectors(res.TypedSpec().Value.GetMatchLabels()); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("invalid match labels: %w", err))
+		}
+
+		if err := omni.ValidateMachineClassRequirements(res.TypedSpec().Value.GetRequirements()); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("invalid requirements: %w", err))
+		}
+
+		return multiErr
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.MachineClass, _ ...state.CreateOption) error {
+			return validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.MachineClass, newRes *omni.MachineClass, _ ...state.UpdateOption) error {
+			return validate(newRes)
+		})),
+	}
+}
+
 func validateManualBackup(embs *omni.EtcdManualBackupSpec) error {
 	backupAt := embs.Value.GetBackupAt().AsTime()
 
@@ -724,6 +1152,93 @@ func s3ConfigValidationOptions() []validated.StateOption {
 	}
 }
 
+// joinTokenValidationOptions returns the validation options for the join tokens: the token values should be unique,
+// and the machine labels set by the token can't be the system ones.
+func joinTokenValidationOptions(st state.State) []validated.StateOption {
+	validate := func(ctx context.Context, res *siderolink.JoinToken) error {
+		var multiErr error
+
+		if err := siderolink.ValidateJoinToken(res.TypedSpec().Value); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+
+		for key := range res.TypedSpec().Value.MachineLabels {
+			if strings.HasPrefix(key, omni.SystemLabelPrefix) {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("machine label %q uses the reserved prefix %q", key, omni.SystemLabelPrefix))
+			}
+		}
+
+		joinTokens, err := safe.StateListAll[*siderolink.JoinToken](ctx, st)
+		if err != nil {
+			return err
+		}
+
+		for iter := joinTokens.Iterator(); iter.Next(); {
+			joinToken := iter.Value()
+
+			if joinToken.Metadata().ID() != res.Metadata().ID() && joinToken.TypedSpec().Value.Token == res.TypedSpec().Value.Token {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("join token value is already used by the join token %q", joinToken.Metadata().ID()))
+			}
+		}
+
+		return multiErr
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *siderolink.JoinToken, _ ...state.CreateOption) error {
+			if res.TypedSpec().Value.Uses != 0 && !actor.ContextIsInternalActor(ctx) {
+				return errors.New("join token uses are counted by Omni, they can't be set")
+			}
+
+			return validate(ctx, res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, oldRes *siderolink.JoinToken, newRes *siderolink.JoinToken, _ ...state.UpdateOption) error {
+			if oldRes.TypedSpec().Value.Uses != newRes.TypedSpec().Value.Uses && !actor.ContextIsInternalActor(ctx) {
+				return errors.New("join token uses are counted by Omni, they can't be changed")
+			}
+
+			return validate(ctx, newRes)
+		})),
+	}
+}
+
+// machineAcceptanceValidationOptions returns the validation options for the machine acceptance resource.
+func machineAcceptanceValidationOptions(st state.State) []validated.StateOption {
+	validate := func(ctx context.Context, res *siderolink.MachineAcceptance) error {
+		if res.TypedSpec().Value.Phase != specs.MachineAcceptanceSpec_Rejected {
+			return nil
+		}
+
+		// the rejected machine is disconnected, so the machines in the clusters should be removed from them first
+		machineSetNode, err := safe.StateGetByID[*omni.MachineSetNode](ctx, st, res.Metadata().ID())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		clusterName, _ := machineSetNode.Metadata().Labels().Get(omni.LabelCluster)
+
+		return fmt.Errorf("cannot reject the machine %q as it is allocated to the cluster %q, remove it from the cluster first", res.Metadata().ID(), clusterName)
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *siderolink.MachineAcceptance, _ ...state.CreateOption) error {
+			return validate(ctx, res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, oldRes *siderolink.MachineAcceptance, newRes *siderolink.MachineAcceptance, _ ...state.UpdateOption) error {
+			// the machine might have been allocated before it was rejected
+			if oldRes != nil && oldRes.TypedSpec().Value.Phase == newRes.TypedSpec().Value.Phase {
+				return nil
+			}
+
+			return validate(ctx, newRes)
+		})),
+	}
+}
+
 func validateS3Configuration(ctx context.Context, s3Conf *omni.EtcdBackupS3Conf) error {
 	if store.IsEmptyS3Conf(s3Conf) {
 		return nil
@@ -21,16 +21,19 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
 )
@@ -120,6 +123,33 @@ func TestClusterValidation(t *testing.T) {
 	cluster.TypedSpec().Value.KubernetesVersion = "1.28.1"
 
 	require.NoError(t, st.Create(ctx, cluster))
+
+	// invalid upgrade policy
+	cluster.TypedSpec().Value.TalosUpgradePolicy = &specs.TalosUpgradePolicy{
+		WorkersPercentage: 150,
+	}
+
+	err = st.Update(ctx, cluster)
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "workers percentage")
+
+	cluster.TypedSpec().Value.TalosUpgradePolicy.WorkersPercentage = 50
+
+	require.NoError(t, st.Update(ctx, cluster))
+
+	// invalid upgrade channel
+	cluster.TypedSpec().Value.UpgradeChannel = &specs.UpgradeChannel{
+		Talos:      "latest",
+		Kubernetes: "1.29",
+	}
+
+	err = st.Update(ctx, cluster)
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "talos upgrade channel")
+
+	cluster.TypedSpec().Value.UpgradeChannel.Talos = omnires.UpgradeChannelStable
+
+	require.NoError(t, st.Update(ctx, cluster))
 }
 
 func TestRelationLabelsValidation(t *testing.T) {
@@ -251,6 +281,60 @@ func TestMachineSetValidation(t *testing.T) {
 	assert.True(t, validated.IsValidationError(err), "expected validation error")
 	assert.ErrorContains(t, err, "worker machine set must not have ID")
 
+	// surge without the machine class
+
+	machineSet5 := omnires.NewMachineSet(resources.DefaultNamespace, "test-cluster-surge")
+
+	machineSet5.Metadata().Labels().Set(omnires.LabelCluster, "test-cluster")
+	machineSet5.Metadata().Labels().Set(omnires.LabelWorkerRole, "")
+
+	machineSet5.TypedSpec().Value.UpdateStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
+		Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
+			MaxSurge: 1,
+		},
+	}
+
+	err = st.Create(ctx, machineSet5)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "max surge requires the rolling update strategy")
+
+	machineSet5.TypedSpec().Value.UpdateStrategy = specs.MachineSetSpec_Rolling
+
+	err = st.Create(ctx, machineSet5)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "max surge requires the machine class with the static allocation type")
+
+	// invalid maintenance window
+
+	machineSet5.TypedSpec().Value.UpdateStrategyConfig = nil
+	machineSet5.TypedSpec().Value.MaintenanceWindow = &specs.MaintenanceWindow{
+		Schedule: "0 25 * * *",
+		Duration: durationpb.New(time.Hour),
+	}
+
+	err = st.Create(ctx, machineSet5)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "invalid maintenance window hour")
+
+	machineSet5.TypedSpec().Value.MaintenanceWindow.Schedule = "0 2 * * 6"
+	machineSet5.TypedSpec().Value.MaintenanceWindow.Timezone = "Europe/Berlin"
+
+	// forced drain without the timeout
+
+	machineSet5.TypedSpec().Value.DeleteStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
+		Drain: &specs.MachineSetSpec_DrainConfig{
+			Force: true,
+		},
+	}
+
+	err = st.Create(ctx, machineSet5)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "forced drain requires the drain timeout")
+
+	machineSet5.TypedSpec().Value.DeleteStrategyConfig.Drain.Timeout = durationpb.New(5 * time.Minute)
+
+	assert.NoError(t, st.Create(ctx, machineSet5))
+
 	// no cluster exists
 
 	machineSet4 := omnires.NewMachineSet(resources.DefaultNamespace, "test-cluster-tearing-down-control-planes")
@@ -433,6 +517,31 @@ func TestMachineSetLockedAnnotation(t *testing.T) {
 	assert.ErrorContains(st.Create(ctx, machineSetNode), "locking controlplanes is not allowed")
 }
 
+func TestMachineSetNodePendingAcceptance(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.MachineSetNodeValidationOptions(state.WrapCore(innerSt))...)
+
+	machine := omnires.NewMachine(resources.DefaultNamespace, "pending")
+	machine.Metadata().Labels().Set(omnires.MachineStatusLabelPendingAcceptance, "")
+
+	require.NoError(t, st.Create(ctx, machine))
+
+	machineSet := omnires.NewMachineSet(resources.DefaultNamespace, "test-machine-set")
+	machineSetNode := omnires.NewMachineSetNode(resources.DefaultNamespace, machine.Metadata().ID(), machineSet)
+
+	err := st.Create(ctx, machineSetNode)
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "not accepted yet")
+
+	machine.Metadata().Labels().Delete(omnires.MachineStatusLabelPendingAcceptance)
+	require.NoError(t, st.Update(ctx, machine))
+
+	require.NoError(t, st.Create(ctx, machineSetNode))
+}
+
 func TestIdentitySAML(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
@@ -608,6 +717,108 @@ machine:
 	require.ErrorContains(t, err, "tearing down")
 }
 
+func TestConfigPatchLibraryValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.ConfigPatchLibraryValidationOptions(innerSt)...)
+
+	library := omnires.NewConfigPatchLibrary(resources.DefaultNamespace, "ntp")
+	library.TypedSpec().Value.Data = strings.TrimSpace(`
+machine:
+  token: test
+`)
+
+	err := st.Create(ctx, library)
+	require.ErrorContains(t, err, "is not allowed in the config patch")
+
+	library.TypedSpec().Value.Data = strings.TrimSpace(`
+machine:
+  time:
+    servers:
+      - time.cloudflare.com
+`)
+
+	require.NoError(t, st.Create(ctx, library))
+
+	cluster := omnires.NewCluster(resources.DefaultNamespace, "cluster")
+	cluster.TypedSpec().Value.ConfigPatchLibraries = []*specs.ConfigPatchLibraryRef{{Name: "ntp"}}
+
+	require.NoError(t, innerSt.Create(ctx, cluster))
+
+	err = st.Destroy(ctx, library.Metadata())
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	require.ErrorContains(t, err, `config patch library "ntp" is used by the cluster "cluster"`)
+
+	require.NoError(t, innerSt.Destroy(ctx, cluster.Metadata()))
+	require.NoError(t, st.Destroy(ctx, library.Metadata()))
+}
+
+func TestConfigPatchLibraryRefValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	etcdBackupStoreFactory, err := store.NewStoreFactory()
+	require.NoError(t, err)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.MachineSetValidationOptions(innerSt, etcdBackupStoreFactory)...)
+
+	require.NoError(t, innerSt.Create(ctx, omnires.NewCluster(resources.DefaultNamespace, "cluster")))
+	require.NoError(t, innerSt.Create(ctx, omnires.NewConfigPatchLibrary(resources.DefaultNamespace, "ntp")))
+
+	version := omnires.NewConfigPatchLibraryVersion(resources.DefaultNamespace, omnires.ConfigPatchLibraryVersionID("ntp", 1))
+	version.Metadata().Labels().Set(omnires.LabelConfigPatchLibrary, "ntp")
+	version.TypedSpec().Value.Version = 1
+
+	require.NoError(t, innerSt.Create(ctx, version))
+
+	machineSet := omnires.NewMachineSet(resources.DefaultNamespace, omnires.WorkersResourceID("cluster"))
+	machineSet.Metadata().Labels().Set(omnires.LabelCluster, "cluster")
+	machineSet.Metadata().Labels().Set(omnires.LabelWorkerRole, "")
+
+	for _, tt := range []struct {
+		name   string
+		errMsg string
+		refs   []*specs.ConfigPatchLibraryRef
+	}{
+		{
+			name:   "no name or labels",
+			refs:   []*specs.ConfigPatchLibraryRef{{}},
+			errMsg: "should have either name or match labels set",
+		},
+		{
+			name:   "missing library",
+			refs:   []*specs.ConfigPatchLibraryRef{{Name: "sysctls"}},
+			errMsg: `config patch library with name "sysctls" doesn't exist`,
+		},
+		{
+			name:   "version with labels",
+			refs:   []*specs.ConfigPatchLibraryRef{{MatchLabels: []string{"library=ntp"}, Version: 1}},
+			errMsg: "version can be pinned only for the library referenced by name",
+		},
+		{
+			name:   "missing version",
+			refs:   []*specs.ConfigPatchLibraryRef{{Name: "ntp", Version: 2}},
+			errMsg: `version 2 of the config patch library "ntp" doesn't exist`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			invalid := machineSet.DeepCopy().(*omnires.MachineSet) //nolint:forcetypeassert,errcheck
+			invalid.TypedSpec().Value.ConfigPatchLibraries = tt.refs
+
+			err = st.Create(ctx, invalid)
+			require.True(t, validated.IsValidationError(err), "expected validation error")
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+
+	machineSet.TypedSpec().Value.ConfigPatchLibraries = []*specs.ConfigPatchLibraryRef{{Name: "ntp", Version: 1}}
+
+	require.NoError(t, st.Create(ctx, machineSet))
+}
+
 func TestEtcdBackupValidation(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
@@ -628,6 +839,213 @@ func TestEtcdBackupValidation(t *testing.T) {
 	require.NoError(t, st.Create(ctx, backup))
 }
 
+func TestEtcdRestoreValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	clusterID := "test-cluster"
+	clusterUUID := "70fcf307-f9be-40dc-81de-6a427adfc1d4"
+	snapshotName := "test-snapshot"
+
+	etcdBackupStoreFactory := mockEtcdBackupStoreFactory{
+		store: &mockEtcdBackupStore{
+			clusterUUID:  clusterUUID,
+			snapshotName: snapshotName,
+			backupData: etcdbackup.BackupData{
+				AESCBCEncryptionSecret:    "aes-cbc-secret",
+				SecretboxEncryptionSecret: "secretbox-secret",
+			},
+		},
+	}
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.EtcdRestoreValidationOptions(innerSt, &etcdBackupStoreFactory)...)
+
+	restore := omnires.NewEtcdRestore(clusterID)
+	restore.TypedSpec().Value.ClusterUuid = clusterUUID
+	restore.TypedSpec().Value.Snapshot = snapshotName
+
+	// cluster doesn't exist
+
+	err := st.Create(ctx, restore)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "does not exist")
+
+	require.NoError(t, st.Create(ctx, omnires.NewCluster(resources.DefaultNamespace, clusterID)))
+
+	// cluster is not bootstrapped
+
+	err = st.Create(ctx, restore)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "is not bootstrapped")
+
+	bootstrapStatus := omnires.NewClusterBootstrapStatus(resources.DefaultNamespace, clusterID)
+	bootstrapStatus.TypedSpec().Value.Bootstrapped = true
+
+	require.NoError(t, st.Create(ctx, bootstrapStatus))
+
+	clusterUUIDRes := omnires.NewClusterUUID(clusterID)
+	clusterUUIDRes.TypedSpec().Value.Uuid = clusterUUID
+
+	clusterUUIDRes.Metadata().Labels().Set(omnires.LabelClusterUUID, clusterUUID)
+
+	require.NoError(t, st.Create(ctx, clusterUUIDRes))
+
+	backupData := omnires.NewBackupData(clusterID)
+	backupData.TypedSpec().Value.AesCbcEncryptionSecret = "aes-cbc-secret"
+	backupData.TypedSpec().Value.SecretboxEncryptionSecret = "secretbox-secret"
+
+	require.NoError(t, st.Create(ctx, backupData))
+
+	// non-existent snapshot
+
+	restore.TypedSpec().Value.Snapshot = "wrong-snapshot-name"
+
+	err = st.Create(ctx, restore)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "not found")
+
+	// success
+
+	restore.TypedSpec().Value.Snapshot = snapshotName
+
+	require.NoError(t, st.Create(ctx, restore))
+
+	// the spec is immutable
+
+	restore.TypedSpec().Value.Snapshot = "different"
+
+	err = st.Update(ctx, restore)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "etcd restore spec is immutable after creation")
+}
+
+func TestCustomRoleValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, append(omni.AccessPolicyValidationOptions(innerSt), omni.RoleValidationOptions(innerSt)...)...)
+
+	user := auth.NewUser(resources.DefaultNamespace, "on-call-user")
+	user.TypedSpec().Value.Role = "on-call"
+
+	// the custom role is not defined yet
+	err := st.Create(ctx, user)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `unknown role: "on-call"`)
+
+	accessPolicy := auth.NewAccessPolicy()
+	accessPolicy.TypedSpec().Value.Roles = map[string]*specs.AccessPolicyRole{
+		"on-call": {
+			BaseRole:     string(role.Reader),
+			Capabilities: []string{string(role.CapabilityRebootMachines)},
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, accessPolicy))
+	require.NoError(t, st.Create(ctx, user))
+
+	// the role can't be removed while it is assigned to a user
+	accessPolicy.TypedSpec().Value.Roles = nil
+
+	err = st.Update(ctx, accessPolicy)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `role "on-call" is assigned to the user "on-call-user"`)
+
+	err = st.Destroy(ctx, accessPolicy.Metadata())
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `role "on-call" is assigned to the user "on-call-user"`)
+
+	user.TypedSpec().Value.Role = string(role.Operator)
+
+	require.NoError(t, st.Update(ctx, user))
+
+	// the role can't be removed while it is assigned to a service account key either
+	publicKey := auth.NewPublicKey(resources.DefaultNamespace, "on-call-key")
+	publicKey.TypedSpec().Value.Role = "on-call"
+	publicKey.TypedSpec().Value.Identity = &specs.Identity{Email: "on-call@serviceaccount.omni.sidero.dev"}
+
+	require.NoError(t, st.Create(ctx, publicKey))
+
+	err = st.Update(ctx, accessPolicy)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `role "on-call" is assigned to the public key "on-call-key" of "on-call@serviceaccount.omni.sidero.dev"`)
+
+	err = st.Destroy(ctx, accessPolicy.Metadata())
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+
+	require.NoError(t, st.Destroy(ctx, publicKey.Metadata()))
+
+	// the role can't be removed while an active access grant references it
+	grant := auth.NewAccessGrant("on-call-grant")
+	grant.TypedSpec().Value.Identity = "on-call@example.com"
+	grant.TypedSpec().Value.Role = "on-call"
+	grant.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(time.Hour))
+
+	require.NoError(t, st.Create(ctx, grant))
+
+	err = st.Update(ctx, accessPolicy)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `role "on-call" is granted to "on-call@example.com" by the access grant "on-call-grant"`)
+
+	err = st.Destroy(ctx, accessPolicy.Metadata())
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+
+	// the expired grants don't hold the role
+	grant.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(-time.Minute))
+
+	require.NoError(t, st.Update(ctx, grant))
+
+	require.NoError(t, st.Update(ctx, accessPolicy))
+	require.NoError(t, st.Destroy(ctx, accessPolicy.Metadata()))
+}
+
+func TestAccessGrantValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.AccessGrantValidationOptions(innerSt)...)
+
+	grant := auth.NewAccessGrant("break-glass")
+	grant.TypedSpec().Value.Role = "invalid"
+	grant.TypedSpec().Value.Clusters = []string{"prod-["}
+	grant.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(-time.Minute))
+
+	err := st.Create(ctx, grant)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "identity is not set")
+	assert.ErrorContains(t, err, "invalid cluster match pattern")
+	assert.ErrorContains(t, err, `unknown role: "invalid"`)
+	assert.ErrorContains(t, err, "expiration should be in the future")
+	assert.ErrorContains(t, err, "reason is not set")
+
+	grant.TypedSpec().Value.Identity = "on-call@example.com"
+	grant.TypedSpec().Value.Role = string(role.Operator)
+	grant.TypedSpec().Value.Clusters = []string{"prod-*"}
+	grant.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(30 * 24 * time.Hour))
+	grant.TypedSpec().Value.Reason = "incident"
+
+	// the grants are limited in time
+	err = st.Create(ctx, grant)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "expiration should be within 24h0m0s")
+
+	grant.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(2 * time.Hour))
+
+	require.NoError(t, st.Create(ctx, grant))
+
+	// the grant can't be extended, a new one should be created instead
+	grant.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(4 * time.Hour))
+
+	err = st.Update(ctx, grant)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "immutable")
+
+	require.NoError(t, st.Destroy(ctx, grant.Metadata()))
+}
+
 func TestSAMLLabelRuleValidation(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
@@ -654,6 +1072,41 @@ func TestSAMLLabelRuleValidation(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMachineClassValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, omni.MachineClassValidationOptions()...)
+
+	machineClass := omnires.NewMachineClass(resources.DefaultNamespace, "test-class")
+	machineClass.TypedSpec().Value.MatchLabels = []string{"--invalid--- ===== 5"}
+	machineClass.TypedSpec().Value.Requirements = &specs.MachineClassSpec_Requirements{
+		Disks: []*specs.MachineClassSpec_Requirements_Disk{
+			{
+				Type: "tape",
+			},
+		},
+	}
+
+	err := st.Create(ctx, machineClass)
+	assert.ErrorContains(t, err, "invalid match labels")
+	assert.ErrorContains(t, err, `unknown disk type "tape"`)
+
+	machineClass.TypedSpec().Value.MatchLabels = nil
+	machineClass.TypedSpec().Value.Requirements.Disks[0] = &specs.MachineClassSpec_Requirements_Disk{
+		Type:  "NVMe",
+		Model: "Samsung[",
+	}
+
+	err = st.Create(ctx, machineClass)
+	assert.ErrorContains(t, err, `invalid disk model pattern "Samsung["`)
+
+	machineClass.TypedSpec().Value.Requirements.Disks[0].Model = "Samsung*"
+
+	require.NoError(t, st.Create(ctx, machineClass))
+}
+
 func TestMachineSetClassesValidation(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
@@ -760,6 +1213,95 @@ func TestS3ConfigValidation(t *testing.T) {
 	require.NoError(t, st.Update(ctx, res))
 }
 
+func TestJoinTokenValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	st := validated.NewState(innerSt, omni.JoinTokenValidationOptions(innerSt)...)
+
+	token := strings.Repeat("a", siderolink.JoinTokenMinLength)
+
+	res := siderolink.NewJoinToken(resources.DefaultNamespace, "short")
+	res.TypedSpec().Value.Token = "short"
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, res)), "expected validation error")
+
+	res = siderolink.NewJoinToken(resources.DefaultNamespace, "site-a")
+	res.TypedSpec().Value.Token = token
+	res.TypedSpec().Value.MachineLabels = map[string]string{omnires.LabelCluster: "cluster"}
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, res)), "expected validation error")
+
+	res.TypedSpec().Value.MachineLabels = map[string]string{"site": "a"}
+
+	require.NoError(t, st.Create(ctx, res))
+
+	duplicate := siderolink.NewJoinToken(resources.DefaultNamespace, "site-b")
+	duplicate.TypedSpec().Value.Token = token
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, duplicate)), "expected validation error")
+
+	res.TypedSpec().Value.Cluster = "cluster"
+	res.TypedSpec().Value.MachineClass = "class"
+
+	require.True(t, validated.IsValidationError(st.Update(ctx, res)), "expected validation error")
+
+	res.TypedSpec().Value.MachineClass = ""
+
+	require.NoError(t, st.Update(ctx, res))
+
+	// the uses are counted by Omni only
+	res.TypedSpec().Value.Uses = 1
+
+	require.NoError(t, st.Update(actor.MarkContextAsInternalActor(ctx), res))
+
+	res.TypedSpec().Value.Uses = 0
+
+	require.True(t, validated.IsValidationError(st.Update(ctx, res)), "expected validation error")
+}
+
+func TestMachineAcceptanceValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	st := validated.NewState(innerSt, omni.MachineAcceptanceValidationOptions(innerSt)...)
+
+	machineSetNode := omnires.NewMachineSetNode(resources.DefaultNamespace, "allocated", omnires.NewMachineSet(resources.DefaultNamespace, "cluster-workers"))
+	machineSetNode.Metadata().Labels().Set(omnires.LabelCluster, "cluster")
+
+	require.NoError(t, innerSt.Create(ctx, machineSetNode))
+
+	// the machines which are not in a cluster can be rejected
+	res := siderolink.NewMachineAcceptance(siderolink.Namespace, "free")
+	res.TypedSpec().Value.Phase = specs.MachineAcceptanceSpec_Rejected
+
+	require.NoError(t, st.Create(ctx, res))
+
+	res = siderolink.NewMachineAcceptance(siderolink.Namespace, "allocated")
+	res.TypedSpec().Value.Phase = specs.MachineAcceptanceSpec_Rejected
+
+	err := st.Create(ctx, res)
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	require.ErrorContains(t, err, `allocated to the cluster "cluster"`)
+
+	res.TypedSpec().Value.Phase = specs.MachineAcceptanceSpec_Accepted
+
+	require.NoError(t, st.Create(ctx, res))
+
+	res.TypedSpec().Value.Phase = specs.MachineAcceptanceSpec_Rejected
+
+	require.True(t, validated.IsValidationError(st.Update(ctx, res)), "expected validation error")
+
+	// the machine is removed from the cluster
+	require.NoError(t, innerSt.Destroy(ctx, machineSetNode.Metadata()))
+
+	require.NoError(t, st.Update(ctx, res))
+}
+
 func TestSchematicConfigurationValidation(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -817,6 +1359,10 @@ func (m *mockEtcdBackupStore) Upload(context.Context, etcdbackup.Description, io
 	return nil
 }
 
+func (m *mockEtcdBackupStore) Remove(context.Context, string, string) error {
+	return nil
+}
+
 func (m *mockEtcdBackupStore) Download(_ context.Context, _ []byte, clusterUUID, snapshotName string) (etcdbackup.BackupData, io.ReadCloser, error) {
 	if m.clusterUUID == clusterUUID && m.snapshotName == snapshotName {
 		return m.backupData, io.NopCloser(strings.NewReader("test")), nil
@@ -226,28 +226,38 @@ func (v *State) permissions(ctx context.Context) (*virtual.Permissions, error) {
 }
 
 func (v *State) clusterPermissions(ctx context.Context, ptr resource.Pointer) (*virtual.ClusterPermissions, error) {
-	userRole, _, err := accesspolicy.RoleForCluster(ctx, ptr.ID(), v.PrimaryState)
+	capabilities, err := accesspolicy.CapabilitiesForCluster(ctx, ptr.ID(), v.PrimaryState)
 	if err != nil {
 		return nil, err
 	}
 
 	clusterPermissions := virtual.NewClusterPermissions(ptr.ID())
 
-	if userRole.Check(role.Reader) == nil {
-		clusterPermissions.TypedSpec().Value.CanRebootMachines = true
-		clusterPermissions.TypedSpec().Value.CanDownloadKubeconfig = true
-		clusterPermissions.TypedSpec().Value.CanDownloadTalosconfig = true
-		clusterPermissions.TypedSpec().Value.CanReadConfigPatches = true
-	}
-
-	if userRole.Check(role.Operator) == nil {
-		clusterPermissions.TypedSpec().Value.CanAddMachines = true
-		clusterPermissions.TypedSpec().Value.CanRemoveMachines = true
-		clusterPermissions.TypedSpec().Value.CanUpdateKubernetes = true
-		clusterPermissions.TypedSpec().Value.CanUpdateTalos = true
-		clusterPermissions.TypedSpec().Value.CanManageConfigPatches = true
-		clusterPermissions.TypedSpec().Value.CanSyncKubernetesManifests = true
-		clusterPermissions.TypedSpec().Value.CanManageClusterFeatures = true
+	for _, capability := range capabilities {
+		switch capability {
+		case role.CapabilityReadConfigPatches:
+			clusterPermissions.TypedSpec().Value.CanReadConfigPatches = true
+		case role.CapabilityRebootMachines:
+			clusterPermissions.TypedSpec().Value.CanRebootMachines = true
+		case role.CapabilityDownloadKubeconfig:
+			clusterPermissions.TypedSpec().Value.CanDownloadKubeconfig = true
+		case role.CapabilityDownloadTalosconfig:
+			clusterPermissions.TypedSpec().Value.CanDownloadTalosconfig = true
+		case role.CapabilityAddMachines:
+			clusterPermissions.TypedSpec().Value.CanAddMachines = true
+		case role.CapabilityRemoveMachines:
+			clusterPermissions.TypedSpec().Value.CanRemoveMachines = true
+		case role.CapabilityUpdateKubernetes:
+			clusterPermissions.TypedSpec().Value.CanUpdateKubernetes = true
+		case role.CapabilityUpdateTalos:
+			clusterPermissions.TypedSpec().Value.CanUpdateTalos = true
+		case role.CapabilityManageConfigPatches:
+			clusterPermissions.TypedSpec().Value.CanManageConfigPatches = true
+		case role.CapabilitySyncKubernetesManifests:
+			clusterPermissions.TypedSpec().Value.CanSyncKubernetesManifests = true
+		case role.CapabilityManageClusterFeatures:
+			clusterPermissions.TypedSpec().Value.CanManageClusterFeatures = true
+		}
 	}
 
 	version, err := resource.ParseVersion("1")
@@ -53,6 +53,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/audit"
 	"github.com/siderolabs/omni/internal/backend/debug"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/factory"
@@ -73,6 +74,7 @@ import (
 	"github.com/siderolabs/omni/internal/frontend"
 	"github.com/siderolabs/omni/internal/memconn"
 	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/auth0"
 	"github.com/siderolabs/omni/internal/pkg/auth/handler"
@@ -92,6 +94,7 @@ type Server struct {
 	omniRuntime                  *omni.Runtime
 	logger                       *zap.Logger
 	logHandler                   *siderolink.LogHandler
+	auditLog                     *audit.Log
 	authConfig                   *authres.Config
 	dnsService                   *dns.Service
 	workloadProxyServiceRegistry *workloadproxy.ServiceRegistry
@@ -118,6 +121,7 @@ func NewServer(
 	omniRuntime *omni.Runtime,
 	talosRuntime *talos.Runtime,
 	logHandler *siderolink.LogHandler,
+	auditLog *audit.Log,
 	authConfig *authres.Config,
 	keyFile, certFile string,
 	proxyServer Proxy,
@@ -127,6 +131,7 @@ func NewServer(
 		omniRuntime:                  omniRuntime,
 		logger:                       logger.With(logging.Component("server")),
 		logHandler:                   logHandler,
+		auditLog:                     auditLog,
 		authConfig:                   authConfig,
 		dnsService:                   dnsService,
 		workloadProxyServiceRegistry: workloadProxyServiceRegistry,
@@ -206,7 +211,7 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
-	serviceServers, err := grpcomni.MakeServiceServers(runtimeState, s.logHandler, oidcProvider, oidcStorage, s.dnsService, s.imageFactoryClient, s.logger)
+	serviceServers, err := grpcomni.MakeServiceServers(runtimeState, s.logHandler, s.auditLog, oidcProvider, oidcStorage, s.dnsService, s.imageFactoryClient, s.logger)
 	if err != nil {
 		return err
 	}
@@ -241,12 +246,16 @@ func (s *Server) Run(ctx context.Context) error {
 	)
 	crtData := certData{certFile: s.certFile, keyFile: s.keyFile}
 
-	workloadProxyHandler, err := s.workloadProxyHandler(mux)
+	// the workload proxy handler wraps the gRPC handler, so the gRPC requests to the exposed services are proxied to them
+	unifiedHandler, err := s.workloadProxyHandler(unifyHandler(mux, grpcProxyServer))
 	if err != nil {
 		return fmt.Errorf("failed to create workload proxy handler: %w", err)
 	}
 
-	unifiedHandler := unifyHandler(workloadProxyHandler, grpcProxyServer, crtData)
+	if value.IsZero(crtData) {
+		// If we don't have TLS data, wrap the handler in http2.Server
+		unifiedHandler = h2c.NewHandler(unifiedHandler, &http2.Server{})
+	}
 
 	fns := []func() error{
 		func() error { return runGRPCServer(ctx, grpcProxyServer, gatewayTransport, s.logger) },
@@ -340,6 +349,14 @@ func (s *Server) buildServerOptions() ([]grpc.ServerOption, error) {
 	unaryInterceptors = append(unaryInterceptors, unaryAuthInterceptors...)
 	streamInterceptors = append(streamInterceptors, streamAuthInterceptors...)
 
+	// audit interceptor goes after the auth ones to know the identity of the caller
+	if s.auditLog != nil {
+		auditInterceptor := audit.NewInterceptor(s.auditLog, s.omniRuntime.State(), s.logger.With(logging.Component("audit")))
+
+		unaryInterceptors = append(unaryInterceptors, auditInterceptor.Unary())
+		streamInterceptors = append(streamInterceptors, auditInterceptor.Stream())
+	}
+
 	return []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
@@ -431,20 +448,34 @@ func (s *Server) authenticatorFunc() auth.AuthenticatorFunc {
 			return nil, err
 		}
 
-		finalRole, err := role.Min(role.Role(user.TypedSpec().Value.GetRole()), role.Role(pubKey.TypedSpec().Value.GetRole()))
+		userRole, userCapabilities, err := accesspolicy.ResolveRoleFromState(ctx, s.omniRuntime.State(), user.TypedSpec().Value.GetRole())
+		if err != nil {
+			return nil, err
+		}
+
+		pubKeyRole, pubKeyCapabilities, err := accesspolicy.ResolveRoleFromState(ctx, s.omniRuntime.State(), pubKey.TypedSpec().Value.GetRole())
+		if err != nil {
+			return nil, err
+		}
+
+		finalRole, err := role.Min(userRole, pubKeyRole)
 		if err != nil {
 			return nil, err
 		}
 
+		finalCapabilities := role.IntersectCapabilities(userRole, userCapabilities, pubKeyRole, pubKeyCapabilities)
+
 		if config.Config.Auth.Suspended {
 			finalRole = role.Reader
+			finalCapabilities = nil
 		}
 
 		return &auth.Authenticator{
-			UserID:   userID,
-			Identity: pubKey.TypedSpec().Value.GetIdentity().GetEmail(),
-			Role:     finalRole,
-			Verifier: verifier,
+			UserID:       userID,
+			Identity:     pubKey.TypedSpec().Value.GetIdentity().GetEmail(),
+			Role:         finalRole,
+			Capabilities: finalCapabilities,
+			Verifier:     verifier,
 		}, nil
 	}
 }
@@ -934,8 +965,8 @@ func runGRPCServer(ctx context.Context, server *grpc.Server, transport *memconn.
 	return nil
 }
 
-func unifyHandler(handler http.Handler, grpcServer *grpc.Server, data certData) http.Handler {
-	h := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+func unifyHandler(handler http.Handler, grpcServer *grpc.Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.ProtoMajor == 2 && strings.HasPrefix(
 			req.Header.Get("Content-Type"), "application/grpc") {
 			// grpcProxyServer provides top-level gRPC proxy handler.
@@ -946,14 +977,7 @@ func unifyHandler(handler http.Handler, grpcServer *grpc.Server, data certData)
 
 		// handler contains "regular" HTTP handlers
 		handler.ServeHTTP(w, req)
-	}))
-
-	if value.IsZero(data) {
-		// If we don't have TLS data, wrap the handler in http2.Server
-		h = h2c.NewHandler(h, &http2.Server{})
-	}
-
-	return h
+	})
 }
 
 func runPprofServer(ctx context.Context, bindAddress string, l *zap.Logger) error {
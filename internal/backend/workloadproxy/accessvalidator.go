@@ -114,12 +114,13 @@ func (p *PGPAccessValidator) ValidateAccess(ctx context.Context, publicKeyID, pu
 
 	publicKeyRoleStr := publicKey.TypedSpec().Value.GetRole()
 	if publicKeyRoleStr != "" {
-		publicKeyRole, parseErr := role.Parse(publicKeyRoleStr)
+		publicKeyRole, publicKeyCapabilities, parseErr := accesspolicy.ResolveRoleFromState(ctx, p.state, publicKeyRoleStr)
 		if parseErr != nil {
 			return parseErr
 		}
 
 		ctx = context.WithValue(ctx, auth.RoleContextKey{}, publicKeyRole)
+		ctx = context.WithValue(ctx, auth.CapabilitiesContextKey{}, publicKeyCapabilities)
 	}
 
 	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, publicKey.TypedSpec().Value.GetIdentity().GetEmail())
@@ -22,6 +22,8 @@ import (
 )
 
 // ProxyProvider is a provider of HTTP proxies for the exposed services.
+//
+// The returned proxies might also serve the protocol upgrades, the h2c requests and the CONNECT tunnels, depending on the exposed service protocol.
 type ProxyProvider interface {
 	GetProxy(alias string) (http.Handler, resource.ID, error)
 }
@@ -119,6 +121,12 @@ func (h *HTTPHandler) isWorkloadProxyRequest(request *http.Request) bool {
 func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Request, proxy http.Handler, clusterID resource.ID) {
 	publicKeyID, publicKeyIDSignatureBase64 := h.getSignatureCookies(request)
 	if publicKeyID == "" || publicKeyIDSignatureBase64 == "" {
+		if !isBrowserRequest(request) {
+			http.Error(writer, "missing workload proxy authentication cookies", http.StatusUnauthorized)
+
+			return
+		}
+
 		h.redirectToLogin(writer, request)
 
 		return
@@ -127,6 +135,12 @@ func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Req
 	if err := h.accessValidator.ValidateAccess(request.Context(), publicKeyID, publicKeyIDSignatureBase64, clusterID); err != nil {
 		h.logger.Warn("failed to validate access", zap.Error(err))
 
+		if !isBrowserRequest(request) {
+			http.Error(writer, "access to the exposed service is forbidden", http.StatusForbidden)
+
+			return
+		}
+
 		forbiddenURL := h.mainURL.JoinPath("/forbidden").String()
 
 		http.Redirect(writer, request, forbiddenURL, http.StatusSeeOther)
@@ -137,6 +151,13 @@ func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Req
 	proxy.ServeHTTP(writer, request)
 }
 
+// isBrowserRequest returns true if the request can be redirected to the Omni login and forbidden pages.
+//
+// The CONNECT tunnels and the gRPC clients don't follow the redirects, so they get the plain error statuses instead.
+func isBrowserRequest(request *http.Request) bool {
+	return request.Method != http.MethodConnect && !strings.HasPrefix(request.Header.Get("Content-Type"), "application/grpc")
+}
+
 // parseServiceAliasFromHost parses the service alias from the request host.
 //
 // The host will have the pattern: p-<alias>-<instance-name>.<main domain>.
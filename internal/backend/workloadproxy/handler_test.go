@@ -158,4 +158,31 @@ func TestHandler(t *testing.T) {
 		require.Equal(t, []string{testPublicKeyIDSignatureBase64}, accessValidator.publicKeyIDSignatureBase64s)
 		require.Equal(t, []resource.ID{"test-cluster"}, accessValidator.clusterIDs)
 	})
+
+	t.Run("tunnel request without cookies", func(t *testing.T) {
+		t.Parallel()
+
+		next := &mockHandler{}
+		proxyProvider := &mockProxyProvider{}
+		accessValidator := &mockAccessValidator{}
+		logger := zaptest.NewLogger(t)
+
+		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, logger)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		testServiceAlias := "testsvc3"
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodConnect, fmt.Sprintf("https://%s-%s-instanceid.example.com:443", workloadproxy.HostPrefix, testServiceAlias), nil)
+		require.NoError(t, err)
+
+		handler.ServeHTTP(rr, req)
+
+		require.Equal(t, []string{testServiceAlias}, proxyProvider.aliases)
+
+		// the tunnel clients can't follow the login redirect
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+		require.Empty(t, accessValidator.publicKeyIDs)
+	})
 }
@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http2"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// tunnelDialTimeout is the timeout of dialing the exposed service for a TCP tunnel.
+const tunnelDialTimeout = 10 * time.Second
+
+// h2cTransport is shared by all h2c proxies, so the HTTP/2 connections to the exposed services are reused.
+var h2cTransport = &http2.Transport{
+	AllowHTTP: true,
+	DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+		var dialer net.Dialer
+
+		return dialer.DialContext(ctx, network, addr)
+	},
+}
+
+// newProxy returns the handler which proxies the requests to the exposed service at the given address using the protocol of the service.
+func newProxy(protocol specs.ExposedServiceSpec_Protocol, address string) http.Handler {
+	targetURL := &url.URL{
+		Scheme: "http",
+		Host:   address,
+	}
+
+	switch protocol {
+	case specs.ExposedServiceSpec_H2C:
+		proxy := httputil.NewSingleHostReverseProxy(targetURL)
+
+		proxy.Transport = h2cTransport
+		proxy.FlushInterval = -1 // gRPC streams
+
+		return proxy
+	case specs.ExposedServiceSpec_TCP:
+		return &tunnelProxy{address: address}
+	case specs.ExposedServiceSpec_HTTP:
+		fallthrough
+	default:
+		// the protocol upgrades (e.g. WebSocket) are handled by the reverse proxy itself, and the streamed responses are flushed immediately
+		return httputil.NewSingleHostReverseProxy(targetURL)
+	}
+}
+
+// tunnelProxy proxies a raw TCP stream tunneled over an HTTP/1.1 CONNECT request to the exposed service.
+type tunnelProxy struct {
+	address string
+}
+
+// ServeHTTP implements http.Handler.
+func (p *tunnelProxy) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodConnect {
+		writer.Header().Set("Allow", http.MethodConnect)
+
+		http.Error(writer, "the service is a TCP service, it can only be reached through a CONNECT tunnel", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if request.ProtoMajor != 1 {
+		http.Error(writer, "the CONNECT tunnels are only supported over HTTP/1.1", http.StatusHTTPVersionNotSupported)
+
+		return
+	}
+
+	dialer := net.Dialer{
+		Timeout: tunnelDialTimeout,
+	}
+
+	upstreamConn, err := dialer.DialContext(request.Context(), "tcp", p.address)
+	if err != nil {
+		http.Error(writer, "failed to dial the service", http.StatusBadGateway)
+
+		return
+	}
+
+	defer upstreamConn.Close() //nolint:errcheck
+
+	conn, buffered, err := http.NewResponseController(writer).Hijack()
+	if err != nil {
+		http.Error(writer, "failed to hijack the connection", http.StatusInternalServerError)
+
+		return
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	// the connection deadlines might be set by the HTTP server
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		return
+	}
+
+	if _, err = io.WriteString(conn, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		return
+	}
+
+	errCh := make(chan error, 2)
+
+	// the client might have sent the data right after the request, so it is read from the buffered reader
+	go tunnelCopy(errCh, upstreamConn, buffered.Reader)
+	go tunnelCopy(errCh, conn, upstreamConn)
+
+	for range 2 {
+		if err = <-errCh; err != nil {
+			return
+		}
+	}
+}
+
+func tunnelCopy(errCh chan<- error, dst net.Conn, src io.Reader) {
+	_, err := io.Copy(dst, src)
+
+	if closeWriter, ok := dst.(interface{ CloseWrite() error }); ok {
+		closeWriter.CloseWrite() //nolint:errcheck
+	}
+
+	if errors.Is(err, net.ErrClosed) {
+		err = nil
+	}
+
+	errCh <- err
+}
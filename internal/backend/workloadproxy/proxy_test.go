@@ -0,0 +1,266 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/workloadproxy"
+)
+
+// exposeService exposes the service listening on the given address with the given protocol and returns its proxy.
+func exposeService(ctx context.Context, t *testing.T, address string, protocol specs.ExposedServiceSpec_Protocol) http.Handler {
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	serviceRegistry, err := workloadproxy.NewServiceRegistry(st, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error {
+		return serviceRegistry.Start(ctx)
+	})
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, eg.Wait())
+	})
+
+	host, portStr, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "cluster")
+	cluster.TypedSpec().Value.Features = &specs.ClusterSpec_Features{
+		EnableWorkloadProxy: true,
+	}
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	exposedService := omni.NewExposedService(resources.DefaultNamespace, "cluster/service")
+	exposedService.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+	exposedService.Metadata().Labels().Set(omni.LabelExposedServiceAlias, "service")
+
+	exposedService.TypedSpec().Value.Port = uint32(port)
+	exposedService.TypedSpec().Value.Protocol = protocol
+
+	require.NoError(t, st.Create(ctx, exposedService))
+
+	clusterMachineStatus := omni.NewClusterMachineStatus(resources.DefaultNamespace, "clustermachine")
+	clusterMachineStatus.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+
+	clusterMachineStatus.TypedSpec().Value.ManagementAddress = host
+	clusterMachineStatus.TypedSpec().Value.Ready = true
+
+	require.NoError(t, st.Create(ctx, clusterMachineStatus))
+
+	var proxy http.Handler
+
+	require.NoError(t, retry.Constant(3*time.Second, retry.WithUnits(50*time.Millisecond)).Retry(func() error {
+		proxy, _, err = serviceRegistry.GetProxy("service")
+		if err != nil {
+			return retry.ExpectedError(err)
+		}
+
+		if proxy == nil {
+			return retry.ExpectedError(errors.New("proxy is nil"))
+		}
+
+		return nil
+	}))
+
+	return proxy
+}
+
+func TestProxyWebSocket(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	// the service upgrades the connection to an echo protocol
+	service := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("Upgrade") != "websocket" {
+			http.Error(writer, "upgrade required", http.StatusUpgradeRequired)
+
+			return
+		}
+
+		conn, buffered, err := http.NewResponseController(writer).Hijack()
+		if err != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		io.WriteString(conn, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n\r\n") //nolint:errcheck
+
+		io.Copy(conn, buffered) //nolint:errcheck
+	}))
+
+	t.Cleanup(service.Close)
+
+	proxyServer := httptest.NewServer(exposeService(ctx, t, service.Listener.Addr().String(), specs.ExposedServiceSpec_HTTP))
+
+	t.Cleanup(proxyServer.Close)
+
+	conn, err := net.Dial("tcp", proxyServer.Listener.Addr().String())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	_, err = io.WriteString(conn, "GET /live HTTP/1.1\r\nHost: service\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n\r\n")
+	require.NoError(t, err)
+
+	reader := bufio.NewReader(conn)
+
+	resp, err := http.ReadResponse(reader, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+
+	_, err = io.WriteString(conn, "hello")
+	require.NoError(t, err)
+
+	buf := make([]byte, 5)
+
+	_, err = io.ReadFull(reader, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+}
+
+func TestProxyH2C(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	service := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Trailer", "Grpc-Status")
+
+		io.WriteString(writer, request.Proto) //nolint:errcheck
+
+		// stream the response like the gRPC servers do
+		http.NewResponseController(writer).Flush() //nolint:errcheck
+
+		writer.Header().Set("Grpc-Status", "0")
+	}), &http2.Server{}))
+
+	t.Cleanup(service.Close)
+
+	proxyServer := httptest.NewServer(exposeService(ctx, t, service.Listener.Addr().String(), specs.ExposedServiceSpec_H2C))
+
+	t.Cleanup(proxyServer.Close)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, proxyServer.URL+"/grpc.health.v1.Health/Check", nil)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { resp.Body.Close() }) //nolint:errcheck
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	// the service is reached over HTTP/2 even though the client request is HTTP/1.1, and the trailers are passed through
+	assert.Equal(t, "HTTP/2.0", string(body))
+	assert.Equal(t, "0", resp.Trailer.Get("Grpc-Status"))
+}
+
+func TestProxyTCP(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { l.Close() }) //nolint:errcheck
+
+	go func() {
+		for {
+			conn, acceptErr := l.Accept()
+			if acceptErr != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close() //nolint:errcheck
+
+				io.Copy(conn, conn) //nolint:errcheck
+			}()
+		}
+	}()
+
+	proxyServer := httptest.NewServer(exposeService(ctx, t, l.Addr().String(), specs.ExposedServiceSpec_TCP))
+
+	t.Cleanup(proxyServer.Close)
+
+	// the plain HTTP requests are rejected
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, proxyServer.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	conn, err := net.Dial("tcp", proxyServer.Listener.Addr().String())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	// the data sent right after the CONNECT request is not lost
+	_, err = io.WriteString(conn, "CONNECT service:443 HTTP/1.1\r\nHost: service:443\r\n\r\nhello")
+	require.NoError(t, err)
+
+	reader := bufio.NewReader(conn)
+
+	resp, err = http.ReadResponse(reader, &http.Request{Method: http.MethodConnect})
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	buf := make([]byte, 5)
+
+	_, err = io.ReadFull(reader, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf))
+}
@@ -12,8 +12,6 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
-	"net/http/httputil"
-	"net/url"
 	"strconv"
 	"sync"
 
@@ -21,6 +19,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 )
@@ -28,6 +27,7 @@ import (
 type serviceEntry struct {
 	clusterID resource.ID
 	port      uint32
+	protocol  specs.ExposedServiceSpec_Protocol
 }
 
 type clusterEntry struct {
@@ -204,6 +204,7 @@ func (s *ServiceRegistry) handleExposedService(res *omni.ExposedService, deleted
 	s.aliasToServiceEntry[alias] = &serviceEntry{
 		clusterID: clusterID,
 		port:      res.TypedSpec().Value.GetPort(),
+		protocol:  res.TypedSpec().Value.GetProtocol(),
 	}
 }
 
@@ -221,6 +222,8 @@ func (s *ServiceRegistry) getOrCreateClusterEntryNoLock(clusterID resource.ID) *
 }
 
 // GetProxy returns a proxy for the given cluster and the alias of the service.
+//
+// The proxy speaks the protocol declared by the exposed service.
 func (s *ServiceRegistry) GetProxy(alias string) (http.Handler, resource.ID, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -253,10 +256,7 @@ func (s *ServiceRegistry) GetProxy(alias string) (http.Handler, resource.ID, err
 		return "" // unreachable
 	}
 
-	targetURL := &url.URL{
-		Scheme: "http",
-		Host:   net.JoinHostPort(getRandomHealthyTargetAddress(), strconv.Itoa(int(service.port))),
-	}
+	address := net.JoinHostPort(getRandomHealthyTargetAddress(), strconv.Itoa(int(service.port)))
 
-	return httputil.NewSingleHostReverseProxy(targetURL), service.clusterID, nil
+	return newProxy(service.protocol, address), service.clusterID, nil
 }
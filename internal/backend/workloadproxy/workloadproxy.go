@@ -6,19 +6,15 @@
 // Package workloadproxy provides functions for proxying traffic to workload clusters.
 package workloadproxy
 
+import "github.com/siderolabs/omni/client/pkg/constants"
+
 const (
 	// HostPrefix is the prefix used to distinguish subdomain requests which should be proxied to the workload clusters.
-	//
-	// tsgen:workloadProxyHostPrefix
-	HostPrefix = "p"
+	HostPrefix = constants.WorkloadProxyHostPrefix
 
 	// PublicKeyIDCookie is the name of the cookie used for workload proxy request authentication that contains the public key ID.
-	//
-	// tsgen:workloadProxyPublicKeyIdCookie
-	PublicKeyIDCookie = "publicKeyId"
+	PublicKeyIDCookie = constants.WorkloadProxyPublicKeyIDCookie
 
 	// PublicKeyIDSignatureBase64Cookie is the name of the cookie used for workload proxy request authentication that contains the signed & base64'd public key ID.
-	//
-	// tsgen:workloadProxyPublicKeyIdSignatureBase64Cookie
-	PublicKeyIDSignatureBase64Cookie = "publicKeyIdSignatureBase64"
+	PublicKeyIDSignatureBase64Cookie = constants.WorkloadProxyPublicKeyIDSignatureBase64Cookie
 )
@@ -12,10 +12,12 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
@@ -29,6 +31,7 @@ const GroupPrefix = "group/"
 // CheckResult is the result of an access policy check.
 type CheckResult struct {
 	Role                        role.Role
+	Capabilities                []role.Capability
 	KubernetesImpersonateGroups []string
 	MatchesAllClusters          bool
 }
@@ -126,10 +129,35 @@ func Validate(accessPolicy *auth.AccessPolicy) error {
 		}
 	}
 
+	// check custom roles
+	for name, customRole := range accessPolicySpec.GetRoles() {
+		if name == "" {
+			validationErrs = multierror.Append(validationErrs, errors.New("access policy contains a custom role with an empty name"))
+
+			continue
+		}
+
+		if _, err := role.Parse(name); err == nil {
+			validationErrs = multierror.Append(validationErrs, fmt.Errorf("access policy custom role %q conflicts with a built-in role", name))
+
+			continue
+		}
+
+		if _, err := role.Parse(customRole.GetBaseRole()); err != nil {
+			validationErrs = multierror.Append(validationErrs, fmt.Errorf("access policy custom role %q has invalid base role: %w", name, err))
+		}
+
+		for _, capability := range customRole.GetCapabilities() {
+			if _, err := role.ParseCapability(capability); err != nil {
+				validationErrs = multierror.Append(validationErrs, fmt.Errorf("access policy custom role %q is invalid: %w", name, err))
+			}
+		}
+	}
+
 	// check rules
 	for _, rule := range accessPolicySpec.GetRules() {
 		if rule.Role != "" {
-			if _, err := role.Parse(rule.Role); err != nil {
+			if _, _, err := ResolveRole(accessPolicy, rule.Role); err != nil {
 				validationErrs = multierror.Append(validationErrs, err)
 			}
 		}
@@ -198,10 +226,14 @@ func Validate(accessPolicy *auth.AccessPolicy) error {
 }
 
 // Check checks the given user against the given cluster, and returns the result of the check, containing
-// which role is assumed and which groups will be impersonated when the Kubernetes cluster is accessed.
+// which role is assumed, which additional capabilities are granted by the custom roles,
+// and which groups will be impersonated when the Kubernetes cluster is accessed.
+//
+// The access policy can be nil. The given access grants which are active and match the user and the cluster
+// are taken into account the same way as the access policy rules.
 //
 //nolint:gocognit,gocyclo,cyclop
-func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Metadata) (CheckResult, error) {
+func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Metadata, grants ...*auth.AccessGrant) (CheckResult, error) {
 	if identityMD == nil {
 		return CheckResult{}, errors.New("no user metadata")
 	}
@@ -210,12 +242,55 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 		return CheckResult{}, errors.New("no cluster metadata")
 	}
 
-	accessPolicySpec := accessPolicy.TypedSpec().Value
+	accessPolicySpec := &specs.AccessPolicySpec{}
+
+	if accessPolicy != nil {
+		accessPolicySpec = accessPolicy.TypedSpec().Value
+	}
+
 	maxRole := role.None
 
+	var capabilities []role.Capability
+
+	addCapabilities := func(roleCapabilities []role.Capability) {
+		for _, capability := range roleCapabilities {
+			if !slices.Contains(capabilities, capability) {
+				capabilities = append(capabilities, capability)
+			}
+		}
+	}
+
+	matchesAllClusters := false
+
+	for _, grant := range grants {
+		matches, matchesAll, err := grantMatches(grant, clusterMD, identityMD, time.Now())
+		if err != nil {
+			return CheckResult{}, err
+		}
+
+		if !matches {
+			continue
+		}
+
+		grantRole, grantCapabilities, err := ResolveRole(accessPolicy, grant.TypedSpec().Value.GetRole())
+		if err != nil {
+			return CheckResult{}, fmt.Errorf("access grant %q: %w", grant.Metadata().ID(), err)
+		}
+
+		if grantRole.Check(maxRole) == nil {
+			maxRole = grantRole
+		}
+
+		addCapabilities(grantCapabilities)
+
+		matchesAllClusters = matchesAllClusters || matchesAll
+	}
+
 	if len(accessPolicySpec.GetRules()) == 0 {
 		return CheckResult{
-			Role: maxRole,
+			Role:               maxRole,
+			Capabilities:       capabilities,
+			MatchesAllClusters: matchesAllClusters,
 		}, nil
 	}
 
@@ -251,8 +326,6 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 		return false, nil
 	}
 
-	matchesAllClusters := false
-
 	for _, rule := range accessPolicySpec.GetRules() {
 		userMatches := false
 
@@ -334,7 +407,7 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 		}
 
 		if rule.Role != "" {
-			parsedRole, err := role.Parse(rule.Role)
+			parsedRole, ruleCapabilities, err := ResolveRole(accessPolicy, rule.Role)
 			if err != nil {
 				return CheckResult{}, err
 			}
@@ -342,6 +415,8 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 			if parsedRole.Check(maxRole) == nil {
 				maxRole = parsedRole
 			}
+
+			addCapabilities(ruleCapabilities)
 		}
 
 		impersonateGroups = append(impersonateGroups, rule.GetKubernetes().GetImpersonate().GetGroups()...)
@@ -350,6 +425,7 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 	return CheckResult{
 		MatchesAllClusters:          matchesAllClusters,
 		Role:                        maxRole,
+		Capabilities:                capabilities,
 		KubernetesImpersonateGroups: impersonateGroups,
 	}, nil
 }
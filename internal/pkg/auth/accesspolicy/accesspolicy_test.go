@@ -9,16 +9,20 @@ import (
 	"bytes"
 	_ "embed"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/yaml.v3"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
 )
 
 //go:embed testdata/acl-valid.yaml
@@ -27,6 +31,9 @@ var aclValidRaw []byte
 //go:embed testdata/acl-valid-match-selector.yaml
 var aclValidMatchSelectorRaw []byte
 
+//go:embed testdata/acl-valid-custom-role.yaml
+var aclValidCustomRoleRaw []byte
+
 //go:embed testdata/acl-invalid-metadata.yaml
 var aclInvalidMetadataRaw []byte
 
@@ -166,6 +173,122 @@ func TestInvalidRole(t *testing.T) {
 	assert.ErrorContains(t, err, "unknown role")
 }
 
+func TestCustomRole(t *testing.T) {
+	accessPolicy := getAccessPolicy(t, aclValidCustomRoleRaw)
+
+	require.NoError(t, accesspolicy.Validate(accessPolicy))
+
+	checkResult, err := accesspolicy.Check(accessPolicy,
+		omni.NewCluster(resources.DefaultNamespace, "prod-2").Metadata(),
+		auth.NewIdentity(resources.DefaultNamespace, "on-call-user-1").Metadata())
+	require.NoError(t, err)
+	assert.Equal(t, role.Reader, checkResult.Role)
+	assert.ElementsMatch(t, []role.Capability{role.CapabilityRebootMachines, role.CapabilitySyncKubernetesManifests}, checkResult.Capabilities)
+
+	// capabilities from multiple matching rules are combined
+	checkResult, err = accesspolicy.Check(accessPolicy,
+		omni.NewCluster(resources.DefaultNamespace, "prod-1").Metadata(),
+		auth.NewIdentity(resources.DefaultNamespace, "on-call-user-1").Metadata())
+	require.NoError(t, err)
+	assert.Equal(t, role.Reader, checkResult.Role)
+	assert.ElementsMatch(t, []role.Capability{
+		role.CapabilityRebootMachines,
+		role.CapabilitySyncKubernetesManifests,
+		role.CapabilityUpdateTalos,
+	}, checkResult.Capabilities)
+
+	checkResult, err = accesspolicy.Check(accessPolicy,
+		omni.NewCluster(resources.DefaultNamespace, "staging-1").Metadata(),
+		auth.NewIdentity(resources.DefaultNamespace, "on-call-user-1").Metadata())
+	require.NoError(t, err)
+	assert.Equal(t, role.None, checkResult.Role)
+	assert.Empty(t, checkResult.Capabilities)
+
+	baseRole, capabilities, err := accesspolicy.ResolveRole(accessPolicy, "upgrader")
+	require.NoError(t, err)
+	assert.Equal(t, role.None, baseRole)
+	assert.Equal(t, []role.Capability{role.CapabilityUpdateTalos}, capabilities)
+
+	baseRole, capabilities, err = accesspolicy.ResolveRole(nil, string(role.Operator))
+	require.NoError(t, err)
+	assert.Equal(t, role.Operator, baseRole)
+	assert.Empty(t, capabilities)
+
+	_, _, err = accesspolicy.ResolveRole(nil, "upgrader")
+	assert.ErrorContains(t, err, "unknown role")
+}
+
+func TestInvalidCustomRole(t *testing.T) {
+	accessPolicy := getAccessPolicy(t, aclValidCustomRoleRaw)
+
+	accessPolicy.TypedSpec().Value.Roles[string(role.Operator)] = &specs.AccessPolicyRole{BaseRole: string(role.Reader)}
+	accessPolicy.TypedSpec().Value.Roles["on-call"].BaseRole = "non-existent"
+	accessPolicy.TypedSpec().Value.Roles["upgrader"].Capabilities = append(accessPolicy.TypedSpec().Value.Roles["upgrader"].Capabilities, "can_do_anything")
+	accessPolicy.TypedSpec().Value.Rules[1].Role = "non-existent"
+
+	err := accesspolicy.Validate(accessPolicy)
+	assert.ErrorContains(t, err, `custom role "Operator" conflicts with a built-in role`)
+	assert.ErrorContains(t, err, `custom role "on-call" has invalid base role`)
+	assert.ErrorContains(t, err, `unknown capability: "can_do_anything"`)
+	assert.ErrorContains(t, err, `unknown role: "non-existent"`)
+}
+
+func TestAccessGrant(t *testing.T) {
+	accessPolicy := getAccessPolicy(t, aclValidCustomRoleRaw)
+
+	newGrant := func(id, identity, grantRole string, expiration time.Time, clusters ...string) *auth.AccessGrant {
+		grant := auth.NewAccessGrant(id)
+
+		grant.TypedSpec().Value.Identity = identity
+		grant.TypedSpec().Value.Role = grantRole
+		grant.TypedSpec().Value.Clusters = clusters
+		grant.TypedSpec().Value.Expiration = timestamppb.New(expiration)
+
+		return grant
+	}
+
+	grants := []*auth.AccessGrant{
+		newGrant("break-glass", "on-call-user-1", string(role.Operator), time.Now().Add(time.Hour), "prod-*"),
+		newGrant("upgrade", "on-call-user-1", "upgrader", time.Now().Add(time.Hour), "staging-1"),
+		newGrant("expired", "on-call-user-1", string(role.Admin), time.Now().Add(-time.Minute), "*"),
+		newGrant("other-user", "other-user", string(role.Operator), time.Now().Add(time.Hour), "*"),
+	}
+
+	checkResult, err := accesspolicy.Check(accessPolicy,
+		omni.NewCluster(resources.DefaultNamespace, "prod-2").Metadata(),
+		auth.NewIdentity(resources.DefaultNamespace, "on-call-user-1").Metadata(),
+		grants...)
+	require.NoError(t, err)
+	assert.Equal(t, role.Operator, checkResult.Role)
+	assert.False(t, checkResult.MatchesAllClusters)
+
+	// the grant is honored without the access policy
+	checkResult, err = accesspolicy.Check(nil,
+		omni.NewCluster(resources.DefaultNamespace, "prod-2").Metadata(),
+		auth.NewIdentity(resources.DefaultNamespace, "on-call-user-1").Metadata(),
+		grants...)
+	require.NoError(t, err)
+	assert.Equal(t, role.Operator, checkResult.Role)
+
+	// custom roles can be granted
+	checkResult, err = accesspolicy.Check(accessPolicy,
+		omni.NewCluster(resources.DefaultNamespace, "staging-1").Metadata(),
+		auth.NewIdentity(resources.DefaultNamespace, "on-call-user-1").Metadata(),
+		grants...)
+	require.NoError(t, err)
+	assert.Equal(t, role.None, checkResult.Role)
+	assert.Equal(t, []role.Capability{role.CapabilityUpdateTalos}, checkResult.Capabilities)
+
+	// the expired grant and the grants of the other users are ignored
+	checkResult, err = accesspolicy.Check(accessPolicy,
+		omni.NewCluster(resources.DefaultNamespace, "dev-1").Metadata(),
+		auth.NewIdentity(resources.DefaultNamespace, "on-call-user-1").Metadata(),
+		grants...)
+	require.NoError(t, err)
+	assert.Equal(t, role.None, checkResult.Role)
+	assert.False(t, checkResult.MatchesAllClusters)
+}
+
 func getAccessPolicy(t *testing.T, raw []byte) *auth.AccessPolicy {
 	dec := yaml.NewDecoder(bytes.NewReader(raw))
 
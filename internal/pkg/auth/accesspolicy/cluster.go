@@ -7,6 +7,7 @@ package accesspolicy
 
 import (
 	"context"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -22,47 +23,85 @@ import (
 
 // RoleForCluster returns the role of the current user for the given cluster, and whether the role matches all clusters.
 func RoleForCluster(ctx context.Context, id resource.ID, st state.State) (role.Role, bool, error) {
+	clusterRole, _, matchesAllClusters, err := RoleAndCapabilitiesForCluster(ctx, id, st)
+
+	return clusterRole, matchesAllClusters, err
+}
+
+// CapabilitiesForCluster returns all capabilities the current user has on the given cluster.
+//
+// These are the capabilities granted by the role of the user for the cluster, and the additional capabilities
+// granted by the custom roles, either assigned to the user directly or through the access policy rules.
+func CapabilitiesForCluster(ctx context.Context, id resource.ID, st state.State) ([]role.Capability, error) {
+	clusterRole, capabilities, _, err := RoleAndCapabilitiesForCluster(ctx, id, st)
+	if err != nil {
+		return nil, err
+	}
+
+	return role.Capabilities(clusterRole, capabilities), nil
+}
+
+// RoleAndCapabilitiesForCluster returns the role of the current user for the given cluster, the additional capabilities
+// granted by the custom roles, and whether the role matches all clusters.
+//
+// Both the access policy and the active access grants of the user are taken into account.
+func RoleAndCapabilitiesForCluster(ctx context.Context, id resource.ID, st state.State) (role.Role, []role.Capability, bool, error) {
 	userRole, userRoleExists := ctx.Value(auth.RoleContextKey{}).(role.Role)
 	if !userRoleExists {
 		userRole = role.None
 	}
 
+	userCapabilities, _ := ctx.Value(auth.CapabilitiesContextKey{}).([]role.Capability)
+
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
 	accessPolicy, err := safe.StateGet[*authres.AccessPolicy](ctx, st, authres.NewAccessPolicy().Metadata())
-	if err != nil {
-		if state.IsNotFoundError(err) {
-			return userRole, false, nil
-		}
-
-		return role.None, false, err
+	if err != nil && !state.IsNotFoundError(err) {
+		return role.None, nil, false, err
 	}
 
 	identityStr, identityExists := ctx.Value(auth.IdentityContextKey{}).(string)
 	if !identityExists {
-		return userRole, false, nil
+		return userRole, userCapabilities, false, nil
+	}
+
+	grants, err := ActiveGrants(ctx, st, identityStr)
+	if err != nil {
+		return role.None, nil, false, err
+	}
+
+	if accessPolicy == nil && len(grants) == 0 {
+		return userRole, userCapabilities, false, nil
 	}
 
 	identity, err := safe.StateGet[*authres.Identity](ctx, st, authres.NewIdentity(resources.DefaultNamespace, identityStr).Metadata())
 	if err != nil {
 		if state.IsNotFoundError(err) {
-			return userRole, false, nil
+			return userRole, userCapabilities, false, nil
 		}
 
-		return role.None, false, err
+		return role.None, nil, false, err
 	}
 
 	clusterMD := omni.NewCluster(resources.DefaultNamespace, id).Metadata()
 
-	checkResult, err := Check(accessPolicy, clusterMD, identity.Metadata())
+	checkResult, err := Check(accessPolicy, clusterMD, identity.Metadata(), grants...)
 	if err != nil {
-		return role.None, false, err
+		return role.None, nil, false, err
 	}
 
 	maxRole, err := role.Max(userRole, checkResult.Role)
 	if err != nil {
-		return role.None, false, err
+		return role.None, nil, false, err
+	}
+
+	capabilities := slices.Clone(userCapabilities)
+
+	for _, capability := range checkResult.Capabilities {
+		if !slices.Contains(capabilities, capability) {
+			capabilities = append(capabilities, capability)
+		}
 	}
 
-	return maxRole, checkResult.MatchesAllClusters, nil
+	return maxRole, capabilities, checkResult.MatchesAllClusters, nil
 }
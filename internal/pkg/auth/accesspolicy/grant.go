@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package accesspolicy
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// MaxGrantDuration is the maximum time an access grant can stay active for.
+const MaxGrantDuration = 24 * time.Hour
+
+// GrantActive returns true if the access grant is not expired at the given time.
+func GrantActive(grant *auth.AccessGrant, now time.Time) bool {
+	expiration := grant.TypedSpec().Value.GetExpiration()
+
+	return expiration != nil && now.Before(expiration.AsTime())
+}
+
+// ActiveGrants returns the access grants of the given identity which are not expired yet.
+func ActiveGrants(ctx context.Context, st state.State, identity string) ([]*auth.AccessGrant, error) {
+	grants, err := safe.StateListAll[*auth.AccessGrant](actor.MarkContextAsInternalActor(ctx), st)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	var result []*auth.AccessGrant
+
+	for iter := grants.Iterator(); iter.Next(); {
+		grant := iter.Value()
+
+		if grant.TypedSpec().Value.GetIdentity() == identity && GrantActive(grant, now) {
+			result = append(result, grant)
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateGrantClusters checks that the cluster match patterns of the access grant are valid.
+func ValidateGrantClusters(clusters []string) error {
+	for _, cluster := range clusters {
+		if _, err := filepath.Match(cluster, ""); err != nil {
+			return fmt.Errorf("invalid cluster match pattern %q: %w", cluster, err)
+		}
+	}
+
+	return nil
+}
+
+// grantMatches checks whether the access grant is active, and applies to the given identity and the cluster.
+//
+// The second return value is true if the grant matches all clusters.
+func grantMatches(grant *auth.AccessGrant, clusterMD, identityMD *resource.Metadata, now time.Time) (bool, bool, error) {
+	spec := grant.TypedSpec().Value
+
+	if spec.GetIdentity() != identityMD.ID() || !GrantActive(grant, now) {
+		return false, false, nil
+	}
+
+	for _, cluster := range spec.GetClusters() {
+		if cluster == "*" {
+			return true, true, nil
+		}
+
+		matches, err := filepath.Match(cluster, clusterMD.ID())
+		if err != nil {
+			return false, false, fmt.Errorf("invalid cluster match pattern %q in access grant %q", cluster, grant.Metadata().ID())
+		}
+
+		if matches {
+			return true, false, nil
+		}
+	}
+
+	return false, false, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package accesspolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// ResolveRole resolves the given role name into the built-in base role and the additional capabilities.
+//
+// The name is either one of the built-in roles, or the name of a custom role defined in the access policy.
+// The access policy can be nil, in which case only the built-in roles are resolved.
+func ResolveRole(accessPolicy *auth.AccessPolicy, name string) (role.Role, []role.Capability, error) {
+	if parsed, err := role.Parse(name); err == nil {
+		return parsed, nil, nil
+	}
+
+	if accessPolicy == nil {
+		return "", nil, fmt.Errorf("unknown role: %q", name)
+	}
+
+	customRole, ok := accessPolicy.TypedSpec().Value.GetRoles()[name]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown role: %q", name)
+	}
+
+	baseRole, err := role.Parse(customRole.GetBaseRole())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid base role of the custom role %q: %w", name, err)
+	}
+
+	capabilities := make([]role.Capability, 0, len(customRole.GetCapabilities()))
+
+	for _, capabilityStr := range customRole.GetCapabilities() {
+		capability, err := role.ParseCapability(capabilityStr)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid capability of the custom role %q: %w", name, err)
+		}
+
+		capabilities = append(capabilities, capability)
+	}
+
+	return baseRole, capabilities, nil
+}
+
+// ResolveRoleFromState resolves the given role name using the access policy from the state, if the role is not a built-in one.
+func ResolveRoleFromState(ctx context.Context, st state.State, name string) (role.Role, []role.Capability, error) {
+	if parsed, err := role.Parse(name); err == nil {
+		return parsed, nil, nil
+	}
+
+	accessPolicy, err := safe.StateGet[*auth.AccessPolicy](actor.MarkContextAsInternalActor(ctx), st, auth.NewAccessPolicy().Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return "", nil, err
+	}
+
+	return ResolveRole(accessPolicy, name)
+}
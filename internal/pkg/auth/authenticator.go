@@ -19,6 +19,9 @@ type Authenticator struct {
 	Identity string
 	UserID   string
 	Role     role.Role
+
+	// Capabilities are the additional capabilities granted on top of the Role by a custom role.
+	Capabilities []role.Capability
 }
 
 // AuthenticatorFunc represents a function that returns an authenticator for the given public key fingerprint.
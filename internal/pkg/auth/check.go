@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,6 +28,7 @@ var (
 // CheckOptions are the options for the checks.
 type CheckOptions struct {
 	Role           role.Role
+	Capabilities   []role.Capability
 	VerifiedEmail  bool
 	ValidSignature bool
 }
@@ -61,6 +63,14 @@ func WithRole(role role.Role) CheckOption {
 	}
 }
 
+// WithCapabilities allows the context to satisfy the role check set via WithRole by having all the given capabilities
+// granted by a custom role, even if its role is lower than the required one.
+func WithCapabilities(capabilities ...role.Capability) CheckOption {
+	return func(opts *CheckOptions) {
+		opts.Capabilities = capabilities
+	}
+}
+
 // WithValidSignature checks if the context has a valid signature.
 //
 // If the required role set via WithRole is other than role.None, this setting is ignored and the signature is always checked.
@@ -132,7 +142,7 @@ func Check(ctx context.Context, opt ...CheckOption) (CheckResult, error) {
 
 	if opts.Role != role.None {
 		err := ctxRole.Check(opts.Role)
-		if err != nil {
+		if err != nil && !hasCapabilities(ctx, opts.Capabilities) {
 			return CheckResult{}, fmt.Errorf("%w: %v", ErrUnauthorized, err) //nolint:errorlint
 		}
 	}
@@ -148,6 +158,22 @@ func Check(ctx context.Context, opt ...CheckOption) (CheckResult, error) {
 	return result, nil
 }
 
+func hasCapabilities(ctx context.Context, required []role.Capability) bool {
+	if len(required) == 0 {
+		return false
+	}
+
+	capabilities, _ := ctx.Value(CapabilitiesContextKey{}).([]role.Capability)
+
+	for _, capability := range required {
+		if !slices.Contains(capabilities, capability) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // CheckGRPC wraps Check function returning gRPC error codes.
 func CheckGRPC(ctx context.Context, opt ...CheckOption) (CheckResult, error) {
 	result, err := Check(ctx, opt...)
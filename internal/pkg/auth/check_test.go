@@ -128,6 +128,46 @@ func TestCheck(t *testing.T) {
 			opts:    []auth.CheckOption{auth.WithRole(role.Admin)},
 			errorIs: auth.ErrUnauthorized,
 		},
+		{
+			name: "role mismatch, capability granted",
+			ctx: context.WithValue(
+				context.WithValue(
+					context.WithValue(
+						context.Background(),
+						auth.EnabledAuthContextKey{},
+						true,
+					),
+					auth.RoleContextKey{},
+					role.Reader,
+				),
+				auth.CapabilitiesContextKey{},
+				[]role.Capability{role.CapabilitySyncKubernetesManifests},
+			),
+			opts: []auth.CheckOption{auth.WithRole(role.Operator), auth.WithCapabilities(role.CapabilitySyncKubernetesManifests)},
+			want: auth.CheckResult{
+				AuthEnabled:       true,
+				HasValidSignature: true,
+				Role:              role.Reader,
+			},
+		},
+		{
+			name: "role mismatch, other capability granted",
+			ctx: context.WithValue(
+				context.WithValue(
+					context.WithValue(
+						context.Background(),
+						auth.EnabledAuthContextKey{},
+						true,
+					),
+					auth.RoleContextKey{},
+					role.Reader,
+				),
+				auth.CapabilitiesContextKey{},
+				[]role.Capability{role.CapabilityUpdateTalos},
+			),
+			opts:    []auth.CheckOption{auth.WithRole(role.Operator), auth.WithCapabilities(role.CapabilitySyncKubernetesManifests)},
+			errorIs: auth.ErrUnauthorized,
+		},
 		{
 			name: "role and verified email",
 			ctx: context.WithValue(
@@ -20,5 +20,8 @@ type UserIDContextKey struct{}
 // RoleContextKey is the context key for the role. Value has the type role.Role.
 type RoleContextKey struct{}
 
+// CapabilitiesContextKey is the context key for the additional capabilities granted by a custom role. Value has the type []role.Capability.
+type CapabilitiesContextKey struct{}
+
 // IdentityContextKey is the context key for the user identity. Value has the type string.
 type IdentityContextKey struct{}
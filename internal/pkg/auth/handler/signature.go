@@ -106,5 +106,9 @@ func (s *Signature) intercept(request *http.Request) (*http.Request, error) {
 	ctx = context.WithValue(ctx, auth.UserIDContextKey{}, authenticator.UserID)
 	ctx = context.WithValue(ctx, auth.RoleContextKey{}, authenticator.Role)
 
+	if len(authenticator.Capabilities) > 0 {
+		ctx = context.WithValue(ctx, auth.CapabilitiesContextKey{}, authenticator.Capabilities)
+	}
+
 	return request.WithContext(ctx), nil
 }
@@ -116,5 +116,9 @@ func (i *Signature) intercept(ctx context.Context) (context.Context, error) {
 	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, authenticator.Identity)
 	ctx = context.WithValue(ctx, auth.RoleContextKey{}, authenticator.Role)
 
+	if len(authenticator.Capabilities) > 0 {
+		ctx = context.WithValue(ctx, auth.CapabilitiesContextKey{}, authenticator.Capabilities)
+	}
+
 	return ctx, nil
 }
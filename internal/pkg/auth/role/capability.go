@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package role
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Capability represents a fine-grained cluster-level permission.
+//
+// Each capability is granted by one of the built-in roles, custom roles can grant it on top of a lower base role.
+type Capability string
+
+const (
+	// CapabilityReadConfigPatches allows reading the config patches of the cluster.
+	CapabilityReadConfigPatches Capability = "can_read_config_patches"
+
+	// CapabilityRebootMachines allows rebooting the machines of the cluster.
+	CapabilityRebootMachines Capability = "can_reboot_machines"
+
+	// CapabilityDownloadKubeconfig allows downloading the kubeconfig of the cluster.
+	CapabilityDownloadKubeconfig Capability = "can_download_kubeconfig"
+
+	// CapabilityDownloadTalosconfig allows downloading the talosconfig of the cluster.
+	CapabilityDownloadTalosconfig Capability = "can_download_talosconfig"
+
+	// CapabilityAddMachines allows adding machines to the cluster.
+	CapabilityAddMachines Capability = "can_add_machines"
+
+	// CapabilityRemoveMachines allows removing machines from the cluster.
+	CapabilityRemoveMachines Capability = "can_remove_machines"
+
+	// CapabilityUpdateKubernetes allows updating the Kubernetes version of the cluster.
+	CapabilityUpdateKubernetes Capability = "can_update_kubernetes"
+
+	// CapabilityUpdateTalos allows updating the Talos version of the cluster.
+	CapabilityUpdateTalos Capability = "can_update_talos"
+
+	// CapabilityManageConfigPatches allows creating, updating and removing the config patches of the cluster.
+	CapabilityManageConfigPatches Capability = "can_manage_config_patches"
+
+	// CapabilitySyncKubernetesManifests allows syncing the Kubernetes manifests of the cluster.
+	CapabilitySyncKubernetesManifests Capability = "can_sync_kubernetes_manifests"
+
+	// CapabilityManageClusterFeatures allows enabling and disabling the features of the cluster.
+	CapabilityManageClusterFeatures Capability = "can_manage_cluster_features"
+)
+
+// capabilityRoles maps each capability to the least capable built-in role granting it.
+var capabilityRoles = map[Capability]Role{
+	CapabilityReadConfigPatches:       Reader,
+	CapabilityRebootMachines:          Reader,
+	CapabilityDownloadKubeconfig:      Reader,
+	CapabilityDownloadTalosconfig:     Reader,
+	CapabilityAddMachines:             Operator,
+	CapabilityRemoveMachines:          Operator,
+	CapabilityUpdateKubernetes:        Operator,
+	CapabilityUpdateTalos:             Operator,
+	CapabilityManageConfigPatches:     Operator,
+	CapabilitySyncKubernetesManifests: Operator,
+	CapabilityManageClusterFeatures:   Operator,
+}
+
+// ParseCapability parses the capability string.
+func ParseCapability(capability string) (Capability, error) {
+	if _, ok := capabilityRoles[Capability(capability)]; !ok {
+		return "", fmt.Errorf("unknown capability: %q", capability)
+	}
+
+	return Capability(capability), nil
+}
+
+// Has checks if the role grants the given capability by itself.
+func (r Role) Has(capability Capability) bool {
+	required, ok := capabilityRoles[capability]
+	if !ok {
+		return false
+	}
+
+	return r.Check(required) == nil
+}
+
+// HasCapability checks if the capability is granted either by the role or by the additional capabilities.
+func HasCapability(r Role, capabilities []Capability, capability Capability) bool {
+	return r.Has(capability) || slices.Contains(capabilities, capability)
+}
+
+// Capabilities returns all capabilities granted by the role and by the additional capabilities, sorted.
+func Capabilities(r Role, capabilities []Capability) []Capability {
+	result := make([]Capability, 0, len(capabilityRoles))
+
+	for capability := range capabilityRoles {
+		if HasCapability(r, capabilities, capability) {
+			result = append(result, capability)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// IntersectCapabilities returns the additional capabilities which are granted both by the first and by the second grant.
+//
+// Only the capabilities explicitly listed in either grant are returned, the ones implied by the roles are not.
+// It is used to determine the effective capabilities when two grants are combined with Min.
+func IntersectCapabilities(first Role, firstCapabilities []Capability, second Role, secondCapabilities []Capability) []Capability {
+	var result []Capability
+
+	for _, capability := range slices.Concat(firstCapabilities, secondCapabilities) {
+		if slices.Contains(result, capability) {
+			continue
+		}
+
+		if HasCapability(first, firstCapabilities, capability) && HasCapability(second, secondCapabilities, capability) {
+			result = append(result, capability)
+		}
+	}
+
+	return result
+}
@@ -35,6 +35,7 @@ type kubeconfigCluster struct {
 
 type kubeconfigClusterCluster struct {
 	Server                   string `yaml:"server"`
+	TLSServerName            string `yaml:"tls-server-name,omitempty"`
 	CertificateAuthorityData string `yaml:"certificate-authority-data"`
 }
 
@@ -98,6 +99,7 @@ func GenerateKubeconfig(secrets *omni.ClusterSecrets, lbConfig *omni.LoadBalance
 				Name: secrets.Metadata().ID(),
 				Cluster: kubeconfigClusterCluster{
 					Server:                   lbConfig.TypedSpec().Value.SiderolinkEndpoint,
+					TLSServerName:            lbConfig.TypedSpec().Value.SniHostname, // routes the connections in the single port load balancer mode
 					CertificateAuthorityData: base64.StdEncoding.EncodeToString(secretBundle.Certs.K8s.Crt),
 				},
 			},
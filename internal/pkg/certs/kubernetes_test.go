@@ -51,3 +51,28 @@ func TestIsKubernetesCertificateStale(t *testing.T) {
 
 	require.True(t, stale)
 }
+
+func TestGenerateKubeconfigSNI(t *testing.T) {
+	bundle, err := secrets.NewBundle(secrets.NewFixedClock(time.Now()), config.TalosVersionCurrent)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+
+	secrets := omni.NewClusterSecrets("", "my-cluster")
+	secrets.TypedSpec().Value.Data = data
+
+	lbConfig := omni.NewLoadBalancerConfig("", "")
+	lbConfig.TypedSpec().Value.SiderolinkEndpoint = "https://[2001:db8::1]:6443"
+	lbConfig.TypedSpec().Value.SniHostname = "my-cluster.lb.test"
+
+	kubeconfig, err := certs.GenerateKubeconfig(secrets, lbConfig, constants.CertificateValidityTime)
+	require.NoError(t, err)
+
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	require.NoError(t, err)
+
+	// the load balancer is dialed by the address, and the SNI hostname routes the connection to the cluster
+	require.Equal(t, "https://[2001:db8::1]:6443", cfg.Host)
+	require.Equal(t, "my-cluster.lb.test", cfg.TLSClientConfig.ServerName)
+}
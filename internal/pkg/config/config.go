@@ -48,6 +48,7 @@ type Params struct {
 	SiderolinkWireguardBindAddress       string `yaml:"siderolinkWireguardBindAddress"`
 	SiderolinkWireguardAdvertisedAddress string `yaml:"siderolinkWireguardAdvertisedAddress"`
 	SiderolinkDisableLastEndpoint        bool   `yaml:"siderolinkDisableLastEndpoint"`
+	SiderolinkRequireMachineApproval     bool   `yaml:"siderolinkRequireMachineApproval"`
 
 	EventSinkPort    int                `yaml:"eventSinkPort"`
 	SideroLinkAPIURL string             `yaml:"siderolinkAPIURL"`
@@ -56,6 +57,10 @@ type Params struct {
 
 	LogStorage LogStorageParams `yaml:"logStorage"`
 
+	LogForwarding LogForwardingParams `yaml:"logForwarding"`
+
+	AuditLog AuditLogParams `yaml:"auditLog"`
+
 	Auth AuthParams `yaml:"auth"`
 
 	InitialUsers []string `yaml:"initialUsers"`
@@ -92,6 +97,7 @@ type Params struct {
 // EtcdBackupParams defines etcd backup configs.
 type EtcdBackupParams struct {
 	LocalPath    string        `yaml:"localPath"`
+	Command      string        `yaml:"command"`
 	S3Enabled    bool          `yaml:"s3Enabled"`
 	TickInterval time.Duration `yaml:"tickInterval"`
 	MinInterval  time.Duration `yaml:"minInterval"`
@@ -104,11 +110,17 @@ func (ebp EtcdBackupParams) GetStorageType() (EtcdBackupStorage, error) {
 		return "", errors.New("both localPath and s3 are set")
 	}
 
+	if ebp.Command != "" && (ebp.LocalPath != "" || ebp.S3Enabled) {
+		return "", errors.New("command can't be set together with localPath or s3")
+	}
+
 	switch {
-	case ebp.LocalPath == "" && !ebp.S3Enabled:
+	case ebp.LocalPath == "" && !ebp.S3Enabled && ebp.Command == "":
 		return EtcdBackupTypeS3, nil
 	case ebp.LocalPath != "":
 		return EtcdBackupTypeFS, nil
+	case ebp.Command != "":
+		return EtcdBackupTypeExec, nil
 	case ebp.S3Enabled:
 		return EtcdBackupTypeS3, nil
 	default:
@@ -132,6 +144,20 @@ type LoadBalancerParams struct {
 
 	HealthCheckInterval time.Duration `yaml:"healthCheckInterval"`
 	HealthCheckTimeout  time.Duration `yaml:"healthCheckTimeout"`
+
+	SNI LoadBalancerSNIParams `yaml:"sni"`
+}
+
+// LoadBalancerSNIParams defines the single port load balancer mode configs.
+//
+// In this mode the cluster load balancers share a single port, and the connections are routed to the clusters by the TLS SNI.
+// Only the clusters created after the mode is enabled use the shared port, the existing clusters keep their own ports.
+// When the mode is disabled, the clusters which use the shared port keep using it, only the new clusters get their own ports.
+// The port must be outside of the allocated ports range, and the clients must send the cluster hostname as the TLS SNI.
+type LoadBalancerSNIParams struct {
+	Domain  string `yaml:"domain"`
+	Port    int    `yaml:"port"`
+	Enabled bool   `yaml:"enabled"`
 }
 
 // StorageParams defines storage configs.
@@ -173,7 +199,33 @@ type KeyPrunerParams struct {
 type LogStorageParams struct {
 	Path        string        `yaml:"directory"`
 	FlushPeriod time.Duration `yaml:"flushPeriod"`
-	Enabled     bool          `yaml:"enabled"`
+	// SegmentSize is the size in bytes after which the active log segment of a machine is compressed.
+	SegmentSize int64 `yaml:"segmentSize"`
+	// MaxSizePerMachine is the maximum size in bytes of the stored logs of a single machine, 0 means unlimited.
+	MaxSizePerMachine int64 `yaml:"maxSizePerMachine"`
+	// MaxAge is the retention period of the stored logs, 0 means unlimited.
+	MaxAge  time.Duration `yaml:"maxAge"`
+	Enabled bool          `yaml:"enabled"`
+}
+
+// LogForwardingParams defines machine log forwarding configuration.
+type LogForwardingParams struct {
+	// Endpoints are the URLs of the sinks the machine logs are forwarded to.
+	// Supported schemes are syslog+tcp, syslog+udp, loki+http(s) and otlp+http(s).
+	Endpoints []string `yaml:"endpoints"`
+	// BufferSize is the number of log lines kept per sink while it is unavailable.
+	BufferSize int `yaml:"bufferSize"`
+}
+
+// AuditLogParams defines audit log configuration.
+type AuditLogParams struct {
+	Path string `yaml:"directory"`
+	// MaxFileSize is the size in bytes after which the audit log file is rotated.
+	MaxFileSize int64 `yaml:"maxFileSize"`
+	// MaxFiles is the number of the rotated audit log files to keep, 0 (the default) keeps all of them,
+	// so the audit history is never removed unless the retention is configured explicitly.
+	MaxFiles int  `yaml:"maxFiles"`
+	Enabled  bool `yaml:"enabled"`
 }
 
 var (
@@ -201,15 +253,31 @@ var (
 
 			HealthCheckInterval: 20 * time.Second,
 			HealthCheckTimeout:  15 * time.Second,
+
+			SNI: LoadBalancerSNIParams{
+				Port:   6443,
+				Domain: "kubernetes.omni.internal",
+			},
 		},
 		KeyPruner: KeyPrunerParams{
 			Interval: 10 * time.Minute,
 		},
 		LogServerPort: 8092,
 		LogStorage: LogStorageParams{
+			Enabled:           true,
+			Path:              "_out/logs",
+			FlushPeriod:       10 * time.Minute,
+			SegmentSize:       4 * 1024 * 1024,
+			MaxSizePerMachine: 64 * 1024 * 1024,
+			MaxAge:            7 * 24 * time.Hour,
+		},
+		LogForwarding: LogForwardingParams{
+			BufferSize: 10000,
+		},
+		AuditLog: AuditLogParams{
 			Enabled:     true,
-			Path:        "_out/logs",
-			FlushPeriod: 10 * time.Minute,
+			Path:        "_out/audit",
+			MaxFileSize: 100 * 1024 * 1024,
 		},
 		TalosRegistry:       consts.TalosRegistry,
 		KubernetesRegistry:  consts.KubernetesRegistry,
@@ -341,4 +409,6 @@ const (
 	EtcdBackupTypeS3 EtcdBackupStorage = "s3"
 	// EtcdBackupTypeFS is the filesystem backup storage type.
 	EtcdBackupTypeFS EtcdBackupStorage = "local"
+	// EtcdBackupTypeExec is the external command backup storage type.
+	EtcdBackupTypeExec EtcdBackupStorage = "exec"
 )
@@ -12,12 +12,14 @@ import (
 	"fmt"
 	"io"
 	"net/netip"
+	"slices"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/go-tail"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
@@ -29,7 +31,7 @@ func NewLogHandler(machineMap *MachineMap, omniState state.State, storageConfig
 	storage := optional.None[*LogStorage]()
 
 	if storageConfig.Enabled {
-		storage = optional.Some(NewLogStorage(storageConfig.Path))
+		storage = optional.Some(NewLogStorage(storageConfig))
 	}
 
 	cache := NewMachineCache(storage, logger)
@@ -44,9 +46,17 @@ func NewLogHandler(machineMap *MachineMap, omniState state.State, storageConfig
 	return &handler
 }
 
+// LogForwarder ships the machine log lines to the external sinks.
+type LogForwarder interface {
+	Run(ctx context.Context) error
+	Forward(machineID MachineID, timestamp time.Time, line []byte)
+}
+
 // LogHandler stores a map of machines to their circular log buffers.
 type LogHandler struct {
-	OmniState          state.State
+	OmniState state.State
+	// Forwarder, if set, receives every log line in addition to the buffers.
+	Forwarder          LogForwarder
 	Map                *MachineMap
 	logger             *zap.Logger
 	Cache              *MachineCache
@@ -55,6 +65,19 @@ type LogHandler struct {
 
 // Start starts the LogHandler.
 func (h *LogHandler) Start(ctx context.Context) error {
+	if h.Forwarder == nil {
+		return h.run(ctx)
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error { return h.Forwarder.Run(ctx) })
+	eg.Go(func() error { return h.run(ctx) })
+
+	return eg.Wait()
+}
+
+func (h *LogHandler) run(ctx context.Context) error {
 	h.logger.Info("starting log handler")
 
 	eventCh := make(chan state.Event)
@@ -164,6 +187,10 @@ func (h *LogHandler) writeMessage(ip string, data []byte) error {
 		return fmt.Errorf("failed to get machine ID for ip address '%s': %w", ip, err)
 	}
 
+	if h.Forwarder != nil {
+		h.Forwarder.Forward(id, time.Now(), data)
+	}
+
 	err = h.Cache.WriteMessage(id, data)
 	if err != nil {
 		return fmt.Errorf("failed to write message to buffer for machine '%s': %w", id, err)
@@ -226,6 +253,162 @@ func (h *LogHandler) GetReader(machineID MachineID, follow bool, tailLines optio
 	return &LineReader{reader: r}, nil
 }
 
+// ErrLogStorageDisabled is returned when the stored logs are queried while the log storage is disabled.
+var ErrLogStorageDisabled = errors.New("log storage is disabled")
+
+// LogQuery selects the log lines of a machine.
+type LogQuery struct {
+	// Since and Until limit the time range of the lines, zero values leave the range open.
+	// Time range queries are served from the log storage.
+	Since time.Time
+	Until time.Time
+	// Match filters the lines, nil matches all of them.
+	Match func(line []byte) bool
+	// TailLines limits the lines to the last matching ones.
+	// When following the logs, it is applied to the buffered lines before filtering.
+	TailLines optional.Optional[int32]
+	Follow    bool
+}
+
+// LogReader reads the log lines one by one.
+type LogReader interface {
+	ReadLine() ([]byte, error)
+	Close() error
+}
+
+// Query returns a line reader of the lines of the given machine ID matching the query.
+func (h *LogHandler) Query(machineID MachineID, query LogQuery) (LogReader, error) {
+	var (
+		r   LogReader
+		err error
+	)
+
+	switch {
+	case !query.Since.IsZero() || !query.Until.IsZero():
+		r, err = h.getStorageReader(machineID, query)
+	case query.Follow || query.Match == nil:
+		r, err = h.GetReader(machineID, query.Follow, query.TailLines)
+		if err != nil {
+			return nil, err
+		}
+
+		return filterLines(r, query.Match), nil
+	default:
+		r, err = h.GetReader(machineID, false, optional.None[int32]())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tailLines(filterLines(r, query.Match), query.TailLines), nil
+}
+
+func (h *LogHandler) getStorageReader(machineID MachineID, query LogQuery) (LogReader, error) {
+	if query.Follow {
+		return nil, errors.New("time range can't be used when following the logs")
+	}
+
+	storage, storageEnabled := h.Cache.Storage.Get()
+	if !storageEnabled {
+		return nil, ErrLogStorageDisabled
+	}
+
+	exists, err := storage.Exists(machineID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, &BufferNotFoundError{id: machineID}
+	}
+
+	return storage.Reader(machineID, query.Since, query.Until)
+}
+
+// filterReader skips the lines which don't match.
+type filterReader struct {
+	LogReader
+
+	match func(line []byte) bool
+}
+
+func filterLines(r LogReader, match func(line []byte) bool) LogReader {
+	if match == nil {
+		return r
+	}
+
+	return &filterReader{LogReader: r, match: match}
+}
+
+// ReadLine reads the next matching line from the underlying reader.
+func (r *filterReader) ReadLine() ([]byte, error) {
+	for {
+		line, err := r.LogReader.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+
+		if r.match(line) {
+			return line, nil
+		}
+	}
+}
+
+// tailReader reads the whole underlying reader on the first read, and returns only the last lines of it.
+type tailReader struct {
+	LogReader
+
+	lines  [][]byte
+	n      int
+	loaded bool
+}
+
+func tailLines(r LogReader, n optional.Optional[int32]) LogReader {
+	if !n.IsPresent() {
+		return r
+	}
+
+	return &tailReader{LogReader: r, n: int(n.ValueOrZero())}
+}
+
+// ReadLine reads the next line of the tail.
+func (r *tailReader) ReadLine() ([]byte, error) {
+	if !r.loaded {
+		for {
+			line, err := r.LogReader.ReadLine()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if r.n == 0 {
+				continue
+			}
+
+			if len(r.lines) == r.n {
+				r.lines = r.lines[1:]
+			}
+
+			r.lines = append(r.lines, slices.Clone(line))
+		}
+
+		r.loaded = true
+	}
+
+	if len(r.lines) == 0 {
+		return nil, io.EOF
+	}
+
+	line := r.lines[0]
+	r.lines = r.lines[1:]
+
+	return line, nil
+}
+
 // LineReader is a reader which reads lines surrounded by \n from the underlying reader.
 type LineReader struct {
 	buf    *bufio.Reader
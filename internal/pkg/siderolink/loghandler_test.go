@@ -6,8 +6,10 @@
 package siderolink_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"net/netip"
 	"os"
 	"path/filepath"
@@ -162,6 +164,44 @@ func TestLogHandler_HandleMessage(t *testing.T) {
 	})
 }
 
+// TestLogHandlerQuery tests that log handler filters the lines and applies the tail to the matching ones.
+func TestLogHandlerQuery(t *testing.T) {
+	machineMap := siderolink.NewMachineMap(&siderolink.MapStorage{
+		IPToMachine: map[string]siderolink.MachineID{
+			"1.2.3.4": "machine1",
+		},
+	})
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	handler := siderolink.NewLogHandler(machineMap, st, &config.LogStorageParams{Enabled: false}, zaptest.NewLogger(t))
+
+	for _, msg := range []string{`{"msg": "error 1"}`, `{"msg": "ok"}`, `{"msg": "error 2"}`, `{"msg": "error 3"}`, `{"msg": "ok"}`} {
+		handler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte(msg))
+	}
+
+	reader, err := handler.Query("machine1", siderolink.LogQuery{
+		Match:     func(line []byte) bool { return bytes.Contains(line, []byte("error")) },
+		TailLines: optional.Some[int32](2),
+	})
+	require.NoError(t, err)
+
+	line, err := reader.ReadLine()
+	require.NoError(t, err)
+	require.Equal(t, `{"msg": "error 2"}`, string(line))
+
+	line, err = reader.ReadLine()
+	require.NoError(t, err)
+	require.Equal(t, `{"msg": "error 3"}`, string(line))
+
+	_, err = reader.ReadLine()
+	require.ErrorIs(t, err, io.EOF)
+
+	// time range queries require log storage
+	_, err = handler.Query("machine1", siderolink.LogQuery{Since: time.Now().Add(-time.Hour)})
+	require.ErrorIs(t, err, siderolink.ErrLogStorageDisabled)
+}
+
 // TestLogHandlerStorage tests that log handler can store logs on the filesystem when log storage is enabled.
 func TestLogHandlerStorage(t *testing.T) {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -203,24 +243,17 @@ func TestLogHandlerStorage(t *testing.T) {
 	// destroy machine-2
 	require.NoError(t, st.Destroy(ctx, omni.NewMachine(resources.DefaultNamespace, "machine-2").Metadata()))
 
-	// ensure that log and hash files for machine-2 are removed
+	// ensure that logs for machine-2 are removed
 	require.NoError(t, retry.Constant(10*time.Second).Retry(func() error {
-		logFileExists, err := fileExists(filepath.Join(tempDir, "machine-2.log"))
+		logDirExists, err := fileExists(filepath.Join(tempDir, "machine-2"))
 		require.NoError(t, err)
 
-		if logFileExists {
-			return retry.ExpectedError(errors.New("log file for machine-2 is still present"))
-		}
-
-		logHashExists, err := fileExists(filepath.Join(tempDir, "machine-2.log.sha256sum"))
-		require.NoError(t, err)
-
-		if logHashExists {
-			return retry.ExpectedError(errors.New("log hash for machine-2 is still present"))
+		if logDirExists {
+			return retry.ExpectedError(errors.New("logs for machine-2 are still present"))
 		}
 
 		return nil
-	}), "log and hash files for machine-2 should be removed")
+	}), "logs for machine-2 should be removed")
 
 	// send another log and immediately cancel the context
 	logHandler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte("bbbb"))
@@ -272,15 +305,12 @@ func TestLogHandlerStorageDisabled(t *testing.T) {
 
 	require.NoError(t, eg.Wait())
 
-	assert.NoFileExists(t, filepath.Join(tempDir, "machine-1.log"))
-	assert.NoFileExists(t, filepath.Join(tempDir, "machine-1.log.sha256sum"))
-
-	assert.NoFileExists(t, filepath.Join(tempDir, "machine-2.log"))
-	assert.NoFileExists(t, filepath.Join(tempDir, "machine-2.log.sha256sum"))
+	assert.NoDirExists(t, filepath.Join(tempDir, "machine-1"))
+	assert.NoDirExists(t, filepath.Join(tempDir, "machine-2"))
 }
 
 func assertLogExists(t *testing.T, dir, machineID, log string) {
-	fileBytes, err := os.ReadFile(filepath.Join(dir, machineID+".log"))
+	fileBytes, err := os.ReadFile(filepath.Join(dir, machineID, "current.log"))
 	if err != nil {
 		// Print additional directory contents to help with debugging.
 		stat := must.Value(os.Stat(dir))(t)
@@ -6,31 +6,110 @@
 package siderolink
 
 import (
+	"bufio"
 	"bytes"
-	"crypto/sha256"
-	"encoding/hex"
+	"compress/gzip"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+const (
+	activeSegmentName   = "current.log"
+	sealedSegmentSuffix = ".log.gz"
+
+	// legacyLogSuffix is the suffix of the single-file machine logs written by the previous versions.
+	legacyLogSuffix = ".log"
+
+	// DefaultSegmentSize is the segment size used when it is not configured.
+	DefaultSegmentSize = 4 * 1024 * 1024
+
+	// segmentMaxDuration is the time span after which the active segment is sealed even if it is not full,
+	// so that the logs of the quiet machines are compressed and expire as well.
+	segmentMaxDuration = time.Hour
+
+	// flushThreshold is the size of the pending entries which are written to the disk without waiting for the flush.
+	flushThreshold = 64 * 1024
 )
 
 // LogStorage stores logs for machines on file system.
+//
+// The logs of each machine are kept in a separate directory as a sequence of segments.
+// Entries are appended to the active segment, which is compressed with gzip (sealed) once it gets large or old enough.
+// Sealed segments are named after the time range of their entries, so the time range reads skip the segments outside of it.
+// The oldest segments of a machine are removed once its logs exceed the maximum size or age.
 type LogStorage struct {
+	machines map[MachineID]*machineLog
+
 	Path string
+	// SegmentSize is the size in bytes after which the active segment is sealed.
+	SegmentSize int64
+	// MaxSize is the maximum size in bytes of the logs of a single machine, 0 means unlimited.
+	MaxSize int64
+	// MaxAge is the age after which the log entries are removed, 0 means unlimited.
+	MaxAge time.Duration
+
+	mu sync.Mutex
+}
+
+// machineLog is the state of the active segment of a machine.
+type machineLog struct {
+	first   time.Time
+	last    time.Time
+	pending []byte
+	size    int64
+}
+
+func (ml *machineLog) empty() bool {
+	return ml.size == 0 && len(ml.pending) == 0
 }
 
 // NewLogStorage creates a new LogStorage.
-func NewLogStorage(path string) *LogStorage {
+func NewLogStorage(params *config.LogStorageParams) *LogStorage {
+	segmentSize := params.SegmentSize
+	if segmentSize <= 0 {
+		segmentSize = DefaultSegmentSize
+	}
+
 	return &LogStorage{
-		Path: path,
+		machines:    map[MachineID]*machineLog{},
+		Path:        params.Path,
+		SegmentSize: segmentSize,
+		MaxSize:     params.MaxSizePerMachine,
+		MaxAge:      params.MaxAge,
 	}
 }
 
-// Exists returns true if the log file exists for the given machine ID.
+// Exists returns true if there are stored logs for the given machine ID.
 func (l *LogStorage) Exists(id MachineID) (bool, error) {
-	_, err := os.Stat(l.logPath(id))
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if ml, ok := l.machines[id]; ok && !ml.empty() {
+		return true, nil
+	}
+
+	entries, err := os.ReadDir(l.machineDir(id))
+	if err == nil {
+		return len(entries) > 0, nil
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	_, err = os.Stat(l.legacyLogPath(id))
 	if err == nil {
 		return true, nil
 	}
@@ -42,97 +121,685 @@ func (l *LogStorage) Exists(id MachineID) (bool, error) {
 	return false, err
 }
 
-// Load loads logs of the machine with the given id, if exists, into the given writer.
-func (l *LogStorage) Load(id MachineID, writer io.Writer) error {
-	filePath := l.logPath(id)
+// Append adds the log entry received at the given time to the logs of the machine.
+//
+// Entries are buffered in memory until the next Flush, or until there are enough of them.
+func (l *LogStorage) Append(id MachineID, timestamp time.Time, data []byte) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-	bufferData, err := os.ReadFile(filePath)
+	ml, err := l.machine(id)
 	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil
-		}
-
 		return err
 	}
 
-	hashHexExpectedBytes, err := os.ReadFile(l.logSHA256Path(id))
+	ml.pending = appendEntry(ml.pending, timestamp, data)
+
+	if ml.first.IsZero() {
+		ml.first = timestamp
+	}
+
+	if timestamp.After(ml.last) {
+		ml.last = timestamp
+	}
+
+	if len(ml.pending) < flushThreshold {
+		return nil
+	}
+
+	return l.flush(id, ml)
+}
+
+// Flush writes the pending entries of all machines to the disk, seals the old active segments and removes the expired ones.
+func (l *LogStorage) Flush() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ids, err := l.storedMachines()
 	if err != nil {
-		removeErr := l.Remove(id)
-		if removeErr != nil {
-			return fmt.Errorf("failed to delete invalid log buffer file: %w", removeErr)
+		return err
+	}
+
+	now := time.Now()
+
+	var multiErr error
+
+	for _, id := range ids {
+		if err = l.flushMachine(id, now); err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("failed to flush logs for machine '%s': %w", id, err))
 		}
+	}
+
+	return multiErr
+}
 
-		return fmt.Errorf("failed to read log buffer hash file: %w", err)
+// Load loads the most recent logs of the machine with the given id, if exist, into the given writer.
+//
+// The entries are written surrounded with '\n', up to the capacity of the machine log buffer.
+func (l *LogStorage) Load(id MachineID, writer io.Writer) error {
+	sources, err := l.snapshot(id, time.Time{}, time.Time{})
+	if err != nil {
+		return err
 	}
 
-	hashHexExpected := string(hashHexExpectedBytes)
+	defer closeSources(sources)
 
-	// verify the hash
-	hashActual := sha256.Sum256(bufferData)
-	hashHexActual := hex.EncodeToString(hashActual[:])
+	var (
+		chunks [][][]byte
+		size   int
+	)
 
-	if hashHexExpected != hashHexActual {
-		removeErr := l.Remove(id)
-		if removeErr != nil {
-			return fmt.Errorf("failed to delete invalid log buffer file: %w", removeErr)
+	// read the segments starting from the newest one, until there is enough data to fill the buffer
+	for i := len(sources) - 1; i >= 0 && size < MaxCapacity; i-- {
+		r := &LogStorageReader{sources: sources[i : i+1]}
+
+		var chunk [][]byte
+
+		for {
+			line, readErr := r.ReadLine()
+			if errors.Is(readErr, io.EOF) {
+				break
+			}
+
+			if readErr != nil {
+				return readErr
+			}
+
+			chunk = append(chunk, line)
+			size += len(line) + 2
 		}
 
-		return fmt.Errorf("invalid log buffer hash in file: expected %s, got %s", hashHexExpected, hashHexActual)
+		chunks = append(chunks, chunk)
 	}
 
-	_, err = io.Copy(writer, bytes.NewReader(bufferData))
+	bw := bufio.NewWriter(writer)
 
-	return err
+	for i := len(chunks) - 1; i >= 0; i-- {
+		for _, line := range chunks[i] {
+			for _, b := range [][]byte{{'\n'}, line, {'\n'}} {
+				if _, err = bw.Write(b); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Reader returns a reader of the log entries of the machine received within the given time range.
+//
+// Zero since or until leave the corresponding side of the range open.
+func (l *LogStorage) Reader(id MachineID, since, until time.Time) (*LogStorageReader, error) {
+	sources, err := l.snapshot(id, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogStorageReader{
+		sources: sources,
+		since:   since,
+		until:   until,
+	}, nil
 }
 
-// Remove removes the log file, if exists, for the given machine ID.
+// Remove removes the logs, if exist, for the given machine ID.
 func (l *LogStorage) Remove(id MachineID) error {
-	if err := l.removeFileIfExists(l.logPath(id)); err != nil {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.machines, id)
+
+	if err := os.RemoveAll(l.machineDir(id)); err != nil {
 		return err
 	}
 
-	return l.removeFileIfExists(l.logSHA256Path(id))
+	if err := removeFileIfExists(l.legacyLogPath(id)); err != nil {
+		return err
+	}
+
+	return removeFileIfExists(l.legacyLogPath(id) + ".sha256sum")
 }
 
-// Save saves the given reader into the log file for the given machine ID.
-func (l *LogStorage) Save(machineID MachineID, reader io.Reader) error {
-	err := os.MkdirAll(l.Path, 0o755)
+// snapshot opens the segments of the machine overlapping with the time range, sorted from the oldest to the newest.
+//
+// Only the part of the active segment written before the call is read, the segment might be appended concurrently.
+func (l *LogStorage) snapshot(id MachineID, since, until time.Time) ([]segmentSource, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ml, err := l.machine(id)
 	if err != nil {
+		return nil, err
+	}
+
+	if err = l.flush(id, ml); err != nil {
+		return nil, err
+	}
+
+	segments, err := l.sealedSegments(id)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps := func(first, last time.Time) bool {
+		return (since.IsZero() || !last.Before(since)) && (until.IsZero() || !first.After(until))
+	}
+
+	var sources []segmentSource
+
+	for _, segment := range segments {
+		if !overlaps(segment.first, segment.last) {
+			continue
+		}
+
+		f, openErr := os.Open(segment.path)
+		if openErr != nil {
+			closeSources(sources)
+
+			return nil, fmt.Errorf("failed to open log segment: %w", openErr)
+		}
+
+		sources = append(sources, segmentSource{file: f, compressed: true})
+	}
+
+	if ml.size > 0 && overlaps(ml.first, ml.last) {
+		f, openErr := os.Open(l.activeSegmentPath(id))
+		if openErr != nil {
+			closeSources(sources)
+
+			return nil, fmt.Errorf("failed to open log segment: %w", openErr)
+		}
+
+		sources = append(sources, segmentSource{file: f, size: ml.size})
+	}
+
+	return sources, nil
+}
+
+// machine returns the state of the active segment of the machine, reading it from the disk on the first access.
+func (l *LogStorage) machine(id MachineID) (*machineLog, error) {
+	if ml, ok := l.machines[id]; ok {
+		return ml, nil
+	}
+
+	if err := l.migrateLegacyLog(id); err != nil {
+		return nil, fmt.Errorf("failed to migrate legacy log file for machine '%s': %w", id, err)
+	}
+
+	ml := &machineLog{}
+
+	f, err := os.Open(l.activeSegmentPath(id))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to open log segment: %w", err)
+	}
+
+	if err == nil {
+		defer f.Close() //nolint:errcheck
+
+		r := bufio.NewReader(f)
+
+		for {
+			line, readErr := r.ReadBytes('\n')
+			if errors.Is(readErr, io.EOF) {
+				// a partially written entry at the end is overwritten by the next ones
+				break
+			}
+
+			if readErr != nil {
+				return nil, fmt.Errorf("failed to read log segment: %w", readErr)
+			}
+
+			ml.size += int64(len(line))
+
+			timestamp, _, ok := parseEntry(line)
+			if !ok {
+				continue
+			}
+
+			if ml.first.IsZero() {
+				ml.first = timestamp
+			}
+
+			if timestamp.After(ml.last) {
+				ml.last = timestamp
+			}
+		}
+	}
+
+	l.machines[id] = ml
+
+	return ml, nil
+}
+
+// flush writes the pending entries of the machine to the active segment, and seals it if it is full.
+func (l *LogStorage) flush(id MachineID, ml *machineLog) error {
+	if len(ml.pending) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(l.machineDir(id), 0o755); err != nil {
 		return fmt.Errorf("failed to create log storage directory: %w", err)
 	}
 
-	logFilePath := l.logPath(machineID)
+	f, err := os.OpenFile(l.activeSegmentPath(id), os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open log segment for machine '%s': %w", id, err)
+	}
 
-	file, err := os.OpenFile(logFilePath, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0o644)
+	defer f.Close() //nolint:errcheck
+
+	n, err := f.WriteAt(ml.pending, ml.size)
 	if err != nil {
-		return fmt.Errorf("failed to open log file for machine '%s': %w", machineID, err)
+		return fmt.Errorf("failed to write log segment for machine '%s': %w", id, err)
 	}
 
-	defer file.Close() //nolint:errcheck
+	ml.size += int64(n)
+	ml.pending = ml.pending[:0]
 
-	hash := sha256.New()
+	if ml.size < l.SegmentSize {
+		return nil
+	}
 
-	teeReader := io.TeeReader(reader, hash)
+	return l.seal(id, ml)
+}
 
-	_, err = io.Copy(file, teeReader)
+func (l *LogStorage) flushMachine(id MachineID, now time.Time) error {
+	ml, err := l.machine(id)
 	if err != nil {
-		return fmt.Errorf("failed to copy buffer to file for machine '%s': %w", machineID, err)
+		return err
 	}
 
-	hashHex := hex.EncodeToString(hash.Sum(nil))
+	if err = l.flush(id, ml); err != nil {
+		return err
+	}
 
-	return os.WriteFile(l.logSHA256Path(machineID), []byte(hashHex), 0o644)
+	if ml.size > 0 && now.Sub(ml.first) >= segmentMaxDuration {
+		if err = l.seal(id, ml); err != nil {
+			return err
+		}
+	}
+
+	return l.prune(id, ml, now)
 }
 
-func (l *LogStorage) logPath(machineID MachineID) string {
-	return filepath.Join(l.Path, fmt.Sprintf("%s.log", machineID))
+// seal compresses the active segment of the machine into a new sealed segment.
+func (l *LogStorage) seal(id MachineID, ml *machineLog) error {
+	data, err := os.ReadFile(l.activeSegmentPath(id))
+	if err != nil {
+		return fmt.Errorf("failed to read log segment: %w", err)
+	}
+
+	if err = l.writeSealedSegment(id, ml.first, ml.last, data[:ml.size]); err != nil {
+		return err
+	}
+
+	if err = os.Remove(l.activeSegmentPath(id)); err != nil {
+		return fmt.Errorf("failed to remove sealed log segment: %w", err)
+	}
+
+	*ml = machineLog{pending: ml.pending}
+
+	return nil
 }
 
-func (l *LogStorage) logSHA256Path(machineID MachineID) string {
-	return l.logPath(machineID) + ".sha256sum"
+func (l *LogStorage) writeSealedSegment(id MachineID, first, last time.Time, data []byte) error {
+	path := filepath.Join(l.machineDir(id), fmt.Sprintf("%020d-%020d%s", first.UnixNano(), last.UnixNano(), sealedSegmentSuffix))
+	tmpPath := path + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create log segment: %w", err)
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	gz := gzip.NewWriter(f)
+
+	if _, err = gz.Write(data); err != nil {
+		return fmt.Errorf("failed to compress log segment: %w", err)
+	}
+
+	if err = gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress log segment: %w", err)
+	}
+
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("failed to write log segment: %w", err)
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// prune removes the oldest segments of the machine which exceed the size or the age limits.
+func (l *LogStorage) prune(id MachineID, ml *machineLog, now time.Time) error {
+	segments, err := l.sealedSegments(id)
+	if err != nil {
+		return err
+	}
+
+	total := ml.size
+
+	for _, segment := range segments {
+		total += segment.size
+	}
+
+	for _, segment := range segments {
+		expired := l.MaxAge > 0 && now.Sub(segment.last) > l.MaxAge
+		oversized := l.MaxSize > 0 && total > l.MaxSize
+
+		if !expired && !oversized {
+			break
+		}
+
+		if err = removeFileIfExists(segment.path); err != nil {
+			return fmt.Errorf("failed to remove log segment: %w", err)
+		}
+
+		total -= segment.size
+	}
+
+	if ml.size > 0 && l.MaxAge > 0 && now.Sub(ml.last) > l.MaxAge {
+		if err = removeFileIfExists(l.activeSegmentPath(id)); err != nil {
+			return fmt.Errorf("failed to remove log segment: %w", err)
+		}
+
+		*ml = machineLog{pending: ml.pending}
+	}
+
+	return nil
+}
+
+type sealedSegment struct {
+	first time.Time
+	last  time.Time
+	path  string
+	size  int64
+}
+
+// sealedSegments returns the sealed segments of the machine sorted from the oldest to the newest.
+func (l *LogStorage) sealedSegments(id MachineID) ([]sealedSegment, error) {
+	entries, err := os.ReadDir(l.machineDir(id))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to list log segments: %w", err)
+	}
+
+	var segments []sealedSegment
+
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), sealedSegmentSuffix)
+		if !ok || !entry.Type().IsRegular() {
+			continue
+		}
+
+		firstStr, lastStr, ok := strings.Cut(name, "-")
+		if !ok {
+			continue
+		}
+
+		first, firstErr := strconv.ParseInt(firstStr, 10, 64)
+		last, lastErr := strconv.ParseInt(lastStr, 10, 64)
+
+		if firstErr != nil || lastErr != nil {
+			continue
+		}
+
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			continue
+		}
+
+		segments = append(segments, sealedSegment{
+			first: time.Unix(0, first),
+			last:  time.Unix(0, last),
+			path:  filepath.Join(l.machineDir(id), entry.Name()),
+			size:  info.Size(),
+		})
+	}
+
+	// zero-padded names sort in the chronological order
+	slices.SortFunc(segments, func(a, b sealedSegment) int { return strings.Compare(a.path, b.path) })
+
+	return segments, nil
+}
+
+// storedMachines returns the IDs of the machines which have logs either in memory or on the disk.
+func (l *LogStorage) storedMachines() ([]MachineID, error) {
+	ids := make([]MachineID, 0, len(l.machines))
+
+	for id := range l.machines {
+		ids = append(ids, id)
+	}
+
+	entries, err := os.ReadDir(l.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to list log storage directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			ids = append(ids, MachineID(entry.Name()))
+		} else if name, ok := strings.CutSuffix(entry.Name(), legacyLogSuffix); ok {
+			ids = append(ids, MachineID(name))
+		}
+	}
+
+	slices.Sort(ids)
+
+	return slices.Compact(ids), nil
+}
+
+// migrateLegacyLog converts the single-file log of the machine, if exists, to a sealed segment.
+//
+// Legacy logs have no timestamps, so all of their entries get the modification time of the file.
+func (l *LogStorage) migrateLegacyLog(id MachineID) error {
+	path := l.legacyLogPath(id)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	lineReader := &LineReader{reader: io.NopCloser(bytes.NewReader(data))}
+
+	var encoded []byte
+
+	for {
+		line, readErr := lineReader.ReadLine()
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+
+		if readErr != nil {
+			return readErr
+		}
+
+		encoded = appendEntry(encoded, info.ModTime(), line)
+	}
+
+	if len(encoded) > 0 {
+		if err = os.MkdirAll(l.machineDir(id), 0o755); err != nil {
+			return fmt.Errorf("failed to create log storage directory: %w", err)
+		}
+
+		if err = l.writeSealedSegment(id, info.ModTime(), info.ModTime(), encoded); err != nil {
+			return err
+		}
+	}
+
+	if err = removeFileIfExists(path); err != nil {
+		return err
+	}
+
+	return removeFileIfExists(path + ".sha256sum")
+}
+
+func (l *LogStorage) machineDir(id MachineID) string {
+	return filepath.Join(l.Path, string(id))
+}
+
+func (l *LogStorage) activeSegmentPath(id MachineID) string {
+	return filepath.Join(l.machineDir(id), activeSegmentName)
+}
+
+func (l *LogStorage) legacyLogPath(id MachineID) string {
+	return filepath.Join(l.Path, string(id)+legacyLogSuffix)
+}
+
+// LogStorageReader reads the log entries of a machine from the storage segments.
+type LogStorageReader struct {
+	since   time.Time
+	until   time.Time
+	reader  *bufio.Reader
+	closer  io.Closer
+	sources []segmentSource
+	mu      sync.Mutex
+}
+
+type segmentSource struct {
+	file       *os.File
+	size       int64
+	compressed bool
+}
+
+// ReadLine reads the next log entry.
+func (r *LogStorageReader) ReadLine() ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for {
+		if r.reader == nil {
+			if len(r.sources) == 0 {
+				return nil, io.EOF
+			}
+
+			if err := r.next(); err != nil {
+				return nil, err
+			}
+		}
+
+		line, err := r.reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			r.reader = nil
+
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read log segment: %w", err)
+		}
+
+		timestamp, data, ok := parseEntry(line)
+		if !ok {
+			continue
+		}
+
+		if !r.since.IsZero() && timestamp.Before(r.since) {
+			continue
+		}
+
+		if !r.until.IsZero() && timestamp.After(r.until) {
+			continue
+		}
+
+		return data, nil
+	}
+}
+
+// Close closes the LogStorageReader underlying segment files.
+func (r *LogStorageReader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	closeSources(r.sources)
+
+	r.sources = nil
+	r.reader = nil
+
+	if r.closer == nil {
+		return nil
+	}
+
+	err := r.closer.Close()
+	r.closer = nil
+
+	return err
+}
+
+func (r *LogStorageReader) next() error {
+	source := r.sources[0]
+	r.sources = r.sources[1:]
+
+	if r.closer != nil {
+		r.closer.Close() //nolint:errcheck
+	}
+
+	r.closer = source.file
+
+	if !source.compressed {
+		r.reader = bufio.NewReader(io.LimitReader(source.file, source.size))
+
+		return nil
+	}
+
+	gz, err := gzip.NewReader(source.file)
+	if err != nil {
+		return fmt.Errorf("failed to read log segment %q: %w", filepath.Base(source.file.Name()), err)
+	}
+
+	r.reader = bufio.NewReader(gz)
+
+	return nil
+}
+
+func closeSources(sources []segmentSource) {
+	for _, source := range sources {
+		source.file.Close() //nolint:errcheck
+	}
+}
+
+// appendEntry encodes the log entry as a line with the timestamp in Unix nanoseconds, followed by a space and the data.
+func appendEntry(b []byte, timestamp time.Time, data []byte) []byte {
+	b = strconv.AppendInt(b, timestamp.UnixNano(), 10)
+	b = append(b, ' ')
+	b = append(b, data...)
+
+	return append(b, '\n')
+}
+
+func parseEntry(line []byte) (time.Time, []byte, bool) {
+	line, ok := bytes.CutSuffix(line, []byte{'\n'})
+	if !ok {
+		return time.Time{}, nil, false
+	}
+
+	timestampStr, data, ok := bytes.Cut(line, []byte{' '})
+	if !ok {
+		return time.Time{}, nil, false
+	}
+
+	timestamp, err := strconv.ParseInt(string(timestampStr), 10, 64)
+	if err != nil {
+		return time.Time{}, nil, false
+	}
+
+	return time.Unix(0, timestamp), data, true
 }
 
-func (l *LogStorage) removeFileIfExists(path string) error {
+func removeFileIfExists(path string) error {
 	err := os.Remove(path)
 	if err == nil || errors.Is(err, os.ErrNotExist) {
 		return nil
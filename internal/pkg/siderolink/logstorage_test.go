@@ -6,16 +6,18 @@
 package siderolink_test
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
-	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/siderolink"
 )
 
@@ -23,74 +25,184 @@ type LogStorageSuite struct {
 	suite.Suite
 }
 
-// TestSave tests that log storage saves logs on file system with their hash.
-func (l *LogStorageSuite) TestSave() {
-	tempDir := l.T().TempDir()
-	logStorage := siderolink.NewLogStorage(tempDir)
+func (l *LogStorageSuite) newStorage(dir string) *siderolink.LogStorage {
+	return siderolink.NewLogStorage(&config.LogStorageParams{
+		Path: dir,
+	})
+}
+
+func (l *LogStorageSuite) readAll(r *siderolink.LogStorageReader) []string {
+	defer r.Close() //nolint:errcheck
 
-	l.Require().NoError(logStorage.Save("test-machine", strings.NewReader("aaaa")), "failed to save log")
+	var lines []string
 
-	fileBytes, err := os.ReadFile(filepath.Join(tempDir, "test-machine.log"))
-	l.Require().NoError(err, "failed to read log file")
+	for {
+		line, err := r.ReadLine()
+		if errors.Is(err, io.EOF) {
+			return lines
+		}
+
+		l.Require().NoError(err)
+
+		lines = append(lines, string(line))
+	}
+}
 
-	l.Require().Equal("aaaa", string(fileBytes), "log file contents are not equal")
+func (l *LogStorageSuite) segments(dir, suffix string) []string {
+	entries, err := os.ReadDir(dir)
+	l.Require().NoError(err)
 
-	fileHashBytes, err := os.ReadFile(filepath.Join(tempDir, "test-machine.log.sha256sum"))
-	l.Require().NoError(err, "failed to read log file sha256sum")
+	var result []string
 
-	expectedHash := sha256Hex([]byte("aaaa"))
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), suffix) {
+			result = append(result, entry.Name())
+		}
+	}
 
-	assert.Equal(l.T(), expectedHash, string(fileHashBytes), "log file hash is not equal")
+	return result
 }
 
-// TestLoad tests that log storage loads logs from file system.
+// TestLoad tests that log storage loads the flushed logs from file system.
 func (l *LogStorageSuite) TestLoad() {
 	tempDir := l.T().TempDir()
-	logStorage := siderolink.NewLogStorage(tempDir)
+	logStorage := l.newStorage(tempDir)
+
+	exists, err := logStorage.Exists("test-machine")
+	l.Require().NoError(err)
+	l.Require().False(exists)
+
+	now := time.Now()
+
+	l.Require().NoError(logStorage.Append("test-machine", now, []byte("aaaa")))
+	l.Require().NoError(logStorage.Append("test-machine", now, []byte("bbbb")))
+	l.Require().NoError(logStorage.Flush())
+
+	l.Require().FileExists(filepath.Join(tempDir, "test-machine", "current.log"))
+
+	// a new storage reads the logs written by the previous one
+	logStorage = l.newStorage(tempDir)
 
-	l.Require().NoError(logStorage.Save("test-machine", strings.NewReader("aaaa")), "failed to save log")
+	exists, err = logStorage.Exists("test-machine")
+	l.Require().NoError(err)
+	l.Require().True(exists)
 
 	var buffer strings.Builder
 
 	l.Require().NoError(logStorage.Load("test-machine", &buffer))
 
-	l.Assert().Equal("aaaa", buffer.String(), "log file contents are not equal")
+	l.Assert().Equal("\naaaa\n\nbbbb\n", buffer.String(), "log contents are not equal")
+}
+
+// TestReadTimeRange tests that the entries are compressed into segments, and read within the time range.
+func (l *LogStorageSuite) TestReadTimeRange() {
+	tempDir := l.T().TempDir()
+	logStorage := l.newStorage(tempDir)
+	logStorage.SegmentSize = 64
+
+	start := time.Now().Add(-24 * time.Hour)
+
+	for i := range 10 {
+		l.Require().NoError(logStorage.Append("test-machine", start.Add(time.Duration(i)*time.Hour), []byte(fmt.Sprintf("line %d", i))))
+
+		if i%2 == 1 {
+			l.Require().NoError(logStorage.Flush())
+		}
+	}
+
+	l.Require().Len(l.segments(filepath.Join(tempDir, "test-machine"), ".log.gz"), 5)
+
+	r, err := logStorage.Reader("test-machine", start.Add(3*time.Hour), start.Add(6*time.Hour))
+	l.Require().NoError(err)
+
+	l.Assert().Equal([]string{"line 3", "line 4", "line 5", "line 6"}, l.readAll(r))
+
+	r, err = logStorage.Reader("test-machine", time.Time{}, time.Time{})
+	l.Require().NoError(err)
+
+	l.Assert().Len(l.readAll(r), 10)
+
+	// the pending entries are read as well
+	l.Require().NoError(logStorage.Append("test-machine", time.Now(), []byte("line 10")))
+
+	r, err = logStorage.Reader("test-machine", start.Add(9*time.Hour), time.Time{})
+	l.Require().NoError(err)
+
+	l.Assert().Equal([]string{"line 9", "line 10"}, l.readAll(r))
 }
 
-func (l *LogStorageSuite) TestLoadInvalid() {
+// TestRetention tests that the oldest segments are removed once the logs exceed the size or the age limits.
+func (l *LogStorageSuite) TestRetention() {
 	tempDir := l.T().TempDir()
-	logStorage := siderolink.NewLogStorage(tempDir)
+	logStorage := l.newStorage(tempDir)
+	logStorage.SegmentSize = 64
+	logStorage.MaxAge = 54 * time.Hour
+
+	start := time.Now().Add(-96 * time.Hour)
+
+	for i := range 8 {
+		l.Require().NoError(logStorage.Append("test-machine", start.Add(time.Duration(i)*12*time.Hour), []byte(strings.Repeat("a", 100))))
+		l.Require().NoError(logStorage.Flush())
+	}
 
-	l.Require().NoError(logStorage.Save("test-machine", strings.NewReader("aaaa")), "failed to save log")
+	r, err := logStorage.Reader("test-machine", time.Time{}, time.Time{})
+	l.Require().NoError(err)
 
-	// corrupt the log file hash
-	l.Require().NoError(os.WriteFile(filepath.Join(tempDir, "test-machine.log.sha256sum"), []byte("invalid-hash"), 0o644))
+	// the entries older than the max age are removed
+	l.Assert().Len(l.readAll(r), 4)
+
+	segments := l.segments(filepath.Join(tempDir, "test-machine"), ".log.gz")
+	l.Require().Len(segments, 4)
+
+	info, err := os.Stat(filepath.Join(tempDir, "test-machine", segments[0]))
+	l.Require().NoError(err)
+
+	logStorage.MaxSize = 2 * info.Size()
+
+	l.Require().NoError(logStorage.Flush())
+
+	l.Assert().Len(l.segments(filepath.Join(tempDir, "test-machine"), ".log.gz"), 2)
+}
+
+// TestLegacyLog tests that the single-file logs of the previous versions are converted to segments.
+func (l *LogStorageSuite) TestLegacyLog() {
+	tempDir := l.T().TempDir()
+
+	l.Require().NoError(os.WriteFile(filepath.Join(tempDir, "test-machine.log"), []byte("\naaaa\n\nbbbb\n"), 0o644))
+	l.Require().NoError(os.WriteFile(filepath.Join(tempDir, "test-machine.log.sha256sum"), []byte("hash"), 0o644))
+
+	logStorage := l.newStorage(tempDir)
+
+	exists, err := logStorage.Exists("test-machine")
+	l.Require().NoError(err)
+	l.Require().True(exists)
 
 	var buffer strings.Builder
 
-	l.Require().Error(logStorage.Load("test-machine", &buffer))
+	l.Require().NoError(logStorage.Load("test-machine", &buffer))
+
+	l.Assert().Equal("\naaaa\n\nbbbb\n", buffer.String(), "log contents are not equal")
 
-	l.Assert().NoFileExists(filepath.Join(tempDir, "test-machine.log"), "log file was not removed")
-	l.Assert().NoFileExists(filepath.Join(tempDir, "test-machine.log.sha256sum"), "log file was not removed")
+	l.Assert().NoFileExists(filepath.Join(tempDir, "test-machine.log"), "legacy log file was not removed")
+	l.Assert().NoFileExists(filepath.Join(tempDir, "test-machine.log.sha256sum"), "legacy log hash file was not removed")
+	l.Assert().Len(l.segments(filepath.Join(tempDir, "test-machine"), ".log.gz"), 1)
 }
 
 // TestRemove tests that log storage cal properly remove logs from file system.
 func (l *LogStorageSuite) TestRemove() {
 	tempDir := l.T().TempDir()
-	logStorage := siderolink.NewLogStorage(tempDir)
+	logStorage := l.newStorage(tempDir)
 
-	l.Require().NoError(logStorage.Save("test-machine", strings.NewReader("aaaa")), "failed to save log")
+	l.Require().NoError(logStorage.Append("test-machine", time.Now(), []byte("aaaa")))
+	l.Require().NoError(logStorage.Flush())
 
 	l.Require().NoError(logStorage.Remove("test-machine"))
 
-	l.Require().NoFileExists(filepath.Join(tempDir, "test-machine.log"), "log file was not removed")
-	l.Require().NoFileExists(filepath.Join(tempDir, "test-machine.log.sha256sum"), "log file was not removed")
-}
-
-func sha256Hex(data []byte) string {
-	sum256Sum := sha256.Sum256(data)
+	l.Require().NoDirExists(filepath.Join(tempDir, "test-machine"), "log directory was not removed")
 
-	return hex.EncodeToString(sum256Sum[:])
+	exists, err := logStorage.Exists("test-machine")
+	l.Require().NoError(err)
+	l.Require().False(exists)
 }
 
 func TestLogStorageSuite(t *testing.T) {
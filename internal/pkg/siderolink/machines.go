@@ -11,11 +11,11 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
-	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/containers"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/go-circular"
@@ -44,6 +44,7 @@ func NewMachineCache(storage optional.Optional[*LogStorage], logger *zap.Logger)
 }
 
 // WriteMessage writes the message surrounded with '\n' to the circular buffer for the given machine ID.
+// If storage is enabled, it also appends the message to the storage.
 func (m *MachineCache) WriteMessage(id MachineID, rawData []byte) error {
 	buffer, err := m.GetWriter(id)
 	if err != nil {
@@ -65,6 +66,10 @@ func (m *MachineCache) WriteMessage(id MachineID, rawData []byte) error {
 		return err
 	}
 
+	if storage, storageEnabled := m.Storage.Get(); storageEnabled {
+		return storage.Append(id, time.Now(), rawData)
+	}
+
 	return nil
 }
 
@@ -133,7 +138,7 @@ func (m *MachineCache) Remove(id MachineID) error {
 	return nil
 }
 
-// SaveAll saves all the logs to the storage, i.e., the file system.
+// SaveAll flushes all the logs to the storage, i.e., the file system, and applies the storage retention.
 func (m *MachineCache) SaveAll() error {
 	storage, storageEnabled := m.Storage.Get()
 
@@ -141,32 +146,7 @@ func (m *MachineCache) SaveAll() error {
 		return errors.New("storage is not enabled")
 	}
 
-	var multiErr error
-
-	for id, reader := range m.getMachineBufferReaders() {
-		m.logger.Debug("save logs for machine", zap.String("machine_id", string(id)))
-
-		err := storage.Save(id, reader)
-		if err != nil {
-			multiErr = multierror.Append(multiErr, err)
-		}
-	}
-
-	return multiErr
-}
-
-// getMachineBufferReaders takes a snapshot (copy) of the machine ID to buffer map in a thread-safe manner.
-func (m *MachineCache) getMachineBufferReaders() map[MachineID]io.Reader {
-	m.mx.Lock()
-	defer m.mx.Unlock()
-
-	bufferReaderMap := map[MachineID]io.Reader{}
-
-	m.machineBuffers.ForEach(func(id MachineID, buffer *circular.Buffer) {
-		bufferReaderMap[id] = buffer.GetReader()
-	})
-
-	return bufferReaderMap
+	return storage.Flush()
 }
 
 func (m *MachineCache) init() {
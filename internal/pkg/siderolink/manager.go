@@ -8,6 +8,7 @@ package siderolink
 import (
 	"context"
 	"crypto/rand"
+	"crypto/subtle"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 	"net/netip"
 	"os"
 	"strconv"
+	"sync"
 	"syscall"
 	"time"
 
@@ -45,6 +47,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/config"
@@ -67,6 +70,9 @@ type LinkCounterDelta struct {
 // maxPendingClientMessages sets the maximum number of messages for queue "from peers" after which it will block.
 const maxPendingClientMessages = 100
 
+// machineAcceptanceRetryInterval is the interval between the attempts to apply a machine acceptance which has failed.
+const machineAcceptanceRetryInterval = 10 * time.Second
+
 // NewManager creates new Manager.
 func NewManager(
 	ctx context.Context,
@@ -142,6 +148,7 @@ type Manager struct {
 	allowedPeers        *wggrpc.AllowedPeers
 	peerTraffic         *wgbind.PeerTraffic
 	virtualPrefix       netip.Prefix
+	joinTokenMu         sync.Mutex
 }
 
 // JoinTokenLen number of random bytes to be encoded in the join token.
@@ -263,6 +270,7 @@ func (manager *Manager) Run(
 	}
 
 	eg.Go(func() error { return manager.pollWireguardPeers(groupCtx) })
+	eg.Go(func() error { return manager.handleMachineAcceptances(groupCtx) })
 
 	if listenHost == "" {
 		listenHost = manager.serverAddr.Addr().String()
@@ -627,14 +635,22 @@ type isDirty bool
 
 // getLink will return either the existing Link for the requesting node or a new Link.
 func (manager *Manager) getLink(ctx context.Context, req *pb.ProvisionRequest, id string) (*siderolink.Link, isDirty, error) {
+	pending, err := manager.checkMachineAcceptance(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+
 	res, err := safe.StateGet[*siderolink.Link](ctx, manager.state, resource.NewMetadata(siderolink.Namespace, siderolink.LinkType, id, resource.VersionUndefined))
 	if state.IsNotFoundError(err) {
-		if manager.wgConfig().JoinToken == "" {
-			return nil, false, status.Error(codes.PermissionDenied, "cannot accept new nodes if no join token is set")
-		}
+		// the number of the machines joined with a join token is checked before the link is created
+		manager.joinTokenMu.Lock()
+		defer manager.joinTokenMu.Unlock()
 
-		if req.JoinToken == nil || *req.JoinToken != manager.wgConfig().JoinToken {
-			return nil, false, status.Error(codes.PermissionDenied, "invalid join token")
+		var joinToken *siderolink.JoinToken
+
+		joinToken, err = manager.checkJoinToken(ctx, req)
+		if err != nil {
+			return nil, false, err
 		}
 
 		var spec *specs.SiderolinkSpec
@@ -648,16 +664,261 @@ func (manager *Manager) getLink(ctx context.Context, req *pb.ProvisionRequest, i
 
 		link := siderolink.NewLink(siderolink.Namespace, id, spec)
 
+		if joinToken != nil {
+			spec.JoinTokenId = joinToken.Metadata().ID()
+
+			link.Metadata().Labels().Set(omni.LabelJoinToken, joinToken.Metadata().ID())
+
+			// the use is counted before the link is created, so a failed join might take up a use, but the limit is never exceeded
+			if _, err = safe.StateUpdateWithConflicts(ctx, manager.state, joinToken.Metadata(), func(res *siderolink.JoinToken) error {
+				res.TypedSpec().Value.Uses++
+
+				return nil
+			}); err != nil {
+				return nil, false, err
+			}
+		}
+
+		if pending {
+			link.Metadata().Labels().Set(omni.MachineStatusLabelPendingAcceptance, "")
+
+			if err = manager.state.Create(ctx, siderolink.NewMachineAcceptance(siderolink.Namespace, id)); err != nil && !state.IsConflictError(err) {
+				return nil, false, err
+			}
+		}
+
 		if err = manager.state.Create(ctx, link); err != nil {
 			return nil, false, err
 		}
 
+		if joinToken != nil {
+			if err = manager.createMachineLabels(ctx, id, joinToken); err != nil {
+				return nil, false, err
+			}
+		}
+
 		return link, true, nil
 	}
 
 	return res, false, err
 }
 
+// checkMachineAcceptance checks that the machine is not rejected, and returns whether the machine has to be accepted before it can be used.
+func (manager *Manager) checkMachineAcceptance(ctx context.Context, id string) (bool, error) {
+	acceptance, err := safe.StateGetByID[*siderolink.MachineAcceptance](ctx, manager.state, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return config.Config.SiderolinkRequireMachineApproval, nil
+		}
+
+		return false, err
+	}
+
+	switch acceptance.TypedSpec().Value.Phase {
+	case specs.MachineAcceptanceSpec_Rejected:
+		return false, status.Error(codes.PermissionDenied, "the machine is rejected")
+	case specs.MachineAcceptanceSpec_Pending:
+		return true, nil
+	case specs.MachineAcceptanceSpec_Accepted:
+	}
+
+	return false, nil
+}
+
+// handleMachineAcceptances applies the changes of the machine acceptances to the links.
+//
+// The links of the rejected machines are torn down, and they are destroyed when the controllers remove their finalizers.
+// The failed attempts are retried in the background, so a single machine never blocks handling the others.
+func (manager *Manager) handleMachineAcceptances(ctx context.Context) error {
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	eventCh := make(chan state.Event)
+	retryCh := make(chan resource.ID)
+
+	if err := manager.state.WatchKind(ctx, siderolink.NewMachineAcceptance(siderolink.Namespace, "").Metadata(), eventCh, state.WithBootstrapContents(true)); err != nil {
+		return err
+	}
+
+	if err := manager.state.WatchKind(ctx, siderolink.NewLink(siderolink.Namespace, "", nil).Metadata(), eventCh); err != nil {
+		return err
+	}
+
+	for {
+		var id resource.ID
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case id = <-retryCh:
+		case event := <-eventCh:
+			switch event.Type {
+			case state.Errored:
+				return fmt.Errorf("error watching machine acceptances: %w", event.Error)
+			case state.Bootstrapped, state.Destroyed:
+				continue
+			case state.Created, state.Updated:
+			}
+
+			// only the links which are being removed are of interest, as they are destroyed once their finalizers are removed
+			if event.Resource.Metadata().Type() == siderolink.LinkType && event.Resource.Metadata().Phase() != resource.PhaseTearingDown {
+				continue
+			}
+
+			id = event.Resource.Metadata().ID()
+		}
+
+		if err := manager.applyMachineAcceptance(ctx, id); err != nil {
+			manager.logger.Error("failed to apply the machine acceptance, retrying", zap.String("machine", id), zap.Error(err))
+
+			time.AfterFunc(machineAcceptanceRetryInterval, func() {
+				channel.SendWithContext(ctx, retryCh, id)
+			})
+		}
+	}
+}
+
+// applyMachineAcceptance marks the link of the pending machine, and removes the link of the rejected machine.
+func (manager *Manager) applyMachineAcceptance(ctx context.Context, id resource.ID) error {
+	acceptance, err := safe.StateGetByID[*siderolink.MachineAcceptance](ctx, manager.state, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	link, err := safe.StateGetByID[*siderolink.Link](ctx, manager.state, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if acceptance.TypedSpec().Value.Phase == specs.MachineAcceptanceSpec_Rejected {
+		return manager.removeRejectedLink(ctx, link)
+	}
+
+	if link.Metadata().Phase() == resource.PhaseTearingDown {
+		return nil
+	}
+
+	_, err = safe.StateUpdateWithConflicts(ctx, manager.state, link.Metadata(), func(res *siderolink.Link) error {
+		if acceptance.TypedSpec().Value.Phase == specs.MachineAcceptanceSpec_Pending {
+			res.Metadata().Labels().Set(omni.MachineStatusLabelPendingAcceptance, "")
+		} else {
+			res.Metadata().Labels().Delete(omni.MachineStatusLabelPendingAcceptance)
+		}
+
+		return nil
+	})
+	if err != nil && !state.IsNotFoundError(err) && !state.IsPhaseConflictError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// removeRejectedLink disconnects the rejected machine and tears down its link.
+//
+// The link is destroyed right away if it has no finalizers, otherwise it is destroyed on one of the next link updates.
+func (manager *Manager) removeRejectedLink(ctx context.Context, link *siderolink.Link) error {
+	if link.Metadata().Phase() == resource.PhaseRunning {
+		if err := manager.wgHandler.PeerEvent(ctx, link.TypedSpec().Value, true); err != nil {
+			return err
+		}
+
+		manager.logger.Info("machine is rejected, removing the link", zap.String("machine", link.Metadata().ID()))
+	}
+
+	ready, err := manager.state.Teardown(ctx, link.Metadata())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !ready {
+		return nil
+	}
+
+	if err = manager.state.Destroy(ctx, link.Metadata()); err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// checkJoinToken checks the join token of the node which joins for the first time.
+//
+// The node can join either with the instance-wide join token, in which case nil is returned, or with one of the JoinTokens.
+func (manager *Manager) checkJoinToken(ctx context.Context, req *pb.ProvisionRequest) (*siderolink.JoinToken, error) {
+	joinTokens, err := safe.StateListAll[*siderolink.JoinToken](ctx, manager.state)
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.wgConfig().JoinToken == "" && joinTokens.Len() == 0 {
+		return nil, status.Error(codes.PermissionDenied, "cannot accept new nodes if no join token is set")
+	}
+
+	if req.JoinToken == nil {
+		return nil, status.Error(codes.PermissionDenied, "invalid join token")
+	}
+
+	if manager.wgConfig().JoinToken != "" && *req.JoinToken == manager.wgConfig().JoinToken {
+		return nil, nil //nolint:nilnil
+	}
+
+	for iter := joinTokens.Iterator(); iter.Next(); {
+		joinToken := iter.Value()
+
+		if subtle.ConstantTimeCompare([]byte(joinToken.TypedSpec().Value.Token), []byte(*req.JoinToken)) != 1 {
+			continue
+		}
+
+		if err = siderolink.CheckJoinTokenUsable(joinToken.TypedSpec().Value, time.Now()); err != nil {
+			manager.logger.Warn("rejected the node joining with the join token",
+				zap.String("node", req.NodeUuid),
+				zap.String("join_token", joinToken.Metadata().ID()),
+				zap.Error(err),
+			)
+
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return joinToken, nil
+	}
+
+	return nil, status.Error(codes.PermissionDenied, "invalid join token")
+}
+
+// createMachineLabels sets the initial labels of the machine which joins with the join token.
+//
+// The labels are set only if the machine doesn't have any labels yet, so the labels set by the user are never overwritten.
+func (manager *Manager) createMachineLabels(ctx context.Context, id string, joinToken *siderolink.JoinToken) error {
+	spec := joinToken.TypedSpec().Value
+	machineLabels := omni.NewMachineLabels(resources.DefaultNamespace, id)
+
+	for key, value := range spec.MachineLabels {
+		machineLabels.Metadata().Labels().Set(key, value)
+	}
+
+	if machineLabels.Metadata().Labels().Empty() {
+		return nil
+	}
+
+	if err := manager.state.Create(ctx, machineLabels); err != nil && !state.IsConflictError(err) {
+		return err
+	}
+
+	return nil
+}
+
 // Provision the SideroLink.
 func (manager *Manager) Provision(ctx context.Context, req *pb.ProvisionRequest) (*pb.ProvisionResponse, error) {
 	ctx = actor.MarkContextAsInternalActor(ctx)
@@ -29,10 +29,14 @@ import (
 	"go.uber.org/zap/zaptest"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/errgroup"
@@ -358,6 +362,193 @@ func (suite *SiderolinkSuite) TestVirtualNodes() {
 	suite.Require().Equal(reprovision.GrpcPeerAddrPort, res.TypedSpec().Value.VirtualAddrport)
 }
 
+func (suite *SiderolinkSuite) TestJoinTokens() {
+	conn, err := grpc.DialContext(suite.ctx, suite.address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	suite.Require().NoError(err)
+
+	client := pb.NewProvisionServiceClient(conn)
+
+	provision := func(id, token string) error {
+		privateKey, err := wgtypes.GeneratePrivateKey() //nolint:govet
+		suite.Require().NoError(err)
+
+		_, err = client.Provision(suite.ctx, &pb.ProvisionRequest{
+			NodeUuid:      id,
+			NodePublicKey: privateKey.PublicKey().String(),
+			JoinToken:     &token,
+		})
+
+		return err
+	}
+
+	token, err := sideromanager.GenerateJoinToken()
+	suite.Require().NoError(err)
+
+	joinToken := siderolink.NewJoinToken(siderolink.Namespace, "site-a")
+	joinToken.TypedSpec().Value.Token = token
+	joinToken.TypedSpec().Value.MaxUses = 1
+	joinToken.TypedSpec().Value.Cluster = "cluster-a"
+	joinToken.TypedSpec().Value.MachineLabels = map[string]string{"site": "a"}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, joinToken))
+
+	suite.Require().NoError(provision("node-1", token))
+
+	link, err := safe.StateGetByID[*siderolink.Link](suite.ctx, suite.state, "node-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(joinToken.Metadata().ID(), link.TypedSpec().Value.JoinTokenId)
+
+	machineLabels, err := safe.StateGetByID[*omni.MachineLabels](suite.ctx, suite.state, "node-1")
+	suite.Require().NoError(err)
+
+	site, _ := machineLabels.Metadata().Labels().Get("site")
+	suite.Assert().Equal("a", site)
+
+	// the node which has already joined can reconnect, but no other nodes can join once the token is used up
+	suite.Require().NoError(provision("node-1", token))
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(provision("node-2", token)))
+
+	joinToken, err = safe.StateGetByID[*siderolink.JoinToken](suite.ctx, suite.state, joinToken.Metadata().ID())
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(1, joinToken.TypedSpec().Value.Uses)
+
+	// removing the machine doesn't free up the use
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, link.Metadata()))
+
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(provision("node-2", token)))
+
+	// the other tokens keep working when the token is revoked
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, joinToken.Metadata(), func(res *siderolink.JoinToken) error {
+		res.TypedSpec().Value.MaxUses = 0
+		res.TypedSpec().Value.Revoked = true
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(provision("node-2", token)))
+
+	otherToken, err := sideromanager.GenerateJoinToken()
+	suite.Require().NoError(err)
+
+	expiredJoinToken := siderolink.NewJoinToken(siderolink.Namespace, "site-b")
+	expiredJoinToken.TypedSpec().Value.Token = otherToken
+	expiredJoinToken.TypedSpec().Value.ExpirationTime = timestamppb.New(time.Now().Add(-time.Minute))
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, expiredJoinToken))
+
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(provision("node-2", otherToken)))
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, expiredJoinToken.Metadata(), func(res *siderolink.JoinToken) error {
+		res.TypedSpec().Value.ExpirationTime = timestamppb.New(time.Now().Add(time.Hour))
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(provision("node-2", otherToken))
+
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(provision("node-3", "unknown")))
+}
+
+func (suite *SiderolinkSuite) provisionMachine(id string) error {
+	connectionParams, err := safe.StateGetByID[*siderolink.ConnectionParams](suite.ctx, suite.state, siderolink.ConfigID)
+	suite.Require().NoError(err)
+
+	conn, err := grpc.DialContext(suite.ctx, suite.address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	privateKey, err := wgtypes.GeneratePrivateKey()
+	suite.Require().NoError(err)
+
+	_, err = pb.NewProvisionServiceClient(conn).Provision(suite.ctx, &pb.ProvisionRequest{
+		NodeUuid:      id,
+		NodePublicKey: privateKey.PublicKey().String(),
+		JoinToken:     &connectionParams.TypedSpec().Value.JoinToken,
+	})
+
+	return err
+}
+
+func (suite *SiderolinkSuite) setAcceptancePhase(id string, phase specs.MachineAcceptanceSpec_Phase) {
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, siderolink.NewMachineAcceptance(siderolink.Namespace, id).Metadata(),
+		func(res *siderolink.MachineAcceptance) error {
+			res.TypedSpec().Value.Phase = phase
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *SiderolinkSuite) TestMachineAcceptance() {
+	config.Config.SiderolinkRequireMachineApproval = true
+
+	defer func() {
+		config.Config.SiderolinkRequireMachineApproval = false
+	}()
+
+	suite.Require().NoError(suite.provisionMachine("accepted"))
+	suite.Require().NoError(suite.provisionMachine("rejected"))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"accepted", "rejected"}, func(res *siderolink.Link, assertion *assert.Assertions) {
+		assertion.True(res.Metadata().Labels().Matches(resource.LabelTerm{Key: omni.MachineStatusLabelPendingAcceptance, Op: resource.LabelOpExists}))
+	})
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"accepted", "rejected"}, func(res *siderolink.MachineAcceptance, assertion *assert.Assertions) {
+		assertion.Equal(specs.MachineAcceptanceSpec_Pending, res.TypedSpec().Value.Phase)
+	})
+
+	suite.setAcceptancePhase("accepted", specs.MachineAcceptanceSpec_Accepted)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"accepted"}, func(res *siderolink.Link, assertion *assert.Assertions) {
+		assertion.False(res.Metadata().Labels().Matches(resource.LabelTerm{Key: omni.MachineStatusLabelPendingAcceptance, Op: resource.LabelOpExists}))
+	})
+
+	// the rejected machine is disconnected and it can't join again
+	suite.setAcceptancePhase("rejected", specs.MachineAcceptanceSpec_Rejected)
+
+	rtestutils.AssertNoResource[*siderolink.Link](suite.ctx, suite.T(), suite.state, "rejected")
+
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(suite.provisionMachine("rejected")))
+	suite.Require().NoError(suite.provisionMachine("accepted"))
+}
+
+func (suite *SiderolinkSuite) TestMachineRejectionWithFinalizers() {
+	config.Config.SiderolinkRequireMachineApproval = true
+
+	defer func() {
+		config.Config.SiderolinkRequireMachineApproval = false
+	}()
+
+	suite.Require().NoError(suite.provisionMachine("rejected"))
+	suite.Require().NoError(suite.provisionMachine("pending"))
+
+	link := siderolink.NewLink(siderolink.Namespace, "rejected", nil)
+
+	// the controllers keep the link until they clean up the machine
+	suite.Require().NoError(suite.state.AddFinalizer(suite.ctx, link.Metadata(), "cleanup"))
+
+	suite.setAcceptancePhase("rejected", specs.MachineAcceptanceSpec_Rejected)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"rejected"}, func(res *siderolink.Link, assertion *assert.Assertions) {
+		assertion.Equal(resource.PhaseTearingDown, res.Metadata().Phase())
+	})
+
+	// the other machines are handled while the rejected link is waiting for the finalizers
+	suite.setAcceptancePhase("pending", specs.MachineAcceptanceSpec_Accepted)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"pending"}, func(res *siderolink.Link, assertion *assert.Assertions) {
+		assertion.False(res.Metadata().Labels().Matches(resource.LabelTerm{Key: omni.MachineStatusLabelPendingAcceptance, Op: resource.LabelOpExists}))
+	})
+
+	suite.Require().NoError(suite.state.RemoveFinalizer(suite.ctx, link.Metadata(), "cleanup"))
+
+	rtestutils.AssertNoResource[*siderolink.Link](suite.ctx, suite.T(), suite.state, "rejected")
+}
+
 func (suite *SiderolinkSuite) TestGenerateJoinToken() {
 	token, err := sideromanager.GenerateJoinToken()
 